@@ -0,0 +1,68 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/observer"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// annotatedLogsResult is a search result with the deployment events of its time window, to be
+// overlaid on the log timeline as markers.
+type annotatedLogsResult struct {
+	*openobserve.ComponentLogsResult
+	Annotations []observer.DeploymentEvent `json:"annotations"`
+	// AnnotationsUnavailable is set when the deployment events could not be fetched; the logs
+	// are returned regardless.
+	AnnotationsUnavailable bool `json:"annotationsUnavailable,omitempty"`
+}
+
+// parseAnnotations reports whether the "annotations" query parameter requests deployment
+// events. It returns a user-facing message if the parameter is invalid or deployment events
+// are not configured.
+func (h *LogsHandler) parseAnnotations(r *http.Request) (bool, string) {
+	value := r.URL.Query().Get("annotations")
+	if value == "" {
+		return false, ""
+	}
+	annotations, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, "annotations must be true or false"
+	}
+	if annotations && h.deploymentEvents == nil {
+		return false, "deployment event annotations are not enabled"
+	}
+	return annotations, ""
+}
+
+// fetchAnnotations fetches the deployment events of a search in the background. The returned
+// function waits for them; it returns nil if they could not be fetched, which is logged rather
+// than failing the search.
+func (h *LogsHandler) fetchAnnotations(ctx context.Context, params openobserve.ComponentLogsParams) func() []observer.DeploymentEvent {
+	query := observer.DeploymentEventsQuery{
+		Namespace:     params.Namespace,
+		ProjectID:     params.ProjectID,
+		EnvironmentID: params.EnvironmentID,
+		ComponentIDs:  params.ComponentIDs,
+		StartTime:     params.StartTime,
+		EndTime:       params.EndTime,
+	}
+	done := make(chan []observer.DeploymentEvent, 1)
+	go func() {
+		events, err := h.deploymentEvents.DeploymentEvents(ctx, query)
+		if err != nil {
+			h.logger.Warn("Failed to fetch deployment events; returning logs without annotations",
+				slog.String("namespace", params.Namespace),
+				slog.Any("error", err),
+			)
+		}
+		done <- events
+	}()
+	return func() []observer.DeploymentEvent { return <-done }
+}
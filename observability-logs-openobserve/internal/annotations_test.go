@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestSearchLogs_Annotations(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "after the release", "total": float64(1)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	eventsUp := true
+	eventsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !eventsUp {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"events":[{"timestamp":"2025-01-01T10:00:00Z","type":"Deployed","componentId":"c1"}]}`))
+	}))
+	defer eventsServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{DeploymentEventsURL: eventsServer.URL}, testLogger())
+
+	search := func() map[string]interface{} {
+		body := `{"namespace":"ns","componentIds":["c1"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search?annotations=true", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var result map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+			t.Fatalf("invalid JSON response: %v", err)
+		}
+		if logs, _ := result["logs"].([]interface{}); len(logs) != 1 {
+			t.Errorf("expected the logs alongside the annotations, got %v", result["logs"])
+		}
+		return result
+	}
+
+	result := search()
+	annotations, _ := result["annotations"].([]interface{})
+	if len(annotations) != 1 || annotations[0].(map[string]interface{})["type"] != "Deployed" || result["annotationsUnavailable"] != nil {
+		t.Errorf("unexpected annotations: %v", result)
+	}
+
+	eventsUp = false
+	result = search()
+	if result["annotationsUnavailable"] != true || result["annotations"] != nil {
+		t.Errorf("expected the logs without annotations when the events endpoint fails, got %v", result)
+	}
+}
+
+func TestSearchLogs_AnnotationsBadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	for query, want := range map[string]string{
+		"annotations=true":  "deployment event annotations are not enabled",
+		"annotations=maybe": "annotations must be true or false",
+	} {
+		body := `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search?"+query, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, req)
+
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("%s: expected 400 with %q, got %d: %s", query, want, rec.Code, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: logquery.proto
+
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LogQueryRequest selects component logs, as the body of POST /api/v1/logs/search.
+type LogQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ComponentIds  []string               `protobuf:"bytes,2,rep,name=component_ids,json=componentIds,proto3" json:"component_ids,omitempty"`
+	EnvironmentId string                 `protobuf:"bytes,3,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// start_time_micros and end_time_micros bound the query, in microseconds since the Unix
+	// epoch. Without both, the adapter's default window up to now is queried.
+	StartTimeMicros int64    `protobuf:"varint,5,opt,name=start_time_micros,json=startTimeMicros,proto3" json:"start_time_micros,omitempty"`
+	EndTimeMicros   int64    `protobuf:"varint,6,opt,name=end_time_micros,json=endTimeMicros,proto3" json:"end_time_micros,omitempty"`
+	SearchPhrase    string   `protobuf:"bytes,7,opt,name=search_phrase,json=searchPhrase,proto3" json:"search_phrase,omitempty"`
+	LogLevels       []string `protobuf:"bytes,8,rep,name=log_levels,json=logLevels,proto3" json:"log_levels,omitempty"`
+	Limit           int32    `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	// sort_order is "asc" or "desc" (the default).
+	SortOrder     string `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogQueryRequest) Reset() {
+	*x = LogQueryRequest{}
+	mi := &file_logquery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogQueryRequest) ProtoMessage() {}
+
+func (x *LogQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logquery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogQueryRequest.ProtoReflect.Descriptor instead.
+func (*LogQueryRequest) Descriptor() ([]byte, []int) {
+	return file_logquery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LogQueryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetComponentIds() []string {
+	if x != nil {
+		return x.ComponentIds
+	}
+	return nil
+}
+
+func (x *LogQueryRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetStartTimeMicros() int64 {
+	if x != nil {
+		return x.StartTimeMicros
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetEndTimeMicros() int64 {
+	if x != nil {
+		return x.EndTimeMicros
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetSearchPhrase() string {
+	if x != nil {
+		return x.SearchPhrase
+	}
+	return ""
+}
+
+func (x *LogQueryRequest) GetLogLevels() []string {
+	if x != nil {
+		return x.LogLevels
+	}
+	return nil
+}
+
+func (x *LogQueryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LogQueryRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+var File_logquery_proto protoreflect.FileDescriptor
+
+const file_logquery_proto_rawDesc = "" +
+	"\n" +
+	"\x0elogquery.proto\x12\x1eopenchoreo.logs.openobserve.v1\x1a\n" +
+	"logs.proto\"\xe7\x02\n" +
+	"\x0fLogQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12#\n" +
+	"\rcomponent_ids\x18\x02 \x03(\tR\fcomponentIds\x12%\n" +
+	"\x0eenvironment_id\x18\x03 \x01(\tR\renvironmentId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\tR\tprojectId\x12*\n" +
+	"\x11start_time_micros\x18\x05 \x01(\x03R\x0fstartTimeMicros\x12&\n" +
+	"\x0fend_time_micros\x18\x06 \x01(\x03R\rendTimeMicros\x12#\n" +
+	"\rsearch_phrase\x18\a \x01(\tR\fsearchPhrase\x12\x1d\n" +
+	"\n" +
+	"log_levels\x18\b \x03(\tR\tlogLevels\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\n" +
+	" \x01(\tR\tsortOrder2\x7f\n" +
+	"\bLogQuery\x12s\n" +
+	"\n" +
+	"StreamLogs\x12/.openchoreo.logs.openobserve.v1.LogQueryRequest\x1a2.openchoreo.logs.openobserve.v1.ComponentLogsEntry0\x01B[ZYgithub.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb;pbb\x06proto3"
+
+var (
+	file_logquery_proto_rawDescOnce sync.Once
+	file_logquery_proto_rawDescData []byte
+)
+
+func file_logquery_proto_rawDescGZIP() []byte {
+	file_logquery_proto_rawDescOnce.Do(func() {
+		file_logquery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_logquery_proto_rawDesc), len(file_logquery_proto_rawDesc)))
+	})
+	return file_logquery_proto_rawDescData
+}
+
+var file_logquery_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_logquery_proto_goTypes = []any{
+	(*LogQueryRequest)(nil),    // 0: openchoreo.logs.openobserve.v1.LogQueryRequest
+	(*ComponentLogsEntry)(nil), // 1: openchoreo.logs.openobserve.v1.ComponentLogsEntry
+}
+var file_logquery_proto_depIdxs = []int32{
+	0, // 0: openchoreo.logs.openobserve.v1.LogQuery.StreamLogs:input_type -> openchoreo.logs.openobserve.v1.LogQueryRequest
+	1, // 1: openchoreo.logs.openobserve.v1.LogQuery.StreamLogs:output_type -> openchoreo.logs.openobserve.v1.ComponentLogsEntry
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_logquery_proto_init() }
+func file_logquery_proto_init() {
+	if File_logquery_proto != nil {
+		return
+	}
+	file_logs_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logquery_proto_rawDesc), len(file_logquery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_logquery_proto_goTypes,
+		DependencyIndexes: file_logquery_proto_depIdxs,
+		MessageInfos:      file_logquery_proto_msgTypes,
+	}.Build()
+	File_logquery_proto = out.File
+	file_logquery_proto_goTypes = nil
+	file_logquery_proto_depIdxs = nil
+}
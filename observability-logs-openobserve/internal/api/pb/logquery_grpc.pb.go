@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: logquery.proto
+
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LogQuery_StreamLogs_FullMethodName = "/openchoreo.logs.openobserve.v1.LogQuery/StreamLogs"
+)
+
+// LogQueryClient is the client API for LogQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LogQuery serves component log queries to internal consumers over gRPC, on GRPC_PORT.
+type LogQueryClient interface {
+	// StreamLogs runs a component log query and streams the matching log lines in the
+	// requested order, as they are read from the result.
+	StreamLogs(ctx context.Context, in *LogQueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ComponentLogsEntry], error)
+}
+
+type logQueryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogQueryClient(cc grpc.ClientConnInterface) LogQueryClient {
+	return &logQueryClient{cc}
+}
+
+func (c *logQueryClient) StreamLogs(ctx context.Context, in *LogQueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ComponentLogsEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LogQuery_ServiceDesc.Streams[0], LogQuery_StreamLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[LogQueryRequest, ComponentLogsEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogQuery_StreamLogsClient = grpc.ServerStreamingClient[ComponentLogsEntry]
+
+// LogQueryServer is the server API for LogQuery service.
+// All implementations must embed UnimplementedLogQueryServer
+// for forward compatibility.
+//
+// LogQuery serves component log queries to internal consumers over gRPC, on GRPC_PORT.
+type LogQueryServer interface {
+	// StreamLogs runs a component log query and streams the matching log lines in the
+	// requested order, as they are read from the result.
+	StreamLogs(*LogQueryRequest, grpc.ServerStreamingServer[ComponentLogsEntry]) error
+	mustEmbedUnimplementedLogQueryServer()
+}
+
+// UnimplementedLogQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLogQueryServer struct{}
+
+func (UnimplementedLogQueryServer) StreamLogs(*LogQueryRequest, grpc.ServerStreamingServer[ComponentLogsEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedLogQueryServer) mustEmbedUnimplementedLogQueryServer() {}
+func (UnimplementedLogQueryServer) testEmbeddedByValue()                  {}
+
+// UnsafeLogQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogQueryServer will
+// result in compilation errors.
+type UnsafeLogQueryServer interface {
+	mustEmbedUnimplementedLogQueryServer()
+}
+
+func RegisterLogQueryServer(s grpc.ServiceRegistrar, srv LogQueryServer) {
+	// If the following call pancis, it indicates UnimplementedLogQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LogQuery_ServiceDesc, srv)
+}
+
+func _LogQuery_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogQueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogQueryServer).StreamLogs(m, &grpc.GenericServerStream[LogQueryRequest, ComponentLogsEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LogQuery_StreamLogsServer = grpc.ServerStreamingServer[ComponentLogsEntry]
+
+// LogQuery_ServiceDesc is the grpc.ServiceDesc for LogQuery service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogQuery_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openchoreo.logs.openobserve.v1.LogQuery",
+	HandlerType: (*LogQueryServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _LogQuery_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "logquery.proto",
+}
@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: logs.proto
+
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ComponentLogsResult is the protobuf encoding of a component log search result, returned
+// by POST /api/v1/logs/search when the request accepts application/x-protobuf.
+type ComponentLogsResult struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Logs       []*ComponentLogsEntry  `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	TotalCount int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Took       int64                  `protobuf:"varint,3,opt,name=took,proto3" json:"took,omitempty"`
+	// near_limit is set when the query returned close to its limit, so the result is likely
+	// truncated.
+	NearLimit bool `protobuf:"varint,4,opt,name=near_limit,json=nearLimit,proto3" json:"near_limit,omitempty"`
+	// stream is the OpenObserve stream that produced the logs, when fallback streams are
+	// configured.
+	Stream string `protobuf:"bytes,5,opt,name=stream,proto3" json:"stream,omitempty"`
+	// before_cursor and after_cursor continue the result towards older and newer logs.
+	BeforeCursor  string `protobuf:"bytes,6,opt,name=before_cursor,json=beforeCursor,proto3" json:"before_cursor,omitempty"`
+	AfterCursor   string `protobuf:"bytes,7,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentLogsResult) Reset() {
+	*x = ComponentLogsResult{}
+	mi := &file_logs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentLogsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentLogsResult) ProtoMessage() {}
+
+func (x *ComponentLogsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentLogsResult.ProtoReflect.Descriptor instead.
+func (*ComponentLogsResult) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ComponentLogsResult) GetLogs() []*ComponentLogsEntry {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ComponentLogsResult) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ComponentLogsResult) GetTook() int64 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *ComponentLogsResult) GetNearLimit() bool {
+	if x != nil {
+		return x.NearLimit
+	}
+	return false
+}
+
+func (x *ComponentLogsResult) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ComponentLogsResult) GetBeforeCursor() string {
+	if x != nil {
+		return x.BeforeCursor
+	}
+	return ""
+}
+
+func (x *ComponentLogsResult) GetAfterCursor() string {
+	if x != nil {
+		return x.AfterCursor
+	}
+	return ""
+}
+
+// ComponentLogsEntry is a single component log line.
+type ComponentLogsEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// timestamp_micros is the log timestamp in microseconds since the Unix epoch.
+	TimestampMicros int64  `protobuf:"varint,1,opt,name=timestamp_micros,json=timestampMicros,proto3" json:"timestamp_micros,omitempty"`
+	Log             string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	LogLevel        string `protobuf:"bytes,3,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	ComponentUid    string `protobuf:"bytes,4,opt,name=component_uid,json=componentUid,proto3" json:"component_uid,omitempty"`
+	ComponentName   string `protobuf:"bytes,5,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	EnvironmentUid  string `protobuf:"bytes,6,opt,name=environment_uid,json=environmentUid,proto3" json:"environment_uid,omitempty"`
+	EnvironmentName string `protobuf:"bytes,7,opt,name=environment_name,json=environmentName,proto3" json:"environment_name,omitempty"`
+	ProjectUid      string `protobuf:"bytes,8,opt,name=project_uid,json=projectUid,proto3" json:"project_uid,omitempty"`
+	ProjectName     string `protobuf:"bytes,9,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Namespace       string `protobuf:"bytes,10,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName         string `protobuf:"bytes,11,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	PodId           string `protobuf:"bytes,12,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	PodNamespace    string `protobuf:"bytes,13,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	ContainerName   string `protobuf:"bytes,14,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// stream is the container output stream the log was written to (stdout or stderr).
+	Stream string `protobuf:"bytes,15,opt,name=stream,proto3" json:"stream,omitempty"`
+	// raw_log is the log line as stored, set when ANSI escape codes were stripped from log.
+	RawLog        string `protobuf:"bytes,16,opt,name=raw_log,json=rawLog,proto3" json:"raw_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentLogsEntry) Reset() {
+	*x = ComponentLogsEntry{}
+	mi := &file_logs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentLogsEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentLogsEntry) ProtoMessage() {}
+
+func (x *ComponentLogsEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentLogsEntry.ProtoReflect.Descriptor instead.
+func (*ComponentLogsEntry) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ComponentLogsEntry) GetTimestampMicros() int64 {
+	if x != nil {
+		return x.TimestampMicros
+	}
+	return 0
+}
+
+func (x *ComponentLogsEntry) GetLog() string {
+	if x != nil {
+		return x.Log
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetComponentUid() string {
+	if x != nil {
+		return x.ComponentUid
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetComponentName() string {
+	if x != nil {
+		return x.ComponentName
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetEnvironmentUid() string {
+	if x != nil {
+		return x.EnvironmentUid
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetEnvironmentName() string {
+	if x != nil {
+		return x.EnvironmentName
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetProjectUid() string {
+	if x != nil {
+		return x.ProjectUid
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetPodId() string {
+	if x != nil {
+		return x.PodId
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ComponentLogsEntry) GetRawLog() string {
+	if x != nil {
+		return x.RawLog
+	}
+	return ""
+}
+
+var File_logs_proto protoreflect.FileDescriptor
+
+const file_logs_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"logs.proto\x12\x1eopenchoreo.logs.openobserve.v1\"\x91\x02\n" +
+	"\x13ComponentLogsResult\x12F\n" +
+	"\x04logs\x18\x01 \x03(\v22.openchoreo.logs.openobserve.v1.ComponentLogsEntryR\x04logs\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04took\x18\x03 \x01(\x03R\x04took\x12\x1d\n" +
+	"\n" +
+	"near_limit\x18\x04 \x01(\bR\tnearLimit\x12\x16\n" +
+	"\x06stream\x18\x05 \x01(\tR\x06stream\x12#\n" +
+	"\rbefore_cursor\x18\x06 \x01(\tR\fbeforeCursor\x12!\n" +
+	"\fafter_cursor\x18\a \x01(\tR\vafterCursor\"\x9f\x04\n" +
+	"\x12ComponentLogsEntry\x12)\n" +
+	"\x10timestamp_micros\x18\x01 \x01(\x03R\x0ftimestampMicros\x12\x10\n" +
+	"\x03log\x18\x02 \x01(\tR\x03log\x12\x1b\n" +
+	"\tlog_level\x18\x03 \x01(\tR\blogLevel\x12#\n" +
+	"\rcomponent_uid\x18\x04 \x01(\tR\fcomponentUid\x12%\n" +
+	"\x0ecomponent_name\x18\x05 \x01(\tR\rcomponentName\x12'\n" +
+	"\x0fenvironment_uid\x18\x06 \x01(\tR\x0eenvironmentUid\x12)\n" +
+	"\x10environment_name\x18\a \x01(\tR\x0fenvironmentName\x12\x1f\n" +
+	"\vproject_uid\x18\b \x01(\tR\n" +
+	"projectUid\x12!\n" +
+	"\fproject_name\x18\t \x01(\tR\vprojectName\x12\x1c\n" +
+	"\tnamespace\x18\n" +
+	" \x01(\tR\tnamespace\x12\x19\n" +
+	"\bpod_name\x18\v \x01(\tR\apodName\x12\x15\n" +
+	"\x06pod_id\x18\f \x01(\tR\x05podId\x12#\n" +
+	"\rpod_namespace\x18\r \x01(\tR\fpodNamespace\x12%\n" +
+	"\x0econtainer_name\x18\x0e \x01(\tR\rcontainerName\x12\x16\n" +
+	"\x06stream\x18\x0f \x01(\tR\x06stream\x12\x17\n" +
+	"\araw_log\x18\x10 \x01(\tR\x06rawLogB[ZYgithub.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb;pbb\x06proto3"
+
+var (
+	file_logs_proto_rawDescOnce sync.Once
+	file_logs_proto_rawDescData []byte
+)
+
+func file_logs_proto_rawDescGZIP() []byte {
+	file_logs_proto_rawDescOnce.Do(func() {
+		file_logs_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_logs_proto_rawDesc), len(file_logs_proto_rawDesc)))
+	})
+	return file_logs_proto_rawDescData
+}
+
+var file_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_logs_proto_goTypes = []any{
+	(*ComponentLogsResult)(nil), // 0: openchoreo.logs.openobserve.v1.ComponentLogsResult
+	(*ComponentLogsEntry)(nil),  // 1: openchoreo.logs.openobserve.v1.ComponentLogsEntry
+}
+var file_logs_proto_depIdxs = []int32{
+	1, // 0: openchoreo.logs.openobserve.v1.ComponentLogsResult.logs:type_name -> openchoreo.logs.openobserve.v1.ComponentLogsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_logs_proto_init() }
+func file_logs_proto_init() {
+	if File_logs_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logs_proto_rawDesc), len(file_logs_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_logs_proto_goTypes,
+		DependencyIndexes: file_logs_proto_depIdxs,
+		MessageInfos:      file_logs_proto_msgTypes,
+	}.Build()
+	File_logs_proto = out.File
+	file_logs_proto_goTypes = nil
+	file_logs_proto_depIdxs = nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// Headers of HMAC-signed requests.
+const (
+	signatureHeader          = "X-Signature"
+	signatureTimestampHeader = "X-Signature-Timestamp"
+)
+
+// DefaultMaxClockSkew is how far the timestamp of a signed request may be from the adapter's
+// clock when APIAuth.MaxClockSkew is not set.
+const DefaultMaxClockSkew = 5 * time.Minute
+
+// maxSignedBodyBytes caps the request body read to verify an HMAC signature.
+const maxSignedBodyBytes = 10 << 20
+
+// unauthenticatedRoutes lists the paths served without credentials, e.g. to probes.
+var unauthenticatedRoutes = map[string]bool{
+	"/health": true,
+}
+
+// adminRoutes lists the paths reserved to platform admins. They are authenticated with
+// APIAuth.AdminTokens alone, whether or not the rest of the API requires credentials.
+var adminRoutes = map[string]bool{
+	"/api/v1/admin/logs/search": true,
+}
+
+// APIAuth configures authentication of the adapter's own API. Requests are accepted with any
+// of the bearer Tokens or with a valid HMAC signature made with HMACSecret. The zero value
+// disables authentication.
+type APIAuth struct {
+	Tokens     []string
+	HMACSecret []byte
+	// MaxClockSkew bounds the age of signed requests. Zero selects DefaultMaxClockSkew.
+	MaxClockSkew time.Duration
+	// AdminTokens are the bearer tokens accepted by adminRoutes. Empty disables them.
+	AdminTokens []string
+}
+
+// Enabled reports whether any credentials are configured.
+func (a APIAuth) Enabled() bool {
+	return len(a.Tokens) > 0 || len(a.HMACSecret) > 0
+}
+
+// authMiddleware rejects requests without valid credentials with 401. A request is
+// authenticated by an "Authorization: Bearer <token>" header carrying one of the configured
+// tokens, or by an HMAC-SHA256 signature: X-Signature-Timestamp holds the Unix time in
+// seconds and X-Signature the hex signature of
+//
+//	<timestamp>\n<method>\n<path and query>\n<hex SHA-256 of the body>
+//
+// Signatures older or newer than the maximum clock skew are rejected to limit replays.
+func authMiddleware(next http.Handler, auth APIAuth, logger *slog.Logger) http.Handler {
+	maxSkew := auth.MaxClockSkew
+	if maxSkew <= 0 {
+		maxSkew = DefaultMaxClockSkew
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Admin routes are authenticated by adminAuthMiddleware.
+		if unauthenticatedRoutes[r.URL.Path] || adminRoutes[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var ok bool
+		var reason string
+		switch {
+		case r.Header.Get(signatureHeader) != "" && len(auth.HMACSecret) > 0:
+			ok, reason = verifySignature(r, auth.HMACSecret, maxSkew, time.Now())
+		case len(auth.Tokens) > 0:
+			ok, reason = validBearerToken(r, auth.Tokens), "missing or invalid bearer token"
+		default:
+			reason = "missing request signature"
+		}
+		if !ok {
+			logger.Warn("Rejected unauthenticated request",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("clientIP", clientIPFromContext(r.Context())),
+				slog.String("reason", reason),
+			)
+			writeUnauthorized(w, auth, logger)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// adminAuthMiddleware requires one of the admin tokens on adminRoutes: requests without a
+// valid one are rejected with 401, and every request with 403 if no admin token is
+// configured. Other routes are passed through.
+func adminAuthMiddleware(next http.Handler, adminTokens []string, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !adminRoutes[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		status, title, message := http.StatusForbidden, gen.Forbidden, "admin endpoints are disabled"
+		if len(adminTokens) > 0 {
+			if validBearerToken(r, adminTokens) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			status, title, message = http.StatusUnauthorized, gen.Unauthorized, "a valid admin token is required"
+			w.Header().Set("WWW-Authenticate", `Bearer realm="observability-logs-openobserve"`)
+		}
+		logger.Warn("Rejected request to an admin endpoint",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("clientIP", clientIPFromContext(r.Context())),
+			slog.Int("status", status),
+		)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+			Title:   ptr(title),
+			Message: ptr(message),
+		}); err != nil {
+			logger.Error("Failed to write admin rejection response", slog.Any("error", err))
+		}
+	})
+}
+
+// validBearerToken reports whether r carries one of tokens as its bearer token. Tokens are
+// compared in constant time.
+func validBearerToken(r *http.Request, tokens []string) bool {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return false
+	}
+	valid := false
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
+
+// verifySignature checks the HMAC signature of r, restoring its body for the handler. It
+// returns the reason for rejecting an invalid signature.
+func verifySignature(r *http.Request, secret []byte, maxSkew time.Duration, now time.Time) (bool, string) {
+	timestamp := r.Header.Get(signatureTimestampHeader)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false, "missing or malformed signature timestamp"
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > maxSkew || skew < -maxSkew {
+		return false, "signature timestamp outside the allowed clock skew"
+	}
+	signature, err := hex.DecodeString(r.Header.Get(signatureHeader))
+	if err != nil {
+		return false, "malformed signature"
+	}
+
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxSignedBodyBytes+1))
+		if err != nil {
+			return false, "failed to read request body"
+		}
+		if len(body) > maxSignedBodyBytes {
+			return false, "request body too large to verify"
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if !hmac.Equal(signature, requestSignature(secret, timestamp, r.Method, r.URL.RequestURI(), body)) {
+		return false, "invalid signature"
+	}
+	return true, ""
+}
+
+// requestSignature computes the HMAC-SHA256 signature of a request (see authMiddleware).
+func requestSignature(secret []byte, timestamp, method, requestURI string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "\n" + method + "\n" + requestURI + "\n" + hex.EncodeToString(bodyHash[:])))
+	return mac.Sum(nil)
+}
+
+func writeUnauthorized(w http.ResponseWriter, auth APIAuth, logger *slog.Logger) {
+	if len(auth.Tokens) > 0 {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="observability-logs-openobserve"`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+		Title:   ptr(gen.Unauthorized),
+		Message: ptr("valid credentials are required"),
+	}); err != nil {
+		logger.Error("Failed to write unauthorized response", slog.Any("error", err))
+	}
+}
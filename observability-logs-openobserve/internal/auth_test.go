@@ -0,0 +1,134 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// echoHandler responds with the request body, to check that it reaches the handler intact.
+func echoHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	})
+}
+
+func signedRequest(secret, method, target, body string, at time.Time) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	signature := requestSignature([]byte(secret), timestamp, method, req.URL.RequestURI(), []byte(body))
+	req.Header.Set(signatureTimestampHeader, timestamp)
+	req.Header.Set(signatureHeader, hex.EncodeToString(signature))
+	return req
+}
+
+func TestAuthMiddleware_BearerToken(t *testing.T) {
+	handler := authMiddleware(echoHandler(), APIAuth{Tokens: []string{"first", "second"}}, testLogger())
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantStatus    int
+	}{
+		{"valid token", "/api/v1/logs/query", "Bearer second", http.StatusOK},
+		{"lowercase scheme", "/api/v1alpha1/alerts/rules", "bearer first", http.StatusOK},
+		{"missing token", "/api/v1/logs/query", "", http.StatusUnauthorized},
+		{"wrong token", "/api/v1/logs/query", "Bearer third", http.StatusUnauthorized},
+		{"basic auth", "/api/v1/logs/query", "Basic Zmlyc3Q6", http.StatusUnauthorized},
+		{"health stays open", "/health", "", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if rec.Code == http.StatusUnauthorized {
+				if rec.Header().Get("WWW-Authenticate") == "" {
+					t.Error("expected a WWW-Authenticate challenge")
+				}
+				if !strings.Contains(rec.Body.String(), `"title":"unauthorized"`) {
+					t.Errorf("expected an error response, got %s", rec.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_HMAC(t *testing.T) {
+	const secret = "s3cret"
+	handler := authMiddleware(echoHandler(), APIAuth{HMACSecret: []byte(secret), MaxClockSkew: time.Minute}, testLogger())
+	body := `{"namespace":"default"}`
+	now := time.Now()
+
+	t.Run("valid signature", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, signedRequest(secret, http.MethodPost, "/api/v1/logs/search?emptyResultStatus=404", body, now))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+		if rec.Body.String() != body {
+			t.Errorf("expected the body to reach the handler, got %q", rec.Body.String())
+		}
+	})
+
+	rejected := map[string]*http.Request{
+		"wrong secret": signedRequest("other", http.MethodPost, "/api/v1/logs/search", body, now),
+		"stale":        signedRequest(secret, http.MethodPost, "/api/v1/logs/search", body, now.Add(-2*time.Minute)),
+		"unsigned":     httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)),
+	}
+	tampered := signedRequest(secret, http.MethodPost, "/api/v1/logs/search", body, now)
+	tampered.Body = io.NopCloser(strings.NewReader(`{"namespace":"other"}`))
+	rejected["tampered body"] = tampered
+	otherPath := signedRequest(secret, http.MethodPost, "/api/v1/logs/search", body, now)
+	otherPath.URL.Path = "/api/v1/logs/export"
+	rejected["different path"] = otherPath
+	malformed := signedRequest(secret, http.MethodPost, "/api/v1/logs/search", body, now)
+	malformed.Header.Set(signatureHeader, "not-hex")
+	rejected["malformed signature"] = malformed
+
+	for name, req := range rejected {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("expected 401, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_TokenOrSignature(t *testing.T) {
+	const secret = "s3cret"
+	handler := authMiddleware(echoHandler(), APIAuth{Tokens: []string{"token"}, HMACSecret: []byte(secret)}, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/alerts/webhook", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected a bearer token to be accepted, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, signedRequest(secret, http.MethodGet, "/api/v1/logs/stream?namespace=default", "", time.Now()))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected a signature to be accepted, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// DefaultClockSkewTolerance is how far ahead of the adapter's clock the startTime of a query
+// may be when QUERY_CLOCK_SKEW_TOLERANCE is not set, to allow for clients whose clock runs
+// slightly ahead.
+const DefaultClockSkewTolerance = time.Minute
+
+// checkClockSkew rejects a time range starting further in the future than the clock skew
+// tolerance, which would otherwise match no logs and look like a component that logged
+// nothing, usually because the client's clock runs ahead. When clamping is enabled, an endTime
+// beyond the tolerance is brought back to it. It returns a user-facing message describing the
+// problem, or "" if the time range is accepted.
+func (h *LogsHandler) checkClockSkew(params *openobserve.ComponentLogsParams) string {
+	latest := time.Now().Add(h.clockSkewTolerance)
+	if params.StartTime.After(latest) {
+		return fmt.Sprintf("startTime must not be in the future: %s is more than %s ahead of the adapter's clock; check the client's clock for skew",
+			params.StartTime.UTC().Format(time.RFC3339), h.clockSkewTolerance)
+	}
+	if h.clampEndTime && params.EndTime.After(latest) {
+		h.logger.Debug("Clamped a future endTime to the clock skew tolerance",
+			slog.String("namespace", params.Namespace),
+			slog.Time("endTime", params.EndTime),
+			slog.Time("clampedTo", latest),
+		)
+		params.EndTime = latest
+	}
+	return ""
+}
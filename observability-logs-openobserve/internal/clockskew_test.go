@@ -0,0 +1,98 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestCheckClockSkew(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{ClockSkewTolerance: time.Minute}, testLogger())
+	now := time.Now()
+
+	// A client clock 30s ahead is tolerated.
+	params := openobserve.ComponentLogsParams{StartTime: now.Add(30 * time.Second), EndTime: now.Add(time.Hour)}
+	if msg := handler.checkClockSkew(&params); msg != "" {
+		t.Errorf("expected a start within the tolerance to be accepted, got %q", msg)
+	}
+	if !params.EndTime.Equal(now.Add(time.Hour)) {
+		t.Errorf("expected endTime to be kept without clamping, got %v", params.EndTime)
+	}
+
+	params = openobserve.ComponentLogsParams{StartTime: now.Add(5 * time.Minute), EndTime: now.Add(time.Hour)}
+	if msg := handler.checkClockSkew(&params); !strings.Contains(msg, "more than 1m0s ahead of the adapter's clock") {
+		t.Errorf("expected a start beyond the tolerance to be rejected, got %q", msg)
+	}
+
+	clamping := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{ClockSkewTolerance: time.Minute, ClampEndTime: true}, testLogger())
+	params = openobserve.ComponentLogsParams{StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)}
+	if msg := clamping.checkClockSkew(&params); msg != "" {
+		t.Fatalf("unexpected message %q", msg)
+	}
+	if params.EndTime.After(time.Now().Add(time.Minute)) || params.EndTime.Before(now.Add(time.Minute)) {
+		t.Errorf("expected endTime to be clamped to the tolerance, got %v", params.EndTime)
+	}
+}
+
+func TestSearchLogs_ClockSkew(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{ClockSkewTolerance: time.Minute}, testLogger())
+	start := time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339)
+	end := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	body := `{"namespace":"ns","startTime":"` + start + `","endTime":"` + end + `"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "check the client's clock") {
+		t.Errorf("expected 400 pointing at clock skew, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestQueryLogs_ClockSkew(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{ClockSkewTolerance: time.Minute}, testLogger())
+	scope := gen.LogsQueryRequest_SearchScope{}
+	_ = scope.FromComponentSearchScope(gen.ComponentSearchScope{Namespace: "ns"})
+
+	resp, err := handler.QueryLogs(context.Background(), gen.QueryLogsRequestObject{
+		Body: &gen.LogsQueryRequest{
+			StartTime:   time.Now().Add(10 * time.Minute),
+			EndTime:     time.Now().Add(time.Hour),
+			SearchScope: scope,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rec := httptest.NewRecorder()
+	if err := resp.VisitQueryLogsResponse(rec); err != nil {
+		t.Fatalf("failed to write response: %v", err)
+	}
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "check the client's clock") {
+		t.Errorf("expected 400 pointing at clock skew, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestExportLogs_ClockSkew(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{ClockSkewTolerance: time.Minute}, testLogger())
+	start := time.Now().Add(10 * time.Minute).UTC().Format(time.RFC3339)
+	end := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	body := `{"namespace":"ns","startTime":"` + start + `","endTime":"` + end + `"}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ExportLogs(rec, req)
+
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "check the client's clock") {
+		t.Errorf("expected 400 pointing at clock skew, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
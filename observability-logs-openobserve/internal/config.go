@@ -6,14 +6,22 @@ package app
 import (
 	"fmt"
 	"log/slog"
+	"net/http"
+	"net/netip"
 	"net/url"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/s3"
 )
 
 type Config struct {
 	ServerPort              string
+	GRPCPort                string
 	OpenObserveURL          string
 	OpenObserveOrg          string
 	OpenObserveStream       string
@@ -21,7 +29,71 @@ type Config struct {
 	OpenObserveUser         string
 	OpenObservePassword     string
 	ObserverURL             string
+	DefaultedTarget         []string
 	LogLevel                slog.Level
+	LogFieldMapping         map[string]string
+	LogTimestampFormat      string
+	StreamPollInterval      time.Duration
+	StreamHeartbeatInterval time.Duration
+	StreamBufferSize        int
+	StreamWriteTimeout      time.Duration
+	StreamIdleTimeout       time.Duration
+	StreamMaxDuration       time.Duration
+	StreamDrainTimeout      time.Duration
+	MaxStreams              int
+	NearLimitRatio          float64
+	AtTimestampEpsilon      time.Duration
+	EmptyResultNotFound     bool
+	SeverityLevels          []string
+	AcceptedLogLevels       []string
+	LenientLogLevels        bool
+	RequestTimeout          time.Duration
+	PercentileFields        []string
+	LabelSelectorKeys       []string
+	MaxAlertWindow          time.Duration
+	FallbackStreams         []string
+	TimestampField          string
+	TrustedProxies          []netip.Prefix
+	StreamField             string
+	EventTimeField          string
+	VersionField            string
+	CorrelationField        string
+	MessageField            string
+	LevelField              string
+	LevelFromMessage        bool
+	LevelPattern            *regexp.Regexp
+	SeverityClasses         map[string]string
+	AlertsEnabled           bool
+	AdminOrgs               []string
+	AdminOrgConcurrency     int
+	MaxComponentIDs         int
+	DefaultQueryWindow      time.Duration
+	DefaultSortOrder        string
+	SchemaCacheTTL          time.Duration
+	BestEffortTimeout       time.Duration
+	ClockSkewTolerance      time.Duration
+	ClampEndTime            bool
+	ScanBudgets             map[string]float64
+	ScanBudgetWindow        time.Duration
+	QueryDedupWindow        time.Duration
+	MaxScanBytes            int64
+	KeepAliveInterval       time.Duration
+	AllowedStreams          []openobserve.StreamRef
+	StripANSI               bool
+	OpenObserveHeaders      http.Header
+	QueryRetry              openobserve.RetryPolicy
+	AlertRetry              openobserve.RetryPolicy
+	EmptyResultRetry        openobserve.RetryPolicy
+	UIURL                   string
+	LoginURL                string
+	LoginClientID           string
+	ResultsDir              string
+	ResultTTL               time.Duration
+	QueryTemplatesFile      string
+	DeploymentEventsURL     string
+	ExportBucket            s3.Config
+	APIAuth                 APIAuth
+	Tenants                 TenantSettings
 }
 
 // LoadConfig loads configuration from environment variables
@@ -70,12 +142,382 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("OBSERVER_URL must be a valid URL with scheme and host, got: %q", observerURL)
 	}
 
+	// The organization and stream fall back to "default", which silently queries the wrong
+	// place when they were meant to be set. Strict mode requires them instead.
+	var defaultedTarget []string
+	for _, key := range []string{"OPENOBSERVE_ORG", "OPENOBSERVE_STREAM"} {
+		if os.Getenv(key) == "" {
+			defaultedTarget = append(defaultedTarget, key)
+		}
+	}
+	strictTarget, err := strconv.ParseBool(getEnv("OPENOBSERVE_STRICT_TARGET", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OPENOBSERVE_STRICT_TARGET %q: must be true or false", os.Getenv("OPENOBSERVE_STRICT_TARGET"))
+	}
+	if strictTarget && len(defaultedTarget) > 0 {
+		return nil, fmt.Errorf("environment variable %s is required when OPENOBSERVE_STRICT_TARGET is enabled", strings.Join(defaultedTarget, " and "))
+	}
+
 	if _, err := strconv.Atoi(serverPort); err != nil {
 		return nil, fmt.Errorf("invalid SERVER_PORT: %w", err)
 	}
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort != "" {
+		if _, err := strconv.Atoi(grpcPort); err != nil {
+			return nil, fmt.Errorf("invalid GRPC_PORT: %w", err)
+		}
+		if grpcPort == serverPort {
+			return nil, fmt.Errorf("GRPC_PORT must differ from SERVER_PORT")
+		}
+	}
+
+	logFieldMapping, err := ParseFieldMapping(getEnv("LOG_FIELD_MAPPING", ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid LOG_FIELD_MAPPING: %w", err)
+	}
+
+	logTimestampFormat := strings.ToLower(getEnv("LOG_TIMESTAMP_FORMAT", TimestampFormatRFC3339))
+	if logTimestampFormat != TimestampFormatRFC3339 && logTimestampFormat != TimestampFormatUnixMs {
+		return nil, fmt.Errorf("invalid LOG_TIMESTAMP_FORMAT %q: must be %s or %s", logTimestampFormat, TimestampFormatRFC3339, TimestampFormatUnixMs)
+	}
+
+	streamPollInterval, err := getEnvDuration("STREAM_POLL_INTERVAL", 2*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	streamHeartbeatInterval, err := getEnvDuration("STREAM_HEARTBEAT_INTERVAL", 15*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	streamBufferSize, err := getEnvInt("STREAM_BUFFER_SIZE", 1000)
+	if err != nil {
+		return nil, err
+	}
+	streamWriteTimeout, err := getEnvDuration("STREAM_WRITE_TIMEOUT", 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	streamIdleTimeout, err := getEnvDuration("STREAM_IDLE_TIMEOUT", 0)
+	if err != nil {
+		return nil, err
+	}
+	streamMaxDuration, err := getEnvDuration("STREAM_MAX_DURATION", 0)
+	if err != nil {
+		return nil, err
+	}
+	streamDrainTimeout, err := getEnvDuration("STREAM_DRAIN_TIMEOUT", DefaultStreamDrainTimeout)
+	if err != nil {
+		return nil, err
+	}
+	maxStreams, err := getEnvInt("STREAM_MAX_CONNECTIONS", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	nearLimitRatio := 0.9
+	if value := os.Getenv("RESULT_NEAR_LIMIT_RATIO"); value != "" {
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil || ratio <= 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid RESULT_NEAR_LIMIT_RATIO %q: must be a number in (0, 1]", value)
+		}
+		nearLimitRatio = ratio
+	}
+
+	atTimestampEpsilon, err := getEnvDuration("AT_TIMESTAMP_EPSILON", time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+
+	var emptyResultNotFound bool
+	switch emptyResultStatus := getEnv("EMPTY_RESULT_STATUS", "200"); emptyResultStatus {
+	case "200":
+	case "404":
+		emptyResultNotFound = true
+	default:
+		return nil, fmt.Errorf("invalid EMPTY_RESULT_STATUS %q: must be 200 or 404", emptyResultStatus)
+	}
+
+	severityLevels := DefaultSeverityLevels
+	if value := os.Getenv("SEVERITY_LEVELS"); value != "" {
+		severityLevels, err = ParseSeverityLevels(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SEVERITY_LEVELS: %w", err)
+		}
+	}
+
+	var severityClasses map[string]string
+	if value := os.Getenv("SEVERITY_CLASSES"); value != "" {
+		severityClasses, err = ParseSeverityClasses(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SEVERITY_CLASSES: %w", err)
+		}
+	}
+
+	acceptedLogLevels := splitList(os.Getenv("LOG_LEVEL_ALLOWLIST"))
+
+	var lenientLogLevels bool
+	switch validation := getEnv("LOG_LEVEL_VALIDATION", "strict"); validation {
+	case "strict":
+	case "warn":
+		lenientLogLevels = true
+	default:
+		return nil, fmt.Errorf("invalid LOG_LEVEL_VALIDATION %q: must be strict or warn", validation)
+	}
+
+	requestTimeout, err := getEnvDuration("REQUEST_TIMEOUT", 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAlertWindow, err := getEnvDuration("ALERT_MAX_WINDOW", 24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	percentileFields := splitList(os.Getenv("PERCENTILE_FIELDS"))
+	for _, field := range percentileFields {
+		if !openobserve.ValidFieldName(field) {
+			return nil, fmt.Errorf("invalid PERCENTILE_FIELDS entry %q: must be a plain field name", field)
+		}
+	}
+
+	labelSelectorKeys := splitList(os.Getenv("LABEL_SELECTOR_KEYS"))
+	for _, key := range labelSelectorKeys {
+		if !openobserve.ValidLabelKey(key) {
+			return nil, fmt.Errorf("invalid LABEL_SELECTOR_KEYS entry %q: must be a Kubernetes label key", key)
+		}
+	}
+
+	fallbackStreams := splitList(os.Getenv("OPENOBSERVE_FALLBACK_STREAMS"))
+
+	timestampField := getEnv("OPENOBSERVE_TIMESTAMP_FIELD", openobserve.DefaultTimestampField)
+	if !openobserve.ValidFieldName(timestampField) {
+		return nil, fmt.Errorf("invalid OPENOBSERVE_TIMESTAMP_FIELD %q: must be a plain field name", timestampField)
+	}
+
+	streamField := getEnv("LOG_STREAM_FIELD", openobserve.DefaultStreamField)
+	if !openobserve.ValidFieldName(streamField) {
+		return nil, fmt.Errorf("invalid LOG_STREAM_FIELD %q: must be a plain field name", streamField)
+	}
+
+	versionField := getEnv("LOG_VERSION_FIELD", openobserve.DefaultVersionField)
+	if !openobserve.ValidFieldName(versionField) {
+		return nil, fmt.Errorf("invalid LOG_VERSION_FIELD %q: must be a plain field name", versionField)
+	}
+
+	correlationField := getEnv("LOG_CORRELATION_FIELD", openobserve.DefaultCorrelationField)
+	if !openobserve.ValidFieldName(correlationField) {
+		return nil, fmt.Errorf("invalid LOG_CORRELATION_FIELD %q: must be a plain field name", correlationField)
+	}
+
+	messageField := getEnv("LOG_MESSAGE_FIELD", openobserve.DefaultMessageField)
+	if !openobserve.ValidFieldName(messageField) || messageField == timestampField {
+		return nil, fmt.Errorf("invalid LOG_MESSAGE_FIELD %q: must be a plain field name other than the timestamp field", messageField)
+	}
+
+	levelField := getEnv("LOG_LEVEL_FIELD", openobserve.DefaultLevelField)
+	if !openobserve.ValidFieldName(levelField) {
+		return nil, fmt.Errorf("invalid LOG_LEVEL_FIELD %q: must be a plain field name", levelField)
+	}
+	levelFromMessage, err := strconv.ParseBool(getEnv("LOG_LEVEL_FROM_MESSAGE", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid LOG_LEVEL_FROM_MESSAGE: %w", err)
+	}
+	var levelPattern *regexp.Regexp
+	if value := os.Getenv("LOG_LEVEL_PATTERN"); value != "" {
+		if !levelFromMessage {
+			return nil, fmt.Errorf("LOG_LEVEL_PATTERN requires LOG_LEVEL_FROM_MESSAGE")
+		}
+		levelPattern, err = regexp.Compile(value)
+		if err != nil || !openobserve.ValidLevelPattern(levelPattern) {
+			return nil, fmt.Errorf("invalid LOG_LEVEL_PATTERN %q: must be a regular expression capturing the level in a group", value)
+		}
+	}
+
+	eventTimeField := os.Getenv("LOG_EVENT_TIME_FIELD")
+	if eventTimeField != "" && !openobserve.ValidFieldName(eventTimeField) {
+		return nil, fmt.Errorf("invalid LOG_EVENT_TIME_FIELD %q: must be a plain field name", eventTimeField)
+	}
+	if eventTimeField == openobserve.DefaultTimestampField {
+		return nil, fmt.Errorf("invalid LOG_EVENT_TIME_FIELD %q: must differ from the ingestion time field", eventTimeField)
+	}
+
+	var queryDedupWindow time.Duration
+	if value := os.Getenv("QUERY_DEDUP_WINDOW"); value != "" {
+		queryDedupWindow, err = time.ParseDuration(value)
+		if err != nil || queryDedupWindow < 0 {
+			return nil, fmt.Errorf("invalid QUERY_DEDUP_WINDOW %q: must be a non-negative duration such as 2s", value)
+		}
+	}
+
+	var keepAliveInterval time.Duration
+	if value := os.Getenv("KEEPALIVE_INTERVAL"); value != "" {
+		keepAliveInterval, err = time.ParseDuration(value)
+		if err != nil || keepAliveInterval < 0 {
+			return nil, fmt.Errorf("invalid KEEPALIVE_INTERVAL %q: must be a non-negative duration such as 1m", value)
+		}
+	}
+
+	var allowedStreams []openobserve.StreamRef
+	for _, value := range splitList(os.Getenv("ALLOWED_STREAMS")) {
+		ref, err := openobserve.ParseStreamRef(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ALLOWED_STREAMS entry %q: %w", value, err)
+		}
+		allowedStreams = append(allowedStreams, ref)
+	}
+
+	openObserveHeaders, err := openobserve.ParseHeaders(os.Getenv("OPENOBSERVE_HEADERS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid OPENOBSERVE_HEADERS: %w", err)
+	}
+
+	queryRetry, err := getEnvRetryPolicy("QUERY_RETRIES", "QUERY_RETRY_BACKOFF", openobserve.RetryPolicy{Backoff: 250 * time.Millisecond})
+	if err != nil {
+		return nil, err
+	}
+	emptyResultRetry, err := getEnvRetryPolicy("QUERY_EMPTY_RETRIES", "QUERY_EMPTY_RETRY_DELAY", openobserve.RetryPolicy{Backoff: 500 * time.Millisecond})
+	if err != nil {
+		return nil, err
+	}
+
+	alertRetry, err := getEnvRetryPolicy("ALERT_RETRIES", "ALERT_RETRY_BACKOFF", openobserve.RetryPolicy{Retries: 3, Backoff: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	alertsEnabled, err := strconv.ParseBool(getEnv("ALERTS_ENABLED", "true"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ALERTS_ENABLED %q: must be true or false", os.Getenv("ALERTS_ENABLED"))
+	}
+
+	stripANSI, err := strconv.ParseBool(getEnv("LOG_STRIP_ANSI", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid LOG_STRIP_ANSI %q: must be true or false", os.Getenv("LOG_STRIP_ANSI"))
+	}
+
+	uiURL := os.Getenv("OPENOBSERVE_UI_URL")
+	if uiURL != "" {
+		parsedURL, err := url.Parse(uiURL)
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			return nil, fmt.Errorf("OPENOBSERVE_UI_URL must be a valid URL with scheme and host, got: %q", uiURL)
+		}
+	}
+
+	loginURL := os.Getenv("OPENOBSERVE_LOGIN_URL")
+	if loginURL != "" {
+		parsedURL, err := url.Parse(loginURL)
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			return nil, fmt.Errorf("OPENOBSERVE_LOGIN_URL must be a valid URL with scheme and host, got: %q", loginURL)
+		}
+	}
+
+	deploymentEventsURL := os.Getenv("DEPLOYMENT_EVENTS_URL")
+	if deploymentEventsURL != "" {
+		parsedURL, err := url.Parse(deploymentEventsURL)
+		if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" {
+			return nil, fmt.Errorf("DEPLOYMENT_EVENTS_URL must be a valid URL with scheme and host, got: %q", deploymentEventsURL)
+		}
+	}
+
+	// Exporting to object storage is enabled by setting the bucket.
+	exportBucket := s3.Config{
+		Endpoint:        os.Getenv("S3_EXPORT_ENDPOINT"),
+		Region:          os.Getenv("S3_EXPORT_REGION"),
+		Bucket:          os.Getenv("S3_EXPORT_BUCKET"),
+		Prefix:          os.Getenv("S3_EXPORT_PREFIX"),
+		AccessKeyID:     os.Getenv("S3_EXPORT_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("S3_EXPORT_SECRET_ACCESS_KEY"),
+	}
+	if exportBucket.Bucket != "" {
+		if _, err := s3.NewClient(exportBucket); err != nil {
+			return nil, fmt.Errorf("invalid S3 export configuration: %w", err)
+		}
+	}
+
+	resultTTL, err := getEnvDuration("RESULTS_TTL", DefaultResultTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	maxScanMB, err := getEnvInt("QUERY_MAX_SCAN_MB", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	maxComponentIDs, err := getEnvInt("QUERY_MAX_COMPONENT_IDS", openobserve.DefaultMaxComponentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultQueryWindow, err := getEnvDuration("QUERY_DEFAULT_WINDOW", openobserve.DefaultQueryWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultSortOrder := strings.ToLower(getEnv("QUERY_DEFAULT_SORT_ORDER", openobserve.DefaultSortOrder))
+	if defaultSortOrder != "asc" && defaultSortOrder != "desc" {
+		return nil, fmt.Errorf("invalid QUERY_DEFAULT_SORT_ORDER %q: must be asc or desc", defaultSortOrder)
+	}
+
+	schemaCacheTTL, err := getEnvDuration("SCHEMA_CACHE_TTL", openobserve.DefaultSchemaCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	bestEffortTimeout, err := getEnvDuration("QUERY_BEST_EFFORT_TIMEOUT", openobserve.DefaultBestEffortTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	clockSkewTolerance, err := getEnvDuration("QUERY_CLOCK_SKEW_TOLERANCE", DefaultClockSkewTolerance)
+	if err != nil {
+		return nil, err
+	}
+	clampEndTime, err := strconv.ParseBool(getEnv("QUERY_CLAMP_END_TIME", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid QUERY_CLAMP_END_TIME: %w", err)
+	}
+
+	scanBudgets, err := openobserve.ParseScanBudgets(os.Getenv("QUERY_SCAN_BUDGETS"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid QUERY_SCAN_BUDGETS: %w", err)
+	}
+	scanBudgetWindow, err := getEnvDuration("QUERY_SCAN_BUDGET_WINDOW", openobserve.DefaultScanBudgetWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAuth := APIAuth{
+		Tokens:      splitList(os.Getenv("API_AUTH_TOKENS")),
+		HMACSecret:  []byte(os.Getenv("API_AUTH_HMAC_SECRET")),
+		AdminTokens: splitList(os.Getenv("ADMIN_API_TOKENS")),
+	}
+	if apiAuth.MaxClockSkew, err = getEnvDuration("API_AUTH_MAX_CLOCK_SKEW", DefaultMaxClockSkew); err != nil {
+		return nil, err
+	}
+
+	adminOrgs := splitList(os.Getenv("ADMIN_ORGS"))
+	adminOrgConcurrency, err := getEnvInt("ADMIN_ORG_CONCURRENCY", openobserve.DefaultOrgConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := TenantSettings{Header: getEnv("TENANT_HEADER", DefaultTenantHeader)}
+	if path := os.Getenv("TENANTS_FILE"); path != "" {
+		if tenants.Tenants, err = LoadTenants(path, openObserveStream, allowedStreams); err != nil {
+			return nil, fmt.Errorf("invalid TENANTS_FILE: %w", err)
+		}
+	}
+
+	trustedProxies, err := ParseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
 
 	return &Config{
 		ServerPort:              serverPort,
+		GRPCPort:                grpcPort,
 		OpenObserveURL:          openObserveURL,
 		OpenObserveOrg:          openObserveOrg,
 		OpenObserveStream:       openObserveStream,
@@ -83,13 +525,133 @@ func LoadConfig() (*Config, error) {
 		OpenObserveUser:         openObserveUser,
 		OpenObservePassword:     openObservePassword,
 		ObserverURL:             observerURL,
+		DefaultedTarget:         defaultedTarget,
 		LogLevel:                logLevel,
+		LogFieldMapping:         logFieldMapping,
+		LogTimestampFormat:      logTimestampFormat,
+		StreamPollInterval:      streamPollInterval,
+		StreamHeartbeatInterval: streamHeartbeatInterval,
+		StreamBufferSize:        streamBufferSize,
+		StreamWriteTimeout:      streamWriteTimeout,
+		StreamIdleTimeout:       streamIdleTimeout,
+		StreamMaxDuration:       streamMaxDuration,
+		StreamDrainTimeout:      streamDrainTimeout,
+		MaxStreams:              maxStreams,
+		NearLimitRatio:          nearLimitRatio,
+		AtTimestampEpsilon:      atTimestampEpsilon,
+		EmptyResultNotFound:     emptyResultNotFound,
+		SeverityLevels:          severityLevels,
+		AcceptedLogLevels:       acceptedLogLevels,
+		LenientLogLevels:        lenientLogLevels,
+		RequestTimeout:          requestTimeout,
+		PercentileFields:        percentileFields,
+		LabelSelectorKeys:       labelSelectorKeys,
+		MaxAlertWindow:          maxAlertWindow,
+		FallbackStreams:         fallbackStreams,
+		TimestampField:          timestampField,
+		TrustedProxies:          trustedProxies,
+		StreamField:             streamField,
+		EventTimeField:          eventTimeField,
+		VersionField:            versionField,
+		CorrelationField:        correlationField,
+		MessageField:            messageField,
+		LevelField:              levelField,
+		LevelFromMessage:        levelFromMessage,
+		LevelPattern:            levelPattern,
+		SeverityClasses:         severityClasses,
+		AlertsEnabled:           alertsEnabled,
+		AdminOrgs:               adminOrgs,
+		AdminOrgConcurrency:     adminOrgConcurrency,
+		MaxComponentIDs:         maxComponentIDs,
+		DefaultQueryWindow:      defaultQueryWindow,
+		DefaultSortOrder:        defaultSortOrder,
+		SchemaCacheTTL:          schemaCacheTTL,
+		BestEffortTimeout:       bestEffortTimeout,
+		ClockSkewTolerance:      clockSkewTolerance,
+		ClampEndTime:            clampEndTime,
+		ScanBudgets:             scanBudgets,
+		ScanBudgetWindow:        scanBudgetWindow,
+		QueryDedupWindow:        queryDedupWindow,
+		MaxScanBytes:            int64(maxScanMB) << 20,
+		KeepAliveInterval:       keepAliveInterval,
+		AllowedStreams:          allowedStreams,
+		StripANSI:               stripANSI,
+		OpenObserveHeaders:      openObserveHeaders,
+		QueryRetry:              queryRetry,
+		AlertRetry:              alertRetry,
+		EmptyResultRetry:        emptyResultRetry,
+		UIURL:                   uiURL,
+		LoginURL:                loginURL,
+		LoginClientID:           os.Getenv("OPENOBSERVE_LOGIN_CLIENT_ID"),
+		ResultsDir:              os.Getenv("RESULTS_DIR"),
+		ResultTTL:               resultTTL,
+		QueryTemplatesFile:      os.Getenv("QUERY_TEMPLATES_FILE"),
+		DeploymentEventsURL:     deploymentEventsURL,
+		ExportBucket:            exportBucket,
+		APIAuth:                 apiAuth,
+		Tenants:                 tenants,
 	}, nil
 }
 
+// splitList splits a comma-separated list, dropping empty entries.
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+// getEnvDuration parses a positive duration (e.g. "15s") from the environment.
+func getEnvDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive duration such as 15s", key, value)
+	}
+	return d, nil
+}
+
+// getEnvInt parses a positive integer from the environment.
+// getEnvRetryPolicy reads a retry budget: the number of retries, which may be zero, and the
+// initial backoff between them.
+func getEnvRetryPolicy(retriesKey, backoffKey string, defaultValue openobserve.RetryPolicy) (openobserve.RetryPolicy, error) {
+	policy := defaultValue
+	if value := os.Getenv(retriesKey); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return policy, fmt.Errorf("invalid %s %q: must be a non-negative integer", retriesKey, value)
+		}
+		policy.Retries = n
+	}
+	backoff, err := getEnvDuration(backoffKey, defaultValue.Backoff)
+	if err != nil {
+		return policy, err
+	}
+	policy.Backoff = backoff
+	return policy, nil
+}
+
+func getEnvInt(key string, defaultValue int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be a positive integer", key, value)
+	}
+	return n, nil
+}
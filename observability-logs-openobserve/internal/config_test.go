@@ -6,7 +6,13 @@ package app
 import (
 	"log/slog"
 	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
 )
 
 // setEnvVars sets multiple environment variables and returns a cleanup function.
@@ -194,3 +200,1260 @@ func TestGetEnv(t *testing.T) {
 		t.Errorf("expected 'default', got %q", got)
 	}
 }
+
+func TestLoadConfig_LogFieldMapping(t *testing.T) {
+	vars := validEnvVars()
+	vars["LOG_FIELD_MAPPING"] = "log=message, timestamp=timestamp_ms"
+	vars["LOG_TIMESTAMP_FORMAT"] = "UNIX_MS"
+	setEnvVars(t, vars)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.LogFieldMapping["log"] != "message" || cfg.LogFieldMapping["timestamp"] != "timestamp_ms" {
+		t.Errorf("unexpected LogFieldMapping: %v", cfg.LogFieldMapping)
+	}
+	if cfg.LogTimestampFormat != TimestampFormatUnixMs {
+		t.Errorf("expected LogTimestampFormat unix_ms, got %s", cfg.LogTimestampFormat)
+	}
+}
+
+func TestLoadConfig_InvalidLogFieldMapping(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		val  string
+	}{
+		{"unknown field", "LOG_FIELD_MAPPING", "message=log"},
+		{"missing target", "LOG_FIELD_MAPPING", "log="},
+		{"unknown timestamp format", "LOG_TIMESTAMP_FORMAT", "epoch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars := validEnvVars()
+			vars[tt.key] = tt.val
+			setEnvVars(t, vars)
+
+			if _, err := LoadConfig(); err == nil {
+				t.Fatalf("expected error for %s=%q, got nil", tt.key, tt.val)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_StreamSettings(t *testing.T) {
+	vars := validEnvVars()
+	vars["STREAM_POLL_INTERVAL"] = "500ms"
+	vars["STREAM_HEARTBEAT_INTERVAL"] = "30s"
+	vars["STREAM_BUFFER_SIZE"] = "50"
+	vars["STREAM_WRITE_TIMEOUT"] = "5s"
+	vars["STREAM_IDLE_TIMEOUT"] = "10m"
+	vars["STREAM_MAX_DURATION"] = "1h"
+	setEnvVars(t, vars)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StreamPollInterval != 500*time.Millisecond {
+		t.Errorf("expected StreamPollInterval 500ms, got %s", cfg.StreamPollInterval)
+	}
+	if cfg.StreamHeartbeatInterval != 30*time.Second {
+		t.Errorf("expected StreamHeartbeatInterval 30s, got %s", cfg.StreamHeartbeatInterval)
+	}
+	if cfg.StreamBufferSize != 50 {
+		t.Errorf("expected StreamBufferSize 50, got %d", cfg.StreamBufferSize)
+	}
+	if cfg.StreamWriteTimeout != 5*time.Second {
+		t.Errorf("expected StreamWriteTimeout 5s, got %s", cfg.StreamWriteTimeout)
+	}
+	if cfg.StreamIdleTimeout != 10*time.Minute {
+		t.Errorf("expected StreamIdleTimeout 10m, got %s", cfg.StreamIdleTimeout)
+	}
+	if cfg.StreamMaxDuration != time.Hour {
+		t.Errorf("expected StreamMaxDuration 1h, got %s", cfg.StreamMaxDuration)
+	}
+}
+
+func TestLoadConfig_StreamSettingsDefaults(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StreamHeartbeatInterval != 15*time.Second {
+		t.Errorf("expected default StreamHeartbeatInterval 15s, got %s", cfg.StreamHeartbeatInterval)
+	}
+}
+
+func TestLoadConfig_AtTimestampEpsilon(t *testing.T) {
+	vars := validEnvVars()
+	vars["AT_TIMESTAMP_EPSILON"] = "10us"
+	setEnvVars(t, vars)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AtTimestampEpsilon != 10*time.Microsecond {
+		t.Errorf("expected AtTimestampEpsilon 10us, got %s", cfg.AtTimestampEpsilon)
+	}
+}
+
+func TestLoadConfig_InvalidTuningSettings(t *testing.T) {
+	tests := []struct {
+		key string
+		val string
+	}{
+		{"STREAM_POLL_INTERVAL", "soon"},
+		{"STREAM_HEARTBEAT_INTERVAL", "-1s"},
+		{"STREAM_BUFFER_SIZE", "0"},
+		{"STREAM_WRITE_TIMEOUT", "10"},
+		{"STREAM_IDLE_TIMEOUT", "0s"},
+		{"STREAM_MAX_DURATION", "-1h"},
+		{"AT_TIMESTAMP_EPSILON", "0s"},
+		{"REQUEST_TIMEOUT", "forever"},
+		{"ALERT_MAX_WINDOW", "0s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			vars := validEnvVars()
+			vars[tt.key] = tt.val
+			setEnvVars(t, vars)
+
+			if _, err := LoadConfig(); err == nil {
+				t.Fatalf("expected error for %s=%q, got nil", tt.key, tt.val)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_NearLimitRatio(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.NearLimitRatio != 0.9 {
+		t.Errorf("expected default NearLimitRatio 0.9, got %v", cfg.NearLimitRatio)
+	}
+
+	for _, value := range []string{"0", "1.5", "most"} {
+		vars["RESULT_NEAR_LIMIT_RATIO"] = value
+		setEnvVars(t, vars)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for RESULT_NEAR_LIMIT_RATIO=%q, got nil", value)
+		}
+	}
+}
+
+func TestLoadConfig_EmptyResultStatus(t *testing.T) {
+	tests := []struct {
+		value        string
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{"", false, false},
+		{"200", false, false},
+		{"404", true, false},
+		{"204", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("EMPTY_RESULT_STATUS="+tt.value, func(t *testing.T) {
+			vars := validEnvVars()
+			vars["EMPTY_RESULT_STATUS"] = tt.value
+			setEnvVars(t, vars)
+
+			cfg, err := LoadConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && cfg.EmptyResultNotFound != tt.wantNotFound {
+				t.Errorf("expected EmptyResultNotFound %v, got %v", tt.wantNotFound, cfg.EmptyResultNotFound)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_SeverityLevels(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.SeverityLevels) != len(DefaultSeverityLevels) {
+		t.Errorf("expected default severity levels, got %v", cfg.SeverityLevels)
+	}
+
+	vars["SEVERITY_LEVELS"] = "trace,debug,info,warn,error"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.SeverityLevels) != 5 || cfg.SeverityLevels[0] != "TRACE" {
+		t.Errorf("unexpected severity levels: %v", cfg.SeverityLevels)
+	}
+
+	vars["SEVERITY_LEVELS"] = "info,info"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for duplicate severity levels, got nil")
+	}
+}
+
+func TestLoadConfig_SeverityClasses(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.SeverityClasses != nil {
+		t.Errorf("expected no severity classes by default, got %v", cfg.SeverityClasses)
+	}
+
+	vars["SEVERITY_CLASSES"] = "error=critical,*=normal"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.SeverityClasses["ERROR"] != "critical" || cfg.SeverityClasses["*"] != "normal" {
+		t.Errorf("unexpected severity classes: %v", cfg.SeverityClasses)
+	}
+
+	vars["SEVERITY_CLASSES"] = "error"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a malformed severity class, got nil")
+	}
+}
+
+func TestLoadConfig_RequestTimeout(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RequestTimeout != 10*time.Second {
+		t.Errorf("expected default RequestTimeout 10s, got %s", cfg.RequestTimeout)
+	}
+
+	vars["REQUEST_TIMEOUT"] = "45s"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.RequestTimeout != 45*time.Second {
+		t.Errorf("expected RequestTimeout 45s, got %s", cfg.RequestTimeout)
+	}
+}
+
+func TestLoadConfig_PercentileFields(t *testing.T) {
+	vars := validEnvVars()
+	vars["PERCENTILE_FIELDS"] = "latency_ms, duration_ms,"
+	setEnvVars(t, vars)
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.PercentileFields) != 2 || cfg.PercentileFields[0] != "latency_ms" || cfg.PercentileFields[1] != "duration_ms" {
+		t.Errorf("unexpected percentile fields: %v", cfg.PercentileFields)
+	}
+
+	vars["PERCENTILE_FIELDS"] = "latency_ms,count(*)"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for invalid field name, got nil")
+	}
+}
+
+func TestLoadConfig_FallbackStreams(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.FallbackStreams) != 0 {
+		t.Errorf("expected no fallback streams by default, got %v", cfg.FallbackStreams)
+	}
+
+	vars["OPENOBSERVE_FALLBACK_STREAMS"] = "combined, archive"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.FallbackStreams) != 2 || cfg.FallbackStreams[0] != "combined" || cfg.FallbackStreams[1] != "archive" {
+		t.Errorf("unexpected fallback streams: %v", cfg.FallbackStreams)
+	}
+}
+
+func TestLoadConfig_TimestampField(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.TimestampField != "_timestamp" {
+		t.Errorf("expected default timestamp field _timestamp, got %q", cfg.TimestampField)
+	}
+
+	vars["OPENOBSERVE_TIMESTAMP_FIELD"] = "event_time"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.TimestampField != "event_time" {
+		t.Errorf("expected timestamp field event_time, got %q", cfg.TimestampField)
+	}
+
+	vars["OPENOBSERVE_TIMESTAMP_FIELD"] = "event_time DESC"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for invalid timestamp field, got nil")
+	}
+}
+
+func TestLoadConfig_TrustedProxies(t *testing.T) {
+	vars := validEnvVars()
+	vars["TRUSTED_PROXIES"] = "10.0.0.0/8,192.168.0.1"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.TrustedProxies) != 2 {
+		t.Errorf("expected 2 trusted proxies, got %v", cfg.TrustedProxies)
+	}
+
+	vars["TRUSTED_PROXIES"] = "10.0.0.0/40"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for an invalid CIDR, got nil")
+	}
+}
+
+func TestLoadConfig_StreamField(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StreamField != "stream" {
+		t.Errorf("expected default stream field stream, got %q", cfg.StreamField)
+	}
+
+	vars["LOG_STREAM_FIELD"] = "log_stream"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StreamField != "log_stream" {
+		t.Errorf("expected stream field log_stream, got %q", cfg.StreamField)
+	}
+
+	vars["LOG_STREAM_FIELD"] = "log-stream"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for an invalid stream field, got nil")
+	}
+}
+
+func TestLoadConfig_VersionField(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.VersionField != openobserve.DefaultVersionField {
+		t.Errorf("expected default version field %s, got %q", openobserve.DefaultVersionField, cfg.VersionField)
+	}
+
+	t.Setenv("LOG_VERSION_FIELD", "kubernetes_labels_app_version")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.VersionField != "kubernetes_labels_app_version" {
+		t.Errorf("expected version field kubernetes_labels_app_version, got %q", cfg.VersionField)
+	}
+
+	t.Setenv("LOG_VERSION_FIELD", "app.version")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for an invalid version field, got nil")
+	}
+}
+
+func TestLoadConfig_CorrelationField(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.CorrelationField != openobserve.DefaultCorrelationField {
+		t.Errorf("expected default correlation field %s, got %q", openobserve.DefaultCorrelationField, cfg.CorrelationField)
+	}
+
+	t.Setenv("LOG_CORRELATION_FIELD", "x_request_id")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.CorrelationField != "x_request_id" {
+		t.Errorf("expected correlation field x_request_id, got %q", cfg.CorrelationField)
+	}
+
+	t.Setenv("LOG_CORRELATION_FIELD", "x-request-id")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for an invalid correlation field, got nil")
+	}
+}
+
+func TestLoadConfig_MessageField(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MessageField != openobserve.DefaultMessageField {
+		t.Errorf("expected default message field %s, got %q", openobserve.DefaultMessageField, cfg.MessageField)
+	}
+
+	t.Setenv("LOG_MESSAGE_FIELD", "message")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MessageField != "message" {
+		t.Errorf("expected message field message, got %q", cfg.MessageField)
+	}
+
+	for _, field := range []string{"log.message", openobserve.DefaultTimestampField} {
+		t.Setenv("LOG_MESSAGE_FIELD", field)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for message field %q, got nil", field)
+		}
+	}
+}
+
+func TestLoadConfig_EventTimeField(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.EventTimeField != "" {
+		t.Errorf("expected no event time field by default, got %q", cfg.EventTimeField)
+	}
+
+	t.Setenv("LOG_EVENT_TIME_FIELD", "event_time")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.EventTimeField != "event_time" {
+		t.Errorf("expected event time field event_time, got %q", cfg.EventTimeField)
+	}
+
+	for _, value := range []string{"event-time", "_timestamp"} {
+		t.Setenv("LOG_EVENT_TIME_FIELD", value)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for LOG_EVENT_TIME_FIELD %q, got nil", value)
+		}
+	}
+}
+
+func TestLoadConfig_LabelSelectorKeys(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	t.Setenv("LABEL_SELECTOR_KEYS", "team, app.kubernetes.io/name,")
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"team", "app.kubernetes.io/name"}; !reflect.DeepEqual(cfg.LabelSelectorKeys, want) {
+		t.Errorf("expected label selector keys %v, got %v", want, cfg.LabelSelectorKeys)
+	}
+
+	t.Setenv("LABEL_SELECTOR_KEYS", "team name")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for an invalid label key, got nil")
+	}
+}
+
+func TestLoadConfig_DefaultedTarget(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"OPENOBSERVE_ORG", "OPENOBSERVE_STREAM"}; !reflect.DeepEqual(cfg.DefaultedTarget, want) {
+		t.Errorf("expected defaulted target %v, got %v", want, cfg.DefaultedTarget)
+	}
+
+	t.Setenv("OPENOBSERVE_STRICT_TARGET", "true")
+	t.Setenv("OPENOBSERVE_ORG", "team-a")
+	if _, err := LoadConfig(); err == nil || !strings.Contains(err.Error(), "OPENOBSERVE_STREAM") {
+		t.Fatalf("expected error naming OPENOBSERVE_STREAM in strict mode, got %v", err)
+	}
+
+	t.Setenv("OPENOBSERVE_STREAM", "app_logs")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.DefaultedTarget) != 0 {
+		t.Errorf("expected no defaulted target, got %v", cfg.DefaultedTarget)
+	}
+
+	t.Setenv("OPENOBSERVE_STRICT_TARGET", "maybe")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for an invalid OPENOBSERVE_STRICT_TARGET, got nil")
+	}
+}
+
+func TestLoadConfig_QueryDedupWindow(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	t.Setenv("QUERY_DEDUP_WINDOW", "2s")
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.QueryDedupWindow != 2*time.Second {
+		t.Errorf("expected 2s, got %v", cfg.QueryDedupWindow)
+	}
+
+	for _, value := range []string{"soon", "-1s"} {
+		t.Setenv("QUERY_DEDUP_WINDOW", value)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected an error for QUERY_DEDUP_WINDOW %q", value)
+		}
+	}
+}
+
+func TestLoadConfig_QueryMaxScanMB(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxScanBytes != 0 {
+		t.Errorf("expected the scan limit to be disabled by default, got %d", cfg.MaxScanBytes)
+	}
+
+	t.Setenv("QUERY_MAX_SCAN_MB", "512")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxScanBytes != 512<<20 {
+		t.Errorf("expected 512 MiB, got %d", cfg.MaxScanBytes)
+	}
+
+	t.Setenv("QUERY_MAX_SCAN_MB", "-1")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected an error for a negative QUERY_MAX_SCAN_MB")
+	}
+}
+
+func TestLoadConfig_APIAuth(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.APIAuth.Enabled() {
+		t.Error("expected authentication to be disabled by default")
+	}
+
+	t.Setenv("API_AUTH_TOKENS", "first, second")
+	t.Setenv("API_AUTH_HMAC_SECRET", "s3cret")
+	t.Setenv("API_AUTH_MAX_CLOCK_SKEW", "1m")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.APIAuth.Tokens) != 2 || cfg.APIAuth.Tokens[1] != "second" {
+		t.Errorf("unexpected tokens: %v", cfg.APIAuth.Tokens)
+	}
+	if string(cfg.APIAuth.HMACSecret) != "s3cret" || cfg.APIAuth.MaxClockSkew != time.Minute {
+		t.Errorf("unexpected HMAC settings: %+v", cfg.APIAuth)
+	}
+
+	t.Setenv("API_AUTH_MAX_CLOCK_SKEW", "soon")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected an error for an invalid API_AUTH_MAX_CLOCK_SKEW")
+	}
+}
+
+func TestLoadConfig_LogLevelValidation(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AcceptedLogLevels != nil || cfg.LenientLogLevels {
+		t.Errorf("expected default level validation, got %v lenient=%v", cfg.AcceptedLogLevels, cfg.LenientLogLevels)
+	}
+
+	vars["LOG_LEVEL_ALLOWLIST"] = "INFO, NOTICE,"
+	vars["LOG_LEVEL_VALIDATION"] = "warn"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.AcceptedLogLevels) != 2 || cfg.AcceptedLogLevels[1] != "NOTICE" || !cfg.LenientLogLevels {
+		t.Errorf("unexpected level validation: %v lenient=%v", cfg.AcceptedLogLevels, cfg.LenientLogLevels)
+	}
+
+	vars["LOG_LEVEL_VALIDATION"] = "off"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid LOG_LEVEL_VALIDATION, got nil")
+	}
+}
+
+func TestLoadConfig_KeepAliveInterval(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.KeepAliveInterval != 0 {
+		t.Errorf("expected keep-alive to be disabled by default, got %v", cfg.KeepAliveInterval)
+	}
+
+	vars["KEEPALIVE_INTERVAL"] = "1m"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.KeepAliveInterval != time.Minute {
+		t.Errorf("expected 1m, got %v", cfg.KeepAliveInterval)
+	}
+
+	vars["KEEPALIVE_INTERVAL"] = "-1s"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for negative KEEPALIVE_INTERVAL, got nil")
+	}
+}
+
+func TestLoadConfig_AllowedStreams(t *testing.T) {
+	vars := validEnvVars()
+	vars["ALLOWED_STREAMS"] = "archive, team-a/app_logs"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []openobserve.StreamRef{{Name: "archive"}, {Folder: "team-a", Name: "app_logs"}}
+	if !reflect.DeepEqual(cfg.AllowedStreams, want) {
+		t.Errorf("expected %v, got %v", want, cfg.AllowedStreams)
+	}
+
+	vars["ALLOWED_STREAMS"] = "team-a/"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for malformed ALLOWED_STREAMS, got nil")
+	}
+}
+
+func TestLoadConfig_StripANSI(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StripANSI {
+		t.Error("expected ANSI stripping to be disabled by default")
+	}
+
+	vars["LOG_STRIP_ANSI"] = "true"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || !cfg.StripANSI {
+		t.Errorf("expected ANSI stripping to be enabled, got %v", err)
+	}
+
+	vars["LOG_STRIP_ANSI"] = "sometimes"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid LOG_STRIP_ANSI, got nil")
+	}
+}
+
+func TestLoadConfig_AlertsEnabled(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cfg.AlertsEnabled {
+		t.Error("expected alert endpoints to be enabled by default")
+	}
+
+	vars["ALERTS_ENABLED"] = "false"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.AlertsEnabled {
+		t.Errorf("expected alert endpoints to be disabled, got %v", err)
+	}
+
+	vars["ALERTS_ENABLED"] = "never"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid ALERTS_ENABLED, got nil")
+	}
+}
+
+func TestLoadConfig_AdminSearch(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.AdminOrgs) != 0 || len(cfg.APIAuth.AdminTokens) != 0 || cfg.AdminOrgConcurrency != openobserve.DefaultOrgConcurrency {
+		t.Errorf("expected admin search to be disabled by default, got %+v", cfg)
+	}
+
+	vars["ADMIN_ORGS"] = "team-a, team-b"
+	vars["ADMIN_API_TOKENS"] = "admin-token"
+	vars["ADMIN_ORG_CONCURRENCY"] = "2"
+	setEnvVars(t, vars)
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.AdminOrgs) != 2 || cfg.AdminOrgs[1] != "team-b" || cfg.APIAuth.AdminTokens[0] != "admin-token" || cfg.AdminOrgConcurrency != 2 {
+		t.Errorf("unexpected admin search settings: %v %v %d", cfg.AdminOrgs, cfg.APIAuth.AdminTokens, cfg.AdminOrgConcurrency)
+	}
+
+	vars["ADMIN_ORG_CONCURRENCY"] = "0"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid ADMIN_ORG_CONCURRENCY, got nil")
+	}
+}
+
+func TestLoadConfig_MaxComponentIDs(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxComponentIDs != openobserve.DefaultMaxComponentIDs {
+		t.Errorf("expected the default maximum, got %d", cfg.MaxComponentIDs)
+	}
+
+	vars["QUERY_MAX_COMPONENT_IDS"] = "20"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.MaxComponentIDs != 20 {
+		t.Errorf("expected a maximum of 20, got %v", err)
+	}
+
+	vars["QUERY_MAX_COMPONENT_IDS"] = "-1"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid QUERY_MAX_COMPONENT_IDS, got nil")
+	}
+}
+
+func TestLoadConfig_DefaultQueryWindow(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DefaultQueryWindow != openobserve.DefaultQueryWindow {
+		t.Errorf("expected the default window, got %s", cfg.DefaultQueryWindow)
+	}
+
+	vars["QUERY_DEFAULT_WINDOW"] = "1h"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.DefaultQueryWindow != time.Hour {
+		t.Errorf("expected a 1h window, got %v", err)
+	}
+
+	vars["QUERY_DEFAULT_WINDOW"] = "0s"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid QUERY_DEFAULT_WINDOW, got nil")
+	}
+}
+
+func TestLoadConfig_DefaultSortOrder(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DefaultSortOrder != openobserve.DefaultSortOrder {
+		t.Errorf("expected the default sort order, got %q", cfg.DefaultSortOrder)
+	}
+
+	vars["QUERY_DEFAULT_SORT_ORDER"] = "ASC"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.DefaultSortOrder != "asc" {
+		t.Errorf("expected ascending order, got %+v, %v", cfg, err)
+	}
+
+	vars["QUERY_DEFAULT_SORT_ORDER"] = "oldest"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid QUERY_DEFAULT_SORT_ORDER, got nil")
+	}
+}
+
+func TestLoadConfig_SchemaCacheTTL(t *testing.T) {
+	vars := validEnvVars()
+	vars["SCHEMA_CACHE_TTL"] = "5m"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.SchemaCacheTTL != 5*time.Minute {
+		t.Errorf("expected 5m, got %v", cfg.SchemaCacheTTL)
+	}
+
+	vars["SCHEMA_CACHE_TTL"] = "soon"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for invalid SCHEMA_CACHE_TTL, got nil")
+	}
+}
+
+func TestLoadConfig_BestEffortTimeout(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.BestEffortTimeout != openobserve.DefaultBestEffortTimeout {
+		t.Errorf("expected the default best-effort timeout, got %v", cfg.BestEffortTimeout)
+	}
+
+	vars["QUERY_BEST_EFFORT_TIMEOUT"] = "0s"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a zero QUERY_BEST_EFFORT_TIMEOUT, got nil")
+	}
+}
+
+func TestLoadConfig_MaxStreams(t *testing.T) {
+	vars := validEnvVars()
+	vars["STREAM_MAX_CONNECTIONS"] = "200"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxStreams != 200 {
+		t.Errorf("expected 200, got %d", cfg.MaxStreams)
+	}
+
+	vars["STREAM_MAX_CONNECTIONS"] = "-1"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a negative STREAM_MAX_CONNECTIONS, got nil")
+	}
+}
+
+func TestLoadConfig_LevelFromMessage(t *testing.T) {
+	vars := validEnvVars()
+	vars["LOG_LEVEL_FROM_MESSAGE"] = "true"
+	vars["LOG_LEVEL_PATTERN"] = `level=(\w+)`
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.LevelField != openobserve.DefaultLevelField || !cfg.LevelFromMessage || cfg.LevelPattern.String() != `level=(\w+)` {
+		t.Errorf("unexpected level settings: %q, %v, %v", cfg.LevelField, cfg.LevelFromMessage, cfg.LevelPattern)
+	}
+
+	for name, overrides := range map[string]map[string]string{
+		"pattern without a group":  {"LOG_LEVEL_PATTERN": `level=\w+`},
+		"pattern without message":  {"LOG_LEVEL_FROM_MESSAGE": "false"},
+		"malformed pattern":        {"LOG_LEVEL_PATTERN": `level=(\w+`},
+		"invalid level field name": {"LOG_LEVEL_FIELD": "log level"},
+	} {
+		vars := validEnvVars()
+		vars["LOG_LEVEL_FROM_MESSAGE"] = "true"
+		vars["LOG_LEVEL_PATTERN"] = `level=(\w+)`
+		for key, value := range overrides {
+			vars[key] = value
+		}
+		setEnvVars(t, vars)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
+
+func TestLoadConfig_EmptyResultRetry(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.EmptyResultRetry != (openobserve.RetryPolicy{Backoff: 500 * time.Millisecond}) {
+		t.Errorf("expected empty results not to be retried by default, got %+v", cfg.EmptyResultRetry)
+	}
+
+	vars["QUERY_EMPTY_RETRIES"] = "2"
+	vars["QUERY_EMPTY_RETRY_DELAY"] = "200ms"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.EmptyResultRetry != (openobserve.RetryPolicy{Retries: 2, Backoff: 200 * time.Millisecond}) {
+		t.Errorf("unexpected empty result retry: %+v, %v", cfg.EmptyResultRetry, err)
+	}
+
+	for key, value := range map[string]string{"QUERY_EMPTY_RETRIES": "-1", "QUERY_EMPTY_RETRY_DELAY": "0s"} {
+		vars := validEnvVars()
+		vars[key] = value
+		setEnvVars(t, vars)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for %s=%s, got nil", key, value)
+		}
+	}
+}
+
+func TestLoadConfig_UIURL(t *testing.T) {
+	vars := validEnvVars()
+	vars["OPENOBSERVE_UI_URL"] = "https://openobserve.example.com"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.UIURL != "https://openobserve.example.com" {
+		t.Errorf("expected the UI URL to be set, got %q", cfg.UIURL)
+	}
+
+	vars["OPENOBSERVE_UI_URL"] = "openobserve.example.com"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for an OPENOBSERVE_UI_URL without scheme, got nil")
+	}
+}
+
+func TestLoadConfig_LoginURL(t *testing.T) {
+	vars := validEnvVars()
+	vars["OPENOBSERVE_LOGIN_URL"] = "https://dex.example.com/token"
+	vars["OPENOBSERVE_LOGIN_CLIENT_ID"] = "logs-adapter"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.LoginURL != "https://dex.example.com/token" || cfg.LoginClientID != "logs-adapter" {
+		t.Errorf("expected the login endpoint to be set, got %q and %q", cfg.LoginURL, cfg.LoginClientID)
+	}
+
+	vars["OPENOBSERVE_LOGIN_URL"] = "dex.example.com/token"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for an OPENOBSERVE_LOGIN_URL without scheme, got nil")
+	}
+}
+
+func TestLoadConfig_QueryTemplatesFile(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.QueryTemplatesFile != "" {
+		t.Errorf("expected query templates to be kept in memory, got %q", cfg.QueryTemplatesFile)
+	}
+
+	vars["QUERY_TEMPLATES_FILE"] = "/var/lib/adapter/templates.json"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.QueryTemplatesFile != "/var/lib/adapter/templates.json" {
+		t.Errorf("unexpected query templates file: %+v, %v", cfg, err)
+	}
+}
+
+func TestLoadConfig_ScanBudgets(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.ScanBudgets) != 0 || cfg.ScanBudgetWindow != openobserve.DefaultScanBudgetWindow {
+		t.Errorf("expected no scan budgets with the default window, got %v and %v", cfg.ScanBudgets, cfg.ScanBudgetWindow)
+	}
+
+	vars["QUERY_SCAN_BUDGETS"] = "default=10240,*=1024"
+	vars["QUERY_SCAN_BUDGET_WINDOW"] = "30m"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.ScanBudgets["default"] != 10240 || cfg.ScanBudgets["*"] != 1024 || cfg.ScanBudgetWindow != 30*time.Minute {
+		t.Errorf("unexpected scan budget settings: %+v, %v", cfg, err)
+	}
+
+	vars["QUERY_SCAN_BUDGETS"] = "default=unlimited"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a malformed QUERY_SCAN_BUDGETS, got nil")
+	}
+}
+
+func TestLoadConfig_GRPCPort(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.GRPCPort != "" {
+		t.Errorf("expected the gRPC server to be disabled, got port %q", cfg.GRPCPort)
+	}
+
+	vars["GRPC_PORT"] = "9191"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.GRPCPort != "9191" {
+		t.Errorf("unexpected gRPC port: %+v, %v", cfg, err)
+	}
+
+	for _, port := range []string{"grpc", "9098"} {
+		vars["SERVER_PORT"] = "9098"
+		vars["GRPC_PORT"] = port
+		setEnvVars(t, vars)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for GRPC_PORT %q, got nil", port)
+		}
+	}
+}
+
+func TestLoadConfig_ClockSkew(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ClockSkewTolerance != DefaultClockSkewTolerance || cfg.ClampEndTime {
+		t.Errorf("expected the default tolerance without clamping, got %v and %v", cfg.ClockSkewTolerance, cfg.ClampEndTime)
+	}
+
+	vars["QUERY_CLOCK_SKEW_TOLERANCE"] = "5m"
+	vars["QUERY_CLAMP_END_TIME"] = "true"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.ClockSkewTolerance != 5*time.Minute || !cfg.ClampEndTime {
+		t.Errorf("unexpected clock skew settings: %+v, %v", cfg, err)
+	}
+
+	vars["QUERY_CLAMP_END_TIME"] = "sometimes"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a malformed QUERY_CLAMP_END_TIME, got nil")
+	}
+}
+
+func TestLoadConfig_StreamDrainTimeout(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StreamDrainTimeout != DefaultStreamDrainTimeout {
+		t.Errorf("expected the default drain timeout, got %v", cfg.StreamDrainTimeout)
+	}
+
+	vars["STREAM_DRAIN_TIMEOUT"] = "20s"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.StreamDrainTimeout != 20*time.Second {
+		t.Errorf("unexpected drain timeout: %+v, %v", cfg, err)
+	}
+
+	vars["STREAM_DRAIN_TIMEOUT"] = "soon"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a malformed STREAM_DRAIN_TIMEOUT, got nil")
+	}
+}
+
+func TestLoadConfig_Tenants(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Tenants.Enabled() || cfg.Tenants.Header != DefaultTenantHeader {
+		t.Errorf("expected multi-tenancy to be disabled by default, got %+v", cfg.Tenants)
+	}
+
+	path := filepath.Join(t.TempDir(), "tenants.json")
+	if err := os.WriteFile(path, []byte(`{"payments": {"org": "payments", "labelSelectors": {"team": "payments"}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	vars["TENANTS_FILE"] = path
+	vars["TENANT_HEADER"] = "X-Team"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Tenants.Header != "X-Team" || cfg.Tenants.Tenants["payments"].Org != "payments" || cfg.Tenants.Tenants["payments"].LabelSelectors["team"] != "payments" {
+		t.Errorf("unexpected tenants: %+v", cfg.Tenants)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"payments": {"stream": "a/b/c"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for an invalid tenant stream, got nil")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"payments": {"stream": "other_logs"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a tenant stream that is not allowed, got nil")
+	}
+	vars["ALLOWED_STREAMS"] = "other_logs"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err != nil {
+		t.Errorf("expected an allowed tenant stream to be accepted, got %v", err)
+	}
+}
+
+func TestLoadConfig_Results(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ResultsDir != "" || cfg.ResultTTL != DefaultResultTTL {
+		t.Errorf("expected persisting results to be disabled with the default TTL, got %q and %v", cfg.ResultsDir, cfg.ResultTTL)
+	}
+
+	vars["RESULTS_DIR"] = "/var/lib/adapter/results"
+	vars["RESULTS_TTL"] = "1h"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.ResultsDir != "/var/lib/adapter/results" || cfg.ResultTTL != time.Hour {
+		t.Errorf("unexpected results settings: %+v, %v", cfg, err)
+	}
+
+	vars["RESULTS_TTL"] = "0s"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for zero RESULTS_TTL, got nil")
+	}
+}
+
+func TestLoadConfig_OpenObserveHeaders(t *testing.T) {
+	vars := validEnvVars()
+	vars["OPENOBSERVE_HEADERS"] = "X-Scope-OrgID=team-a"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.OpenObserveHeaders.Get("X-Scope-OrgID") != "team-a" {
+		t.Errorf("unexpected headers: %v", cfg.OpenObserveHeaders)
+	}
+
+	vars["OPENOBSERVE_HEADERS"] = "Authorization=Basic abc"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a reserved header in OPENOBSERVE_HEADERS, got nil")
+	}
+}
+
+func TestLoadConfig_DeploymentEventsURL(t *testing.T) {
+	vars := validEnvVars()
+	vars["DEPLOYMENT_EVENTS_URL"] = "http://openchoreo-api:8080/api/v1/deployments/events"
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DeploymentEventsURL != vars["DEPLOYMENT_EVENTS_URL"] {
+		t.Errorf("unexpected DeploymentEventsURL %q", cfg.DeploymentEventsURL)
+	}
+
+	vars["DEPLOYMENT_EVENTS_URL"] = "openchoreo-api/events"
+	setEnvVars(t, vars)
+	if _, err := LoadConfig(); err == nil {
+		t.Error("expected error for a DEPLOYMENT_EVENTS_URL without scheme, got nil")
+	}
+}
+
+func TestLoadConfig_RetryPolicies(t *testing.T) {
+	vars := validEnvVars()
+	setEnvVars(t, vars)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.QueryRetry != (openobserve.RetryPolicy{Backoff: 250 * time.Millisecond}) || cfg.AlertRetry != (openobserve.RetryPolicy{Retries: 3, Backoff: time.Second}) {
+		t.Errorf("unexpected default retry policies: %+v, %+v", cfg.QueryRetry, cfg.AlertRetry)
+	}
+
+	vars["QUERY_RETRIES"] = "1"
+	vars["ALERT_RETRIES"] = "0"
+	vars["ALERT_RETRY_BACKOFF"] = "5s"
+	setEnvVars(t, vars)
+	if cfg, err = LoadConfig(); err != nil || cfg.QueryRetry.Retries != 1 || cfg.AlertRetry != (openobserve.RetryPolicy{Backoff: 5 * time.Second}) {
+		t.Errorf("unexpected retry policies: %+v, %+v, %v", cfg.QueryRetry, cfg.AlertRetry, err)
+	}
+
+	for key, value := range map[string]string{"ALERT_RETRIES": "-1", "QUERY_RETRY_BACKOFF": "0s"} {
+		vars := validEnvVars()
+		vars[key] = value
+		setEnvVars(t, vars)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("expected error for %s=%s, got nil", key, value)
+		}
+	}
+}
+
+func TestLoadConfig_ExportBucket(t *testing.T) {
+	setEnvVars(t, validEnvVars())
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ExportBucket.Bucket != "" {
+		t.Errorf("expected exporting to object storage to be disabled by default, got %+v", cfg.ExportBucket)
+	}
+
+	t.Setenv("S3_EXPORT_BUCKET", "archive")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected error for a bucket without endpoint and credentials, got nil")
+	}
+
+	t.Setenv("S3_EXPORT_ENDPOINT", "http://minio:9000")
+	t.Setenv("S3_EXPORT_ACCESS_KEY_ID", "key")
+	t.Setenv("S3_EXPORT_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("S3_EXPORT_PREFIX", "logs/")
+	if cfg, err = LoadConfig(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ExportBucket.Bucket != "archive" || cfg.ExportBucket.Endpoint != "http://minio:9000" || cfg.ExportBucket.Prefix != "logs/" {
+		t.Errorf("unexpected export bucket: %+v", cfg.ExportBucket)
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import "net/http"
+
+// UpstreamDiagnostics implements GET /api/v1/diagnostics/openobserve.
+// It reports the number of OpenObserve responses per status code, and of requests that got
+// no response, since the adapter started (see openobserve.UpstreamStatus).
+func (h *LogsHandler) UpstreamDiagnostics(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, h.client.UpstreamStatus())
+}
+
+// StreamDiagnostics implements GET /api/v1/diagnostics/streams.
+// It reports the number of open log streams and the maximum that may be open at once (see
+// ServerOptions.MaxStreams).
+func (h *LogsHandler) StreamDiagnostics(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, h.streams.stats())
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestUpstreamDiagnostics(t *testing.T) {
+	var calls atomic.Int32
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			http.Error(w, "slow down", http.StatusTooManyRequests)
+		case 2:
+			http.Error(w, "boom", http.StatusBadGateway)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(0)}}})
+		}
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+	mux := http.NewServeMux()
+	handler.registerRoutes(mux)
+
+	body := `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/count", strings.NewReader(body))
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/diagnostics/openobserve", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var status openobserve.UpstreamStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if status.Requests != 3 || status.StatusCodes["429"] != 1 || status.StatusCodes["502"] != 1 || status.StatusCodes["200"] != 1 {
+		t.Errorf("unexpected status distribution: %+v", status)
+	}
+	if status.Since.IsZero() {
+		t.Error("expected the start of counting to be reported")
+	}
+}
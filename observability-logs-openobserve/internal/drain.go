@@ -0,0 +1,131 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// serviceUnavailable is the title of 503 responses, which the generated API does not define.
+const serviceUnavailable gen.ErrorResponseTitle = "serviceUnavailable"
+
+// DefaultStreamDrainTimeout is how long Server.Shutdown waits for the open log streams to
+// close, after telling their clients the server is closing, when ServerOptions.StreamDrainTimeout
+// is not set.
+const DefaultStreamDrainTimeout = 5 * time.Second
+
+// streamRetryAfter is the Retry-After, in seconds, of the 503 responses to streams refused
+// because too many are open.
+const streamRetryAfter = 5
+
+var (
+	// errStreamsClosing is returned by streamTracker.add once the server is shutting down.
+	errStreamsClosing = errors.New("the server is shutting down")
+	// errTooManyStreams is returned by streamTracker.add when the maximum number of streams
+	// are open.
+	errTooManyStreams = errors.New("too many open log streams")
+)
+
+// StreamStats reports the number of open log streams, e.g. to watch the load of live tails.
+type StreamStats struct {
+	Active int `json:"active"`
+	// Max is the maximum number of streams that may be open at once, or zero for no limit.
+	Max int `json:"max,omitempty"`
+}
+
+// streamTracker tracks the open log streams so that they can be capped and closed gracefully
+// when the server shuts down, instead of being cut when the HTTP server stops.
+type streamTracker struct {
+	mu      sync.Mutex
+	closed  bool
+	count   int
+	limit   int
+	closing chan struct{}
+	active  sync.WaitGroup
+}
+
+func newStreamTracker() *streamTracker {
+	return &streamTracker{closing: make(chan struct{})}
+}
+
+// setLimit caps the number of streams open at once. Zero removes the cap.
+func (t *streamTracker) setLimit(limit int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit = max(limit, 0)
+}
+
+// add registers a new stream, which must call done once it ends. It returns
+// errStreamsClosing once the server is shutting down and errTooManyStreams when the limit of
+// open streams is reached, in which case the stream must not be opened.
+func (t *streamTracker) add() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return errStreamsClosing
+	}
+	if t.limit > 0 && t.count >= t.limit {
+		return errTooManyStreams
+	}
+	t.count++
+	t.active.Add(1)
+	return nil
+}
+
+func (t *streamTracker) done() {
+	t.mu.Lock()
+	t.count--
+	t.mu.Unlock()
+	t.active.Done()
+}
+
+// stats returns the number of open streams and the limit.
+func (t *streamTracker) stats() StreamStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return StreamStats{Active: t.count, Max: t.limit}
+}
+
+// close tells the open streams to end, through the closing channel, and waits until they
+// have or ctx is done. It reports whether every stream ended in time.
+func (t *streamTracker) close(ctx context.Context) bool {
+	t.mu.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.closing)
+	}
+	t.mu.Unlock()
+
+	// No stream is added once closed, so waiting cannot race with add.
+	drained := make(chan struct{})
+	go func() {
+		t.active.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// drainStreams ends the open log streams with a final "close" event whose reason is
+// "shutdown", so that their clients reconnect to another replica, and waits up to timeout
+// for them to finish.
+func (h *LogsHandler) drainStreams(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if !h.streams.close(ctx) {
+		h.logger.Warn("Log streams did not close within the drain timeout",
+			slog.Duration("timeout", timeout),
+		)
+	}
+}
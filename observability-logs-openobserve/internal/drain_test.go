@@ -0,0 +1,150 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestServerShutdown_DrainsStreams(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{StreamPollInterval: 10 * time.Millisecond}, testLogger())
+	srv := NewServerWithOptions("0", handler, ServerOptions{StreamDrainTimeout: 2 * time.Second}, testLogger())
+	adapter := httptest.NewServer(srv.httpServer.Handler)
+	defer adapter.Close()
+
+	resp, err := http.Get(adapter.URL + "/api/v1/logs/stream?namespace=test-ns")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	reader := bufio.NewReader(resp.Body)
+	// Wait for the operation event, so that the stream is open before shutting down.
+	if line, err := reader.ReadString('\n'); err != nil || !strings.HasPrefix(line, "event: operation") {
+		t.Fatalf("expected the operation event, got %q (%v)", line, err)
+	}
+
+	start := time.Now()
+	if err := srv.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected the stream to close promptly, shutdown took %v", elapsed)
+	}
+
+	rest, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read the end of the stream: %v", err)
+	}
+	if !strings.HasSuffix(string(rest), "event: close\ndata: {\"reason\":\"shutdown\"}\n\n") {
+		t.Errorf("expected a final shutdown close event, got %q", rest)
+	}
+
+	// No stream is opened while shutting down.
+	resp, err = http.Get(adapter.URL + "/api/v1/logs/stream?namespace=test-ns")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get("Retry-After") == "" {
+		t.Errorf("expected 503 with Retry-After, got %d", resp.StatusCode)
+	}
+}
+
+func TestStreamTracker_DrainTimeout(t *testing.T) {
+	tracker := newStreamTracker()
+	if err := tracker.add(); err != nil {
+		t.Fatalf("expected a stream to be added before closing, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if tracker.close(ctx) {
+		t.Error("expected the drain to time out while a stream is open")
+	}
+	select {
+	case <-tracker.closing:
+	default:
+		t.Error("expected the open streams to be told to close")
+	}
+	if err := tracker.add(); err != errStreamsClosing {
+		t.Errorf("expected no stream to be added once closed, got %v", err)
+	}
+
+	tracker.done()
+	if !tracker.close(context.Background()) {
+		t.Error("expected the drain to complete once the stream ended")
+	}
+}
+
+func TestServer_MaxStreams(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{StreamPollInterval: 10 * time.Millisecond}, testLogger())
+	srv := NewServerWithOptions("0", handler, ServerOptions{MaxStreams: 1}, testLogger())
+	adapter := httptest.NewServer(srv.httpServer.Handler)
+	defer adapter.Close()
+
+	stats := func() StreamStats {
+		resp, err := http.Get(adapter.URL + "/api/v1/diagnostics/streams")
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		defer resp.Body.Close()
+		var stats StreamStats
+		if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+			t.Fatalf("invalid JSON response: %v", err)
+		}
+		return stats
+	}
+
+	resp, err := http.Get(adapter.URL + "/api/v1/logs/stream?namespace=test-ns")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	if line, err := bufio.NewReader(resp.Body).ReadString('\n'); err != nil || !strings.HasPrefix(line, "event: operation") {
+		t.Fatalf("expected the operation event, got %q (%v)", line, err)
+	}
+	if got := stats(); got != (StreamStats{Active: 1, Max: 1}) {
+		t.Errorf("expected one open stream out of one, got %+v", got)
+	}
+
+	refused, err := http.Get(adapter.URL + "/api/v1/logs/stream?namespace=test-ns")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	refused.Body.Close()
+	if refused.StatusCode != http.StatusServiceUnavailable || refused.Header.Get("Retry-After") != "5" {
+		t.Errorf("expected 503 with Retry-After: 5, got %d %q", refused.StatusCode, refused.Header.Get("Retry-After"))
+	}
+
+	// Closing the stream frees its slot.
+	resp.Body.Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for stats().Active != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the stream to be released after the client disconnected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
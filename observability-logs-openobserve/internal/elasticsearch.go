@@ -0,0 +1,337 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// esDefaultSize is the number of hits returned when an Elasticsearch-style search does not
+// specify a size, matching Elasticsearch's own default.
+const esDefaultSize = 10
+
+// esTimestampField is the only field that range queries and sorting may refer to.
+const esTimestampField = "@timestamp"
+
+// esSearchRequest is the supported subset of an Elasticsearch search request body.
+type esSearchRequest struct {
+	Query json.RawMessage `json:"query"`
+	Size  *int            `json:"size"`
+	From  int             `json:"from"`
+	Sort  json.RawMessage `json:"sort"`
+}
+
+// esHit is a single search hit. The source is the component log entry, with its timestamp
+// also exposed as @timestamp.
+type esHit struct {
+	Index  string   `json:"_index"`
+	ID     string   `json:"_id"`
+	Score  *float64 `json:"_score"`
+	Source esSource `json:"_source"`
+}
+
+type esSource struct {
+	Timestamp time.Time `json:"@timestamp"`
+	openobserve.ComponentLogsEntry
+}
+
+type esSearchResponse struct {
+	Took     int  `json:"took"`
+	TimedOut bool `json:"timed_out"`
+	Hits     struct {
+		Total struct {
+			Value    int    `json:"value"`
+			Relation string `json:"relation"`
+		} `json:"total"`
+		MaxScore *float64 `json:"max_score"`
+		Hits     []esHit  `json:"hits"`
+	} `json:"hits"`
+}
+
+// ElasticsearchSearch implements POST /api/v1/logs/_search.
+// It accepts a minimal subset of the Elasticsearch query DSL and answers with
+// Elasticsearch-style hits, so that tools speaking that DSL can query component logs:
+//   - match_all;
+//   - match / match_phrase on "log" or "message" (the search phrase, at most one);
+//   - match / term on the labels in logLabelFilters ("namespace" is required);
+//   - range on @timestamp with gt/gte/lt/lte as RFC3339 strings or epoch milliseconds;
+//   - bool with must and filter clauses.
+//
+// "size" (default 10) and a single "sort" on @timestamp are honoured. Anything else is
+// rejected with 400 and an Elasticsearch-style error body.
+func (h *LogsHandler) ElasticsearchSearch(w http.ResponseWriter, r *http.Request) {
+	var req esSearchRequest
+	if err := decodeJSONBody(r, &req); err != nil {
+		h.writeESError(w, http.StatusBadRequest, "parsing_exception", requestBodyMessage(err))
+		return
+	}
+	params, err := translateESSearch(req)
+	if err != nil {
+		h.writeESError(w, http.StatusBadRequest, "parsing_exception", err.Error())
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).GetComponentLogs(r.Context(), scopeToTenant(r.Context(), params))
+	if msg, ok := queryRejection(err); ok {
+		h.writeESError(w, http.StatusBadRequest, "illegal_argument_exception", msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to query component logs",
+			slog.String("function", "ElasticsearchSearch"),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		h.writeESError(w, http.StatusInternalServerError, "exception", "internal server error")
+		return
+	}
+
+	var resp esSearchResponse
+	resp.Took = result.Took
+	resp.Hits.Total.Value = result.TotalCount
+	resp.Hits.Total.Relation = "eq"
+	resp.Hits.Hits = make([]esHit, 0, len(result.Logs))
+	for i, entry := range result.Logs {
+		resp.Hits.Hits = append(resp.Hits.Hits, esHit{
+			Index:  "logs",
+			ID:     strconv.FormatInt(entry.Timestamp.UnixMicro(), 10) + "-" + strconv.Itoa(i),
+			Source: esSource{Timestamp: entry.Timestamp, ComponentLogsEntry: entry},
+		})
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// writeESError writes an error in the shape returned by Elasticsearch.
+func (h *LogsHandler) writeESError(w http.ResponseWriter, status int, errorType, reason string) {
+	h.writeJSON(w, status, map[string]interface{}{
+		"error": map[string]string{
+			"type":   errorType,
+			"reason": reason,
+		},
+		"status": status,
+	})
+}
+
+// translateESSearch translates a search request into component log query parameters.
+func translateESSearch(req esSearchRequest) (openobserve.ComponentLogsParams, error) {
+	var params openobserve.ComponentLogsParams
+
+	if len(req.Query) > 0 {
+		if err := applyESClause(&params, req.Query); err != nil {
+			return params, err
+		}
+	}
+	if params.Namespace == "" {
+		return params, fmt.Errorf("the query must filter on namespace with a term or match clause")
+	}
+
+	if params.EndTime.IsZero() {
+		params.EndTime = time.Now()
+	}
+	if params.StartTime.IsZero() {
+		params.StartTime = params.EndTime.Add(-defaultQueryRange)
+	}
+	if params.EndTime.Before(params.StartTime) {
+		return params, fmt.Errorf("the %s range is empty", esTimestampField)
+	}
+
+	params.Limit = esDefaultSize
+	if req.Size != nil {
+		if *req.Size <= 0 {
+			return params, fmt.Errorf("size must be positive")
+		}
+		params.Limit = *req.Size
+	}
+	if req.From != 0 {
+		return params, fmt.Errorf("from is not supported")
+	}
+
+	order, err := parseESSort(req.Sort)
+	if err != nil {
+		return params, err
+	}
+	params.SortOrder = order
+	return params, nil
+}
+
+// applyESClause applies a single query clause to params.
+func applyESClause(params *openobserve.ComponentLogsParams, raw json.RawMessage) error {
+	var clause map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &clause); err != nil || len(clause) != 1 {
+		return fmt.Errorf("each query clause must be an object with exactly one query type")
+	}
+
+	for queryType, body := range clause {
+		switch queryType {
+		case "match_all":
+			return nil
+		case "bool":
+			return applyESBool(params, body)
+		case "match", "match_phrase":
+			return applyESFieldValue(params, queryType, body, "query", true)
+		case "term":
+			return applyESFieldValue(params, queryType, body, "value", false)
+		case "range":
+			return applyESRange(params, body)
+		default:
+			return fmt.Errorf("unsupported query type %q", queryType)
+		}
+	}
+	return nil
+}
+
+func applyESBool(params *openobserve.ComponentLogsParams, body json.RawMessage) error {
+	var b map[string]json.RawMessage
+	if err := json.Unmarshal(body, &b); err != nil {
+		return fmt.Errorf("bool must be an object")
+	}
+	for occur, clauses := range b {
+		if occur != "must" && occur != "filter" {
+			return fmt.Errorf("unsupported bool clause %q: only must and filter are supported", occur)
+		}
+		// A single clause may be given instead of an array.
+		list := []json.RawMessage{clauses}
+		if trimmed := bytes.TrimSpace(clauses); len(trimmed) > 0 && trimmed[0] == '[' {
+			if err := json.Unmarshal(clauses, &list); err != nil {
+				return fmt.Errorf("bool.%s must be a clause or an array of clauses", occur)
+			}
+		}
+		for _, c := range list {
+			if err := applyESClause(params, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyESFieldValue applies a match or term clause. The value is either given directly or
+// as the valueKey property of an object ({"field": {"query": "..."}}). Only match clauses
+// may target the log message.
+func applyESFieldValue(params *openobserve.ComponentLogsParams, queryType string, body json.RawMessage, valueKey string, allowMessage bool) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || len(fields) != 1 {
+		return fmt.Errorf("%s must name exactly one field", queryType)
+	}
+
+	for field, raw := range fields {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			var obj map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &obj); err != nil || len(obj) != 1 || obj[valueKey] == nil {
+				return fmt.Errorf("%s on %q must have a string value", queryType, field)
+			}
+			if err := json.Unmarshal(obj[valueKey], &value); err != nil {
+				return fmt.Errorf("%s on %q must have a string value", queryType, field)
+			}
+		}
+
+		if field == "log" || field == "message" {
+			if !allowMessage {
+				return fmt.Errorf("%s is not supported on %q; use match", queryType, field)
+			}
+			if params.SearchPhrase != "" {
+				return fmt.Errorf("only one match on the log message is supported")
+			}
+			params.SearchPhrase = value
+			continue
+		}
+		set, ok := logLabelFilters[field]
+		if !ok {
+			return fmt.Errorf("unsupported field %q in %s", field, queryType)
+		}
+		set(params, value)
+	}
+	return nil
+}
+
+func applyESRange(params *openobserve.ComponentLogsParams, body json.RawMessage) error {
+	var fields map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || len(fields) != 1 || fields[esTimestampField] == nil {
+		return fmt.Errorf("range is only supported on %s", esTimestampField)
+	}
+
+	for bound, raw := range fields[esTimestampField] {
+		if bound == "format" {
+			continue
+		}
+		t, err := parseESTime(raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s.%s: %w", esTimestampField, bound, err)
+		}
+		switch bound {
+		case "gt", "gte":
+			params.StartTime = t
+		case "lt", "lte":
+			params.EndTime = t
+		default:
+			return fmt.Errorf("unsupported range bound %q", bound)
+		}
+	}
+	return nil
+}
+
+// parseESTime parses an RFC3339 string or a number of milliseconds since the epoch.
+func parseESTime(raw json.RawMessage) (time.Time, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t, nil
+		}
+		if ms, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return time.UnixMilli(ms), nil
+		}
+		return time.Time{}, fmt.Errorf("must be RFC3339 or epoch milliseconds")
+	}
+	var ms int64
+	if err := json.Unmarshal(raw, &ms); err != nil {
+		return time.Time{}, fmt.Errorf("must be RFC3339 or epoch milliseconds")
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// parseESSort reads a sort on @timestamp and returns the sort order, "desc" by default.
+// Accepted forms: [{"@timestamp": "asc"}], [{"@timestamp": {"order": "asc"}}] and the
+// same without the enclosing array.
+func parseESSort(raw json.RawMessage) (string, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return "desc", nil
+	}
+	list := []json.RawMessage{raw}
+	if trimmed := bytes.TrimSpace(raw); trimmed[0] == '[' {
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return "", fmt.Errorf("invalid sort")
+		}
+	}
+	if len(list) != 1 {
+		return "", fmt.Errorf("sort supports a single %s criterion", esTimestampField)
+	}
+
+	var criterion map[string]json.RawMessage
+	if err := json.Unmarshal(list[0], &criterion); err != nil || len(criterion) != 1 || criterion[esTimestampField] == nil {
+		return "", fmt.Errorf("sort is only supported on %s", esTimestampField)
+	}
+	var order string
+	if err := json.Unmarshal(criterion[esTimestampField], &order); err != nil {
+		var obj struct {
+			Order string `json:"order"`
+		}
+		if err := json.Unmarshal(criterion[esTimestampField], &obj); err != nil {
+			return "", fmt.Errorf("invalid sort order")
+		}
+		order = obj.Order
+	}
+	if order != "asc" && order != "desc" {
+		return "", fmt.Errorf("sort order must be asc or desc")
+	}
+	return order, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestTranslateESSearch(t *testing.T) {
+	body := `{
+		"size": 50,
+		"sort": [{"@timestamp": {"order": "asc"}}],
+		"query": {"bool": {
+			"must": [{"match": {"message": {"query": "timed out"}}}],
+			"filter": [
+				{"term": {"namespace": "default"}},
+				{"term": {"component_uid": {"value": "comp-1"}}},
+				{"range": {"@timestamp": {"gte": "2025-01-01T00:00:00Z", "lt": 1735776000000, "format": "strict_date_optional_time"}}}
+			]
+		}}
+	}`
+	var req esSearchRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatalf("invalid test body: %v", err)
+	}
+
+	params, err := translateESSearch(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Namespace != "default" || len(params.ComponentIDs) != 1 || params.ComponentIDs[0] != "comp-1" {
+		t.Errorf("unexpected filters: %+v", params)
+	}
+	if params.SearchPhrase != "timed out" || params.Limit != 50 || params.SortOrder != "asc" {
+		t.Errorf("unexpected search phrase, size or sort: %+v", params)
+	}
+	if !params.StartTime.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) || !params.EndTime.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time range: %v - %v", params.StartTime, params.EndTime)
+	}
+}
+
+func TestTranslateESSearch_Defaults(t *testing.T) {
+	params, err := translateESSearch(esSearchRequest{Query: json.RawMessage(`{"match": {"namespace": "default"}}`)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Limit != esDefaultSize || params.SortOrder != "desc" {
+		t.Errorf("expected default size and sort, got %+v", params)
+	}
+	if params.EndTime.Sub(params.StartTime) != defaultQueryRange {
+		t.Errorf("expected the default time range, got %v - %v", params.StartTime, params.EndTime)
+	}
+}
+
+func TestTranslateESSearch_Unsupported(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing namespace", `{"query": {"match_all": {}}}`},
+		{"unsupported query type", `{"query": {"wildcard": {"log": "err*"}}}`},
+		{"should clause", `{"query": {"bool": {"must": {"term": {"namespace": "default"}}, "should": []}}}`},
+		{"unsupported field", `{"query": {"bool": {"filter": [{"term": {"namespace": "default"}}, {"term": {"host": "x"}}]}}}`},
+		{"term on message", `{"query": {"bool": {"filter": [{"term": {"namespace": "default"}}, {"term": {"log": "x"}}]}}}`},
+		{"range on other field", `{"query": {"bool": {"filter": [{"term": {"namespace": "default"}}, {"range": {"bytes": {"gte": 1}}}]}}}`},
+		{"from", `{"from": 10, "query": {"term": {"namespace": "default"}}}`},
+		{"sort on other field", `{"sort": [{"level": "asc"}], "query": {"term": {"namespace": "default"}}}`},
+		{"two sort criteria", `{"sort": [{"@timestamp": "asc"}, {"@timestamp": "desc"}], "query": {"term": {"namespace": "default"}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req esSearchRequest
+			if err := json.Unmarshal([]byte(tt.body), &req); err != nil {
+				t.Fatalf("invalid test body: %v", err)
+			}
+			if _, err := translateESSearch(req); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestElasticsearchSearch(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		resp := openobserve.OpenObserveResponse{Took: 4, Hits: []map[string]interface{}{{"total": float64(7)}}}
+		if !strings.Contains(string(body), "count(*)") {
+			resp.Hits = []map[string]interface{}{{"_timestamp": float64(1735689600000000), "log": "request timed out"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"query": {"bool": {"filter": [{"term": {"namespace": "default"}}]}}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/_search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ElasticsearchSearch(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		Hits struct {
+			Total struct {
+				Value int `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source map[string]interface{} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if resp.Hits.Total.Value != 7 || len(resp.Hits.Hits) != 1 {
+		t.Fatalf("unexpected hits: %+v", resp.Hits)
+	}
+	source := resp.Hits.Hits[0].Source
+	ts, _ := source["@timestamp"].(string)
+	if got, err := time.Parse(time.RFC3339, ts); err != nil || !got.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected @timestamp %q", ts)
+	}
+	if source["log"] != "request timed out" {
+		t.Errorf("unexpected _source: %v", source)
+	}
+}
+
+func TestElasticsearchSearch_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/_search", strings.NewReader(`{"query": {"fuzzy": {"log": "eror"}}}`))
+	rec := httptest.NewRecorder()
+	handler.ElasticsearchSearch(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+	var resp struct {
+		Error struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+		Status int `json:"status"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if resp.Status != http.StatusBadRequest || !strings.Contains(resp.Error.Reason, "fuzzy") {
+		t.Errorf("unexpected error response: %+v", resp)
+	}
+}
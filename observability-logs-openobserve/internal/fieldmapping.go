@@ -0,0 +1,223 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// Supported encodings for the log entry timestamp in query responses.
+const (
+	TimestampFormatRFC3339 = "rfc3339"
+	TimestampFormatUnixMs  = "unix_ms"
+)
+
+// mappableEntryFields lists the component log entry fields that can be renamed.
+var mappableEntryFields = map[string]bool{
+	"timestamp": true,
+	"log":       true,
+	"level":     true,
+	"metadata":  true,
+}
+
+// entryKeys maps the mappable fields to their keys in the log entries of the adapter's own
+// endpoints (openobserve.ComponentLogsEntry) where they differ. Those entries carry their
+// metadata as top-level fields, so a "metadata" mapping does not apply to them.
+var entryKeys = map[string]string{
+	"level": "logLevel",
+}
+
+// ParseFieldMapping parses a comma-separated list of field=newName pairs
+// (e.g. "log=message,timestamp=timestamp_ms") into a rename map.
+func ParseFieldMapping(value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	targets := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		from, to, ok := strings.Cut(pair, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid field mapping %q: expected field=newName", pair)
+		}
+		if !mappableEntryFields[from] {
+			return nil, fmt.Errorf("invalid field mapping %q: unknown field %q (must be one of %s)", pair, from, strings.Join(sortedKeys(mappableEntryFields), ", "))
+		}
+		if prev, dup := targets[to]; dup {
+			return nil, fmt.Errorf("invalid field mapping: fields %q and %q are both mapped to %q", prev, from, to)
+		}
+		mapping[from] = to
+		targets[to] = from
+	}
+	return mapping, nil
+}
+
+// entryFieldMapper rewrites serialized component log entries so that the adapter can
+// match the schema expected by the consuming UI. It applies to every JSON response and
+// stream carrying log entries; the protobuf, plain-text, Loki and Elasticsearch formats
+// and gRPC keep their own schemas.
+type entryFieldMapper struct {
+	fields          map[string]string
+	timestampFormat string
+}
+
+// isIdentity reports whether the mapper leaves entries in their default shape.
+func (m entryFieldMapper) isIdentity() bool {
+	return len(m.fields) == 0 && (m.timestampFormat == "" || m.timestampFormat == TimestampFormatRFC3339)
+}
+
+// mapEntries converts the entries into JSON objects with renamed fields and
+// the configured timestamp encoding.
+func (m entryFieldMapper) mapEntries(entries []gen.ComponentLogEntry) ([]map[string]interface{}, error) {
+	mapped := make([]map[string]interface{}, 0, len(entries))
+	for i := range entries {
+		out, err := m.mapEntry(entries[i], entries[i].Timestamp, nil)
+		if err != nil {
+			return nil, err
+		}
+		mapped = append(mapped, out)
+	}
+	return mapped, nil
+}
+
+// mapLogEntry converts a log entry of the adapter's own endpoints like mapEntries. The entry
+// is returned as is when the mapper leaves entries in their default shape.
+func (m entryFieldMapper) mapLogEntry(entry *openobserve.ComponentLogsEntry) (interface{}, error) {
+	if m.isIdentity() {
+		return entry, nil
+	}
+	return m.mapEntry(entry, &entry.Timestamp, entryKeys)
+}
+
+// mapEntry converts entry into a JSON object with renamed fields and the configured
+// timestamp encoding. keys maps the mappable fields to their key in the entry where it
+// differs from the field name.
+func (m entryFieldMapper) mapEntry(entry interface{}, timestamp *time.Time, keys map[string]string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal log entry: %w", err)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal log entry: %w", err)
+	}
+
+	if m.timestampFormat == TimestampFormatUnixMs && timestamp != nil {
+		obj["timestamp"] = timestamp.UnixMilli()
+	}
+
+	renames := make(map[string]string, len(m.fields))
+	for from, to := range m.fields {
+		if key, ok := keys[from]; ok {
+			from = key
+		}
+		renames[from] = to
+	}
+	out := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		if renamed, ok := renames[k]; ok {
+			k = renamed
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+// mappedEntries encodes log entries of the adapter's own endpoints with an entryFieldMapper.
+type mappedEntries struct {
+	entries []openobserve.ComponentLogsEntry
+	mapper  entryFieldMapper
+}
+
+func (e mappedEntries) MarshalJSON() ([]byte, error) {
+	if e.entries == nil {
+		return []byte("null"), nil
+	}
+	mapped := make([]interface{}, 0, len(e.entries))
+	for i := range e.entries {
+		out, err := e.mapper.mapLogEntry(&e.entries[i])
+		if err != nil {
+			return nil, err
+		}
+		mapped = append(mapped, out)
+	}
+	return json.Marshal(mapped)
+}
+
+// mapResponse returns v, a response body, with the log entries it carries rewritten by the
+// mapper. Responses without log entries, or when the mapper leaves entries in their default
+// shape, are returned as is. The log entries replace those of the wrapped result, which
+// keeps its other fields.
+func (m entryFieldMapper) mapResponse(v interface{}) interface{} {
+	if m.isIdentity() {
+		return v
+	}
+	switch result := v.(type) {
+	case *openobserve.ComponentLogsResult:
+		if result == nil {
+			return v
+		}
+		return struct {
+			*openobserve.ComponentLogsResult
+			Logs mappedEntries `json:"logs"`
+		}{result, mappedEntries{result.Logs, m}}
+	case annotatedLogsResult:
+		return struct {
+			annotatedLogsResult
+			Logs mappedEntries `json:"logs"`
+		}{result, mappedEntries{result.Logs, m}}
+	case *openobserve.OrgLogsResult:
+		return struct {
+			*openobserve.OrgLogsResult
+			Logs mappedEntries `json:"logs"`
+		}{result, mappedEntries{result.Logs, m}}
+	case *openobserve.AlertEvidenceResult:
+		return struct {
+			*openobserve.AlertEvidenceResult
+			Logs mappedEntries `json:"logs"`
+		}{result, mappedEntries{result.Logs, m}}
+	case *openobserve.CorrelatedLogsResult:
+		return struct {
+			*openobserve.CorrelatedLogsResult
+			Logs interface{} `json:"logs"`
+		}{result, m.mapResponse(result.Logs)}
+	case *openobserve.ComponentLogsWithHistogramResult:
+		return struct {
+			*openobserve.ComponentLogsWithHistogramResult
+			Logs interface{} `json:"logs"`
+		}{result, m.mapResponse(result.Logs)}
+	case *openobserve.LogBucketSamplesResult:
+		type mappedBucket struct {
+			openobserve.LogSampleBucket
+			Samples mappedEntries `json:"samples"`
+		}
+		buckets := make([]mappedBucket, len(result.Buckets))
+		for i, bucket := range result.Buckets {
+			buckets[i] = mappedBucket{bucket, mappedEntries{bucket.Samples, m}}
+		}
+		return struct {
+			*openobserve.LogBucketSamplesResult
+			Buckets []mappedBucket `json:"buckets"`
+		}{result, buckets}
+	}
+	return v
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestParseFieldMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"empty", "", map[string]string{}, false},
+		{"single pair", "log=message", map[string]string{"log": "message"}, false},
+		{"multiple pairs with spaces", " log = message , level=severity,", map[string]string{"log": "message", "level": "severity"}, false},
+		{"missing separator", "log", nil, true},
+		{"empty target", "log=", nil, true},
+		{"unknown field", "podName=pod", nil, true},
+		{"duplicate target", "log=text,level=text", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFieldMapping(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFieldMapping(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("expected %s=%s, got %s=%s", k, v, k, got[k])
+				}
+			}
+		})
+	}
+}
+
+func TestEntryFieldMapper_IsIdentity(t *testing.T) {
+	if !(entryFieldMapper{}).isIdentity() {
+		t.Error("expected zero mapper to be identity")
+	}
+	if !(entryFieldMapper{timestampFormat: TimestampFormatRFC3339}).isIdentity() {
+		t.Error("expected rfc3339-only mapper to be identity")
+	}
+	if (entryFieldMapper{timestampFormat: TimestampFormatUnixMs}).isIdentity() {
+		t.Error("expected unix_ms mapper not to be identity")
+	}
+	if (entryFieldMapper{fields: map[string]string{"log": "message"}}).isIdentity() {
+		t.Error("expected renaming mapper not to be identity")
+	}
+}
+
+func TestEntryFieldMapper_MapEntries(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := []gen.ComponentLogEntry{{
+		Timestamp: &ts,
+		Log:       ptr("hello"),
+		Level:     ptr("INFO"),
+	}}
+
+	mapper := entryFieldMapper{
+		fields:          map[string]string{"log": "message", "timestamp": "timestamp_ms"},
+		timestampFormat: TimestampFormatUnixMs,
+	}
+	mapped, err := mapper.mapEntries(entries)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mapped) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(mapped))
+	}
+
+	entry := mapped[0]
+	if entry["message"] != "hello" {
+		t.Errorf("expected message 'hello', got %v", entry["message"])
+	}
+	if _, ok := entry["log"]; ok {
+		t.Error("expected original log field to be renamed")
+	}
+	if entry["timestamp_ms"] != ts.UnixMilli() {
+		t.Errorf("expected timestamp_ms %d, got %v", ts.UnixMilli(), entry["timestamp_ms"])
+	}
+	if entry["level"] != "INFO" {
+		t.Errorf("expected unmapped level to be kept, got %v", entry["level"])
+	}
+}
+
+func TestQueryLogs_ComponentScope_FieldMapping(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Took: 5,
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(ts.UnixMicro()), "log": "mapped log"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{
+		FieldMapping:    map[string]string{"log": "message", "timestamp": "timestamp_ms"},
+		TimestampFormat: TimestampFormatUnixMs,
+	}, testLogger())
+
+	scope := gen.LogsQueryRequest_SearchScope{}
+	_ = scope.FromComponentSearchScope(gen.ComponentSearchScope{Namespace: "test-ns"})
+
+	resp, err := handler.QueryLogs(context.Background(), gen.QueryLogsRequestObject{
+		Body: &gen.LogsQueryRequest{
+			StartTime:   ts.Add(-time.Hour),
+			EndTime:     ts.Add(time.Hour),
+			SearchScope: scope,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mappedResp, ok := resp.(componentQueryLogsResponse)
+	if !ok {
+		t.Fatalf("expected mapped response, got %T", resp)
+	}
+
+	rec := httptest.NewRecorder()
+	if err := mappedResp.VisitQueryLogsResponse(rec); err != nil {
+		t.Fatalf("unexpected error writing response: %v", err)
+	}
+
+	var body struct {
+		Logs []map[string]interface{} `json:"logs"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(body.Logs) != 1 {
+		t.Fatalf("expected 1 log, got %d", len(body.Logs))
+	}
+	if body.Logs[0]["message"] != "mapped log" {
+		t.Errorf("expected message 'mapped log', got %v", body.Logs[0]["message"])
+	}
+	if body.Logs[0]["timestamp_ms"] != float64(ts.UnixMilli()) {
+		t.Errorf("expected timestamp_ms %d, got %v", ts.UnixMilli(), body.Logs[0]["timestamp_ms"])
+	}
+}
+
+func TestFieldMapping_AdapterEndpoints(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(ts.UnixMicro()), "log": "mapped log", "logLevel": "ERROR"},
+			},
+		})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{
+		FieldMapping:    map[string]string{"log": "message", "level": "severity"},
+		TimestampFormat: TimestampFormatUnixMs,
+	}, testLogger())
+	checkEntry := func(t *testing.T, entry map[string]interface{}) {
+		t.Helper()
+		if entry["message"] != "mapped log" || entry["severity"] != "ERROR" || entry["timestamp"] != float64(ts.UnixMilli()) {
+			t.Errorf("expected a mapped entry, got %v", entry)
+		}
+		if _, ok := entry["log"]; ok {
+			t.Errorf("expected log to be renamed, got %v", entry)
+		}
+	}
+	const body = `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+
+	t.Run("search", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+		var result struct {
+			Logs       []map[string]interface{} `json:"logs"`
+			TotalCount int                      `json:"totalCount"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil || len(result.Logs) != 1 {
+			t.Fatalf("expected one log, got %d: %v", len(result.Logs), err)
+		}
+		checkEntry(t, result.Logs[0])
+	})
+
+	t.Run("export", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ExportLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/export?compression=none", strings.NewReader(body)))
+		var entry map[string]interface{}
+		if err := json.NewDecoder(rec.Body).Decode(&entry); err != nil {
+			t.Fatalf("failed to decode the export (status %d): %v", rec.Code, err)
+		}
+		checkEntry(t, entry)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		entry := openobserve.ComponentLogsEntry{Timestamp: ts, Log: "mapped log", LogLevel: "ERROR"}
+		if err := handler.writeStreamEvent(http.NewResponseController(rec), rec, entry); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var frame map[string]interface{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(rec.Body.String(), "data: "))), &frame); err != nil {
+			t.Fatalf("invalid frame %q: %v", rec.Body.String(), err)
+		}
+		checkEntry(t, frame)
+	})
+
+	t.Run("bucket samples", func(t *testing.T) {
+		result := &openobserve.LogBucketSamplesResult{Interval: "1m0s", Buckets: []openobserve.LogSampleBucket{
+			{Start: ts, Count: 1, Samples: []openobserve.ComponentLogsEntry{{Timestamp: ts, Log: "mapped log", LogLevel: "ERROR"}}},
+		}}
+		raw, err := json.Marshal(handler.fieldMapper.mapResponse(result))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var mapped struct {
+			Interval string `json:"interval"`
+			Buckets  []struct {
+				Count   int                      `json:"count"`
+				Samples []map[string]interface{} `json:"samples"`
+			} `json:"buckets"`
+		}
+		if err := json.Unmarshal(raw, &mapped); err != nil || len(mapped.Buckets) != 1 || len(mapped.Buckets[0].Samples) != 1 {
+			t.Fatalf("unexpected result %s: %v", raw, err)
+		}
+		if mapped.Interval != "1m0s" || mapped.Buckets[0].Count != 1 {
+			t.Errorf("expected the other fields to be kept, got %s", raw)
+		}
+		checkEntry(t, mapped.Buckets[0].Samples[0])
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// maxGRPCRequestBytes caps the size of a gRPC request message.
+const maxGRPCRequestBytes = 1 << 20
+
+// defaultStreamLogsLimit is the number of log lines StreamLogs sends when the request sets no
+// limit, as for log searches.
+const defaultStreamLogsLimit = 100
+
+// GRPCServer serves the LogQuery service of internal/api/proto/logquery.proto alongside the
+// HTTP API. It does not use TLS, so gRPC clients connect to it with insecure credentials.
+type GRPCServer struct {
+	port   string
+	server *grpc.Server
+	logger *slog.Logger
+}
+
+// NewGRPCServer constructs a GRPCServer listening on port that answers queries with
+// logsHandler. When auth has bearer tokens, calls must send one in their "authorization"
+// metadata; request signatures are not supported, so calls are rejected if auth is enabled
+// without tokens. When tenants is enabled, calls must name their tenant in the metadata
+// entry of the tenant header, like HTTP requests.
+func NewGRPCServer(port string, logsHandler *LogsHandler, auth APIAuth, tenants TenantSettings, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		port:   port,
+		server: newGRPCServer(&logQueryService{logs: logsHandler, auth: auth, tenants: tenants, logger: logger}),
+		logger: logger,
+	}
+}
+
+// newGRPCServer constructs a grpc.Server serving the LogQuery service with svc.
+func newGRPCServer(svc *logQueryService) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxGRPCRequestBytes),
+		grpc.ConnectionTimeout(15*time.Second),
+		grpc.StreamInterceptor(svc.authorize),
+	)
+	pb.RegisterLogQueryServer(server, svc)
+	return server
+}
+
+func (s *GRPCServer) Start() error {
+	s.logger.Info("Starting gRPC server", slog.String("port", s.port))
+	listener, err := net.Listen("tcp", ":"+s.port)
+	if err != nil {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
+	if err := s.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
+	return nil
+}
+
+// Shutdown stops accepting calls and waits for the running ones to finish. If ctx ends first,
+// the remaining calls are canceled.
+func (s *GRPCServer) Shutdown(ctx context.Context) error {
+	s.logger.Info("Shutting down gRPC server")
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
+
+// logQueryService implements the LogQuery service.
+type logQueryService struct {
+	pb.UnimplementedLogQueryServer
+
+	logs    *LogsHandler
+	auth    APIAuth
+	tenants TenantSettings
+	logger  *slog.Logger
+}
+
+// authorize authenticates calls and resolves their tenant from the call metadata, with the
+// same settings as HTTP requests.
+func (g *logQueryService) authorize(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	r := metadataRequest(ctx)
+	if g.auth.Enabled() && !validBearerToken(r, g.auth.Tokens) {
+		g.logger.Warn("Rejected unauthenticated gRPC call",
+			slog.String("method", info.FullMethod),
+			slog.String("clientIP", r.RemoteAddr),
+		)
+		return status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+	}
+	if g.tenants.Enabled() {
+		tenant, message := g.tenants.resolve(r)
+		if message != "" {
+			g.logger.Warn("Rejected gRPC call without a valid tenant",
+				slog.String("method", info.FullMethod),
+				slog.String("clientIP", r.RemoteAddr),
+				slog.String("reason", message),
+			)
+			return status.Error(codes.PermissionDenied, message)
+		}
+		stream = &tenantServerStream{ServerStream: stream, ctx: withTenant(ctx, tenant)}
+	}
+	return handler(srv, stream)
+}
+
+// metadataRequest returns a request carrying the metadata of the call in ctx as headers and
+// the address of its peer, for the checks shared with the HTTP API.
+func metadataRequest(ctx context.Context) *http.Request {
+	r := &http.Request{Header: make(http.Header)}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		for _, value := range values {
+			r.Header.Add(key, value)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		r.RemoteAddr = p.Addr.String()
+	}
+	return r
+}
+
+// tenantServerStream is a grpc.ServerStream whose context carries the tenant of the call.
+type tenantServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tenantServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamLogs runs the component log query of req and sends each matching log line as a
+// ComponentLogsEntry message as soon as its page is read from OpenObserve.
+func (g *logQueryService) StreamLogs(req *pb.LogQueryRequest, stream grpc.ServerStreamingServer[pb.ComponentLogsEntry]) error {
+	ctx := stream.Context()
+	params := openobserve.ComponentLogsParams{
+		Namespace:     req.GetNamespace(),
+		ComponentIDs:  req.GetComponentIds(),
+		EnvironmentID: req.GetEnvironmentId(),
+		ProjectID:     req.GetProjectId(),
+		SearchPhrase:  req.GetSearchPhrase(),
+		LogLevels:     req.GetLogLevels(),
+		Limit:         int(req.GetLimit()),
+		SortOrder:     req.GetSortOrder(),
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultStreamLogsLimit
+	}
+	if req.GetStartTimeMicros() != 0 {
+		params.StartTime = time.UnixMicro(req.GetStartTimeMicros()).UTC()
+	}
+	if req.GetEndTimeMicros() != 0 {
+		params.EndTime = time.UnixMicro(req.GetEndTimeMicros()).UTC()
+	}
+	if msg := g.logs.validateSearchParams(&params); msg != "" {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	if msg := g.logs.resolveMinLevel(&params); msg != "" {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	client := g.logs.clientFor(ctx)
+	var exhausted *openobserve.ScanBudgetError
+	if errors.As(client.CheckScanBudget(), &exhausted) {
+		return status.Error(codes.ResourceExhausted, exhausted.Error())
+	}
+
+	err := client.ExportComponentLogs(ctx, scopeToTenant(ctx, params), func(entry openobserve.ComponentLogsEntry) error {
+		return stream.Send(toProtoLogEntry(&entry))
+	})
+	if msg, ok := queryRejection(err); ok {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	if err != nil && ctx.Err() != nil {
+		// The client went away; there is no one left to report the failure to.
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		g.logger.Error("gRPC call failed",
+			slog.String("method", pb.LogQuery_StreamLogs_FullMethodName),
+			slog.Any("error", err),
+		)
+		return status.Error(codes.Internal, "internal server error")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// twoLogsHandler answers OpenObserve searches with two logs.
+func twoLogsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{
+		Hits: []map[string]interface{}{
+			{"_timestamp": float64(1735732800000000), "log": "first"},
+			{"_timestamp": float64(1735732860000000), "log": "second"},
+		},
+	})
+}
+
+// newGRPCTestServer serves the LogQuery service of a handler whose OpenObserve is ooHandler,
+// and returns a client connected to it.
+func newGRPCTestServer(t *testing.T, auth APIAuth, ooHandler http.HandlerFunc) pb.LogQueryClient {
+	t.Helper()
+	ooServer := httptest.NewServer(ooHandler)
+	t.Cleanup(ooServer.Close)
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	server := newGRPCServer(&logQueryService{logs: NewLogsHandler(client, nil, testLogger()), auth: auth, logger: testLogger()})
+	listener := bufconn.Listen(1 << 20)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewLogQueryClient(conn)
+}
+
+// receiveAll reads the messages of stream until it ends, returning them with the status it
+// ended with.
+func receiveAll(stream grpc.ServerStreamingClient[pb.ComponentLogsEntry]) ([]*pb.ComponentLogsEntry, error) {
+	var entries []*pb.ComponentLogsEntry
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func TestGRPC_StreamLogs(t *testing.T) {
+	client := newGRPCTestServer(t, APIAuth{}, twoLogsHandler)
+
+	stream, err := client.StreamLogs(context.Background(), &pb.LogQueryRequest{
+		Namespace:       "ns",
+		StartTimeMicros: 1735689600000000,
+		EndTimeMicros:   1735776000000000,
+		SortOrder:       "asc",
+	})
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	entries, err := receiveAll(stream)
+	if err != nil {
+		t.Fatalf("expected status OK, got %v", err)
+	}
+	if len(entries) != 2 || entries[0].GetLog() != "first" || entries[1].GetLog() != "second" {
+		t.Errorf("expected the logs in ascending order, got %v", entries)
+	}
+	if entries[0].GetTimestampMicros() != 1735732800000000 {
+		t.Errorf("unexpected timestamp %d", entries[0].GetTimestampMicros())
+	}
+}
+
+func TestGRPC_StreamLogsSendsPagesAsTheyAreRead(t *testing.T) {
+	release := make(chan struct{})
+	requests := 0
+	client := newGRPCTestServer(t, APIAuth{}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var hits []map[string]interface{}
+		if requests == 1 {
+			for i := 0; i < 1000; i++ {
+				hits = append(hits, map[string]interface{}{"_timestamp": float64(1735732800000000 + i), "log": "line"})
+			}
+		} else {
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: hits})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.StreamLogs(ctx, &pb.LogQueryRequest{Namespace: "ns", Limit: 2000})
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	// The second page is held back until the first message arrives.
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("expected the first page before the query finished, got %v", err)
+	}
+	close(release)
+	entries, err := receiveAll(stream)
+	if err != nil {
+		t.Fatalf("expected status OK, got %v", err)
+	}
+	if len(entries) != 999 {
+		t.Errorf("expected the rest of the first page, got %d messages", len(entries))
+	}
+}
+
+func TestGRPC_Errors(t *testing.T) {
+	client := newGRPCTestServer(t, APIAuth{Tokens: []string{"secret"}}, twoLogsHandler)
+
+	tests := []struct {
+		name     string
+		req      *pb.LogQueryRequest
+		token    string
+		wantCode codes.Code
+	}{
+		{"unauthenticated", &pb.LogQueryRequest{Namespace: "ns"}, "", codes.Unauthenticated},
+		{"invalid token", &pb.LogQueryRequest{Namespace: "ns"}, "wrong", codes.Unauthenticated},
+		{"missing namespace", &pb.LogQueryRequest{}, "secret", codes.InvalidArgument},
+		{"partial time range", &pb.LogQueryRequest{Namespace: "ns", StartTimeMicros: 1735689600000000}, "secret", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tt.token)
+			}
+			stream, err := client.StreamLogs(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("call failed: %v", err)
+			}
+			entries, err := receiveAll(stream)
+			if status.Code(err) != tt.wantCode || len(entries) != 0 {
+				t.Errorf("expected status %s and no messages, got %v with %d messages", tt.wantCode, err, len(entries))
+			}
+		})
+	}
+}
+
+func TestGRPC_TenantMetadata(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(twoLogsHandler))
+	t.Cleanup(ooServer.Close)
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	svc := &logQueryService{
+		logs:    NewLogsHandler(client, nil, testLogger()),
+		tenants: TenantSettings{Tenants: map[string]Tenant{"team-a": {Org: "team-a"}}},
+		logger:  testLogger(),
+	}
+
+	var gotTenant string
+	handler := func(srv any, stream grpc.ServerStream) error {
+		tenant, _ := tenantFromContext(stream.Context())
+		gotTenant = tenant.id
+		return nil
+	}
+	info := &grpc.StreamServerInfo{FullMethod: pb.LogQuery_StreamLogs_FullMethodName}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "team-a"))
+	if err := svc.authorize(nil, &tenantServerStream{ctx: ctx}, info, handler); err != nil || gotTenant != "team-a" {
+		t.Errorf("expected the call to run as team-a, got tenant %q and %v", gotTenant, err)
+	}
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-tenant-id", "team-b"))
+	if err := svc.authorize(nil, &tenantServerStream{ctx: ctx}, info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied for an unknown tenant, got %v", err)
+	}
+}
@@ -5,10 +5,14 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,21 +21,167 @@ import (
 	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
 	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/observer"
 	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/s3"
 )
 
 // LogsHandler implements the generated StrictServerInterface.
 type LogsHandler struct {
-	client         *openobserve.Client
-	observerClient *observer.Client
-	logger         *slog.Logger
+	client              *openobserve.Client
+	observerClient      *observer.Client
+	fieldMapper         entryFieldMapper
+	stream              streamSettings
+	streams             *streamTracker
+	emptyResultNotFound bool
+	severityLevels      []string
+	acceptedLogLevels   []string
+	lenientLogLevels    bool
+	percentileFields    map[string]bool
+	labelSelectorKeys   map[string]bool
+	expressionFields    map[string]bool
+	maxAlertWindow      time.Duration
+	clockSkewTolerance  time.Duration
+	clampEndTime        bool
+	operations          *operationRegistry
+	results             *resultStore
+	templates           *templateStore
+	deploymentEvents    *observer.EventsClient
+	exportBucket        *s3.Client
+	adminOrgs           []string
+	adminOrgConcurrency int
+	tenantClients       sync.Map
+	logger              *slog.Logger
+}
+
+// HandlerOptions bundles the optional response-shaping settings of LogsHandler.
+type HandlerOptions struct {
+	// FieldMapping renames component log entry fields in query responses (e.g. log -> message).
+	FieldMapping map[string]string
+	// TimestampFormat selects the encoding of log entry timestamps: rfc3339 (default) or unix_ms.
+	TimestampFormat string
+	// StreamPollInterval is how often the live-tail stream polls OpenObserve for new logs.
+	StreamPollInterval time.Duration
+	// StreamHeartbeatInterval is the idle period after which a heartbeat comment is sent to stream clients.
+	StreamHeartbeatInterval time.Duration
+	// StreamBufferSize is the number of log entries buffered per stream client before it is dropped.
+	StreamBufferSize int
+	// StreamWriteTimeout bounds how long a single write to a stream client may block.
+	StreamWriteTimeout time.Duration
+	// StreamIdleTimeout closes a stream after no log was sent to it for this long. Zero disables it.
+	StreamIdleTimeout time.Duration
+	// StreamMaxDuration closes a stream once it has been open for this long. Zero disables it.
+	StreamMaxDuration time.Duration
+	// EmptyResultNotFound makes log queries that match no logs return 404 instead of an empty 200.
+	EmptyResultNotFound bool
+	// SeverityLevels is the severity scale used to expand minLevel filters, ordered from least
+	// to most severe. Defaults to DefaultSeverityLevels.
+	SeverityLevels []string
+	// AcceptedLogLevels are the log levels that logLevels filters may list. Requests naming
+	// other levels are rejected with 400. Defaults to the severity levels.
+	AcceptedLogLevels []string
+	// LenientLogLevels only logs a warning for unknown levels in logLevels filters instead of
+	// rejecting the request, for deployments with custom levels.
+	LenientLogLevels bool
+	// PercentileFields lists the numeric log fields that percentile queries may aggregate.
+	PercentileFields []string
+	// MaxAlertWindow is the largest alert evaluation window accepted when creating or updating
+	// alert rules. Zero means no limit.
+	MaxAlertWindow time.Duration
+	// ClockSkewTolerance is how far ahead of the adapter's clock the startTime of a query may
+	// be. Queries starting later are rejected with 400. Zero rejects any startTime in the future.
+	ClockSkewTolerance time.Duration
+	// ClampEndTime brings an endTime further in the future than ClockSkewTolerance back to it.
+	ClampEndTime bool
+	// ResultsDir is the directory in which query results persisted for sharing are stored.
+	// Empty disables persisting results.
+	ResultsDir string
+	// ResultTTL is how long a persisted query result is served. Defaults to DefaultResultTTL.
+	ResultTTL time.Duration
+	// QueryTemplatesFile is the JSON file in which query templates are stored. Empty keeps
+	// them in memory, so that they are lost on restart.
+	QueryTemplatesFile string
+	// DeploymentEventsURL is the endpoint from which searches fetch deployment events to
+	// annotate their results with (see observer.EventsClient). Empty disables annotations.
+	DeploymentEventsURL string
+	// LabelSelectorKeys are the pod label keys that labelSelectors filters may use. Requests
+	// naming other keys are rejected with 400. Defaults to DefaultLabelSelectorKeys.
+	LabelSelectorKeys []string
+	// ExportBucket is the S3-compatible bucket that logs are exported to by
+	// POST /api/v1/logs/export/s3. An empty bucket disables the endpoint.
+	ExportBucket s3.Config
+	// AdminOrgs are the OpenObserve organizations searched by POST /api/v1/admin/logs/search.
+	// Empty disables the endpoint.
+	AdminOrgs []string
+	// AdminOrgConcurrency bounds the number of organizations searched at a time. Defaults to
+	// openobserve.DefaultOrgConcurrency.
+	AdminOrgConcurrency int
 }
 
 func NewLogsHandler(client *openobserve.Client, observerClient *observer.Client, logger *slog.Logger) *LogsHandler {
-	return &LogsHandler{
+	return NewLogsHandlerWithOptions(client, observerClient, HandlerOptions{}, logger)
+}
+
+// NewLogsHandlerWithOptions constructs a LogsHandler with the given response options.
+func NewLogsHandlerWithOptions(client *openobserve.Client, observerClient *observer.Client, opts HandlerOptions, logger *slog.Logger) *LogsHandler {
+	severityLevels := opts.SeverityLevels
+	if len(severityLevels) == 0 {
+		severityLevels = DefaultSeverityLevels
+	}
+	acceptedLogLevels := opts.AcceptedLogLevels
+	if len(acceptedLogLevels) == 0 {
+		acceptedLogLevels = severityLevels
+	}
+	labelSelectorKeys := opts.LabelSelectorKeys
+	if len(labelSelectorKeys) == 0 {
+		labelSelectorKeys = DefaultLabelSelectorKeys
+	}
+	allowedLabelKeys := make(map[string]bool, len(labelSelectorKeys))
+	for _, key := range labelSelectorKeys {
+		allowedLabelKeys[key] = true
+	}
+	percentileFields := make(map[string]bool, len(opts.PercentileFields))
+	for _, field := range opts.PercentileFields {
+		percentileFields[field] = true
+	}
+	h := &LogsHandler{
 		client:         client,
 		observerClient: observerClient,
-		logger:         logger,
+		fieldMapper: entryFieldMapper{
+			fields:          opts.FieldMapping,
+			timestampFormat: opts.TimestampFormat,
+		},
+		stream:              newStreamSettings(opts),
+		streams:             newStreamTracker(),
+		emptyResultNotFound: opts.EmptyResultNotFound,
+		severityLevels:      severityLevels,
+		acceptedLogLevels:   acceptedLogLevels,
+		lenientLogLevels:    opts.LenientLogLevels,
+		percentileFields:    percentileFields,
+		labelSelectorKeys:   allowedLabelKeys,
+		maxAlertWindow:      opts.MaxAlertWindow,
+		clockSkewTolerance:  opts.ClockSkewTolerance,
+		clampEndTime:        opts.ClampEndTime,
+		operations:          newOperationRegistry(),
+		results:             newResultStore(opts.ResultsDir, opts.ResultTTL),
+		templates:           newTemplateStore(opts.QueryTemplatesFile),
+		adminOrgs:           opts.AdminOrgs,
+		adminOrgConcurrency: opts.AdminOrgConcurrency,
+		logger:              logger,
+	}
+	if client != nil {
+		h.expressionFields = client.ExpressionFields(labelSelectorKeys, opts.PercentileFields)
+	}
+	if opts.DeploymentEventsURL != "" {
+		h.deploymentEvents = observer.NewEventsClient(opts.DeploymentEventsURL)
+	}
+	if opts.ExportBucket.Bucket != "" {
+		bucket, err := s3.NewClient(opts.ExportBucket)
+		if err != nil {
+			logger.Error("Invalid export bucket, exporting to object storage is disabled", slog.Any("error", err))
+		} else {
+			h.exportBucket = bucket
+		}
 	}
+	return h
 }
 
 // Ensure LogsHandler implements the interface at compile time.
@@ -62,9 +212,22 @@ func (h *LogsHandler) QueryLogs(ctx context.Context, request gen.QueryLogsReques
 				Message: ptr("searchScope with a valid namespace is required"),
 			}, nil
 		}
+		if offset, _ := queryOffsetFromContext(ctx); offset != 0 {
+			return gen.QueryLogs400JSONResponse{
+				Title:   ptr(gen.BadRequest),
+				Message: ptr("offset is only supported for component logs"),
+			}, nil
+		}
+		// Workflow logs cannot be restricted to a tenant's label selectors.
+		if sharesOrg(ctx) {
+			return gen.QueryLogs403JSONResponse{
+				Title:   ptr(gen.Forbidden),
+				Message: ptr("workflow logs are " + orgWideMessage),
+			}, nil
+		}
 
 		params := toWorkflowLogsParams(request.Body, &workflowScope)
-		result, err := h.client.GetWorkflowLogs(ctx, params)
+		result, err := h.clientFor(ctx).GetWorkflowLogs(ctx, params)
 		if err != nil {
 			h.logger.Error("Failed to query workflow logs",
 				slog.String("function", "QueryLogs"),
@@ -77,7 +240,10 @@ func (h *LogsHandler) QueryLogs(ctx context.Context, request gen.QueryLogsReques
 			}, nil
 		}
 
-		return gen.QueryLogs200JSONResponse(toWorkflowLogsQueryResponse(result)), nil
+		if len(result.Logs) == 0 && h.emptyResultNotFound {
+			return noLogsFoundResponse(), nil
+		}
+		return withResultHeaders(gen.QueryLogs200JSONResponse(toWorkflowLogsQueryResponse(result)), result.NearLimit, ""), nil
 	}
 
 	// Fall back to ComponentSearchScope
@@ -90,8 +256,33 @@ func (h *LogsHandler) QueryLogs(ctx context.Context, request gen.QueryLogsReques
 	}
 
 	params := toComponentLogsParams(request.Body, &scope)
+	params.Offset, _ = queryOffsetFromContext(ctx)
+	if msg := h.validateLogLevels(params.LogLevels); msg != "" {
+		return gen.QueryLogs400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(msg),
+		}, nil
+	}
+	if err := params.ValidateOffset(); err != nil {
+		return gen.QueryLogs400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(err.Error()),
+		}, nil
+	}
+	if msg := h.checkClockSkew(&params); msg != "" {
+		return gen.QueryLogs400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(msg),
+		}, nil
+	}
 
-	result, err := h.client.GetComponentLogs(ctx, params)
+	result, err := h.clientFor(ctx).GetComponentLogs(ctx, scopeToTenant(ctx, params))
+	if msg, ok := queryRejection(err); ok {
+		return gen.QueryLogs400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(msg),
+		}, nil
+	}
 	if err != nil {
 		h.logger.Error("Failed to query component logs",
 			slog.String("function", "QueryLogs"),
@@ -104,7 +295,36 @@ func (h *LogsHandler) QueryLogs(ctx context.Context, request gen.QueryLogsReques
 		}, nil
 	}
 
-	return gen.QueryLogs200JSONResponse(toLogsQueryResponse(result)), nil
+	if len(result.Logs) == 0 && h.emptyResultNotFound {
+		return noLogsFoundResponse(), nil
+	}
+
+	response := toLogsQueryResponse(result)
+	entries, _ := response.Logs.AsLogsQueryResponseLogs0()
+	page := componentQueryLogsResponse{
+		Logs:   entries,
+		Offset: params.Offset,
+		TookMs: response.TookMs,
+		Total:  response.Total,
+	}
+	if result.NextOffset > 0 {
+		page.NextOffset = &result.NextOffset
+	}
+	if !h.fieldMapper.isIdentity() {
+		mapped, err := h.fieldMapper.mapEntries(entries)
+		if err != nil {
+			h.logger.Error("Failed to apply log field mapping",
+				slog.String("function", "QueryLogs"),
+				slog.Any("error", err),
+			)
+			return gen.QueryLogs500JSONResponse{
+				Title:   ptr(gen.InternalServerError),
+				Message: ptr("internal server error"),
+			}, nil
+		}
+		page.Logs = mapped
+	}
+	return withResultHeaders(page, result.NearLimit, result.Stream), nil
 }
 
 // QueryEvents implements POST /api/v1/events/query.
@@ -164,7 +384,7 @@ func (h *LogsHandler) queryComponentEvents(ctx context.Context, req *gen.EventsQ
 		params.SortOrder = string(*req.SortOrder)
 	}
 
-	result, err := h.client.GetComponentEvents(ctx, params)
+	result, err := h.clientFor(ctx).GetComponentEvents(ctx, params)
 	if err != nil {
 		h.logger.Error("Failed to query component events",
 			slog.String("function", "QueryEvents"),
@@ -199,7 +419,7 @@ func (h *LogsHandler) queryWorkflowEvents(ctx context.Context, req *gen.EventsQu
 		params.SortOrder = string(*req.SortOrder)
 	}
 
-	result, err := h.client.GetWorkflowEvents(ctx, params)
+	result, err := h.clientFor(ctx).GetWorkflowEvents(ctx, params)
 	if err != nil {
 		h.logger.Error("Failed to query workflow events",
 			slog.String("function", "QueryEvents"),
@@ -285,10 +505,28 @@ func (h *LogsHandler) CreateAlertRule(ctx context.Context, request gen.CreateAle
 			Message: ptr("request body is required"),
 		}, nil
 	}
+	return h.createAlertRule(ctx, request.Body, nil), nil
+}
 
-	params := toLogAlertParams(request.Body)
+// createAlertRule creates the alert rule described by body, muted according to schedule if
+// it is set. Problems are reported in the response.
+func (h *LogsHandler) createAlertRule(ctx context.Context, body *gen.AlertRuleRequest, schedule *openobserve.AlertSchedule) gen.CreateAlertRuleResponseObject {
+	params := toLogAlertParams(body)
+	params.Schedule = schedule
+	if err := h.validateAlertParams(params); err != nil {
+		return gen.CreateAlertRule400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(err.Error()),
+		}
+	}
 
-	alertID, err := h.client.CreateAlert(ctx, params)
+	alertID, err := h.clientFor(ctx).CreateAlert(ctx, params)
+	if errors.Is(err, openobserve.ErrAlertAlreadyExists) {
+		return gen.CreateAlertRule409JSONResponse{
+			Title:   ptr(gen.Conflict),
+			Message: ptr(fmt.Sprintf("alert rule %q already exists", body.Metadata.Name)),
+		}
+	}
 	if err != nil {
 		h.logger.Error("Failed to create alert",
 			slog.String("function", "CreateAlertRule"),
@@ -298,7 +536,7 @@ func (h *LogsHandler) CreateAlertRule(ctx context.Context, request gen.CreateAle
 		return gen.CreateAlertRule500JSONResponse{
 			Title:   ptr(gen.InternalServerError),
 			Message: ptr("internal server error"),
-		}, nil
+		}
 	}
 
 	now := time.Now().UTC().Format(time.RFC3339)
@@ -308,12 +546,12 @@ func (h *LogsHandler) CreateAlertRule(ctx context.Context, request gen.CreateAle
 		RuleLogicalId: params.Name,
 		RuleBackendId: &alertID,
 		LastSyncedAt:  &now,
-	}, nil
+	}
 }
 
 // DeleteAlertRule implements DELETE /api/v1alpha1/alerts/rules/{ruleName}.
 func (h *LogsHandler) DeleteAlertRule(ctx context.Context, request gen.DeleteAlertRuleRequestObject) (gen.DeleteAlertRuleResponseObject, error) {
-	alertID, err := h.client.DeleteAlert(ctx, request.RuleName)
+	alertID, err := h.clientFor(ctx).DeleteAlert(ctx, request.RuleName)
 	if err != nil {
 		h.logger.Error("Failed to delete alert",
 			slog.String("function", "DeleteAlertRule"),
@@ -338,7 +576,7 @@ func (h *LogsHandler) DeleteAlertRule(ctx context.Context, request gen.DeleteAle
 
 // GetAlertRule implements GET /api/v1alpha1/alerts/rules/{ruleName}.
 func (h *LogsHandler) GetAlertRule(ctx context.Context, request gen.GetAlertRuleRequestObject) (gen.GetAlertRuleResponseObject, error) {
-	alert, err := h.client.GetAlert(ctx, request.RuleName)
+	alert, err := h.clientFor(ctx).GetAlert(ctx, request.RuleName)
 	if err != nil {
 		h.logger.Error("Failed to get alert",
 			slog.String("function", "GetAlertRule"),
@@ -426,8 +664,14 @@ func (h *LogsHandler) UpdateAlertRule(ctx context.Context, request gen.UpdateAle
 	}
 
 	params := toLogAlertParams(request.Body)
+	if err := h.validateAlertParams(params); err != nil {
+		return gen.UpdateAlertRule400JSONResponse{
+			Title:   ptr(gen.BadRequest),
+			Message: ptr(err.Error()),
+		}, nil
+	}
 
-	alertID, err := h.client.UpdateAlert(ctx, request.RuleName, params)
+	alertID, err := h.clientFor(ctx).UpdateAlert(ctx, request.RuleName, params)
 	if err != nil {
 		h.logger.Error("Failed to update alert",
 			slog.String("function", "UpdateAlertRule"),
@@ -483,11 +727,12 @@ func (h *LogsHandler) HandleAlertWebhook(ctx context.Context, request gen.Handle
 	}
 
 	go func() {
-		forwardCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		// The alert is looked up in the organization of the tenant that received the webhook.
+		forwardCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
 		defer cancel()
 
 		// Retrieve the alert details from OpenObserve to get the namespace. This is because the webhook body does not contain the namespace, but the observer's webhook API requires it.
-		alertDetail, err := h.client.GetAlert(forwardCtx, alertName)
+		alertDetail, err := h.clientFor(forwardCtx).GetAlert(forwardCtx, alertName)
 		if err != nil {
 			h.logger.Error("Failed to get alert details from OpenObserve",
 				slog.String("alertName", alertName),
@@ -642,6 +887,18 @@ func toComponentLogsParams(req *gen.LogsQueryRequest, scope *gen.ComponentSearch
 }
 
 // toLogAlertParams converts the generated AlertRuleRequest to internal params.
+// validateAlertParams validates alert rule parameters, including the configured maximum
+// evaluation window, before they are synced to OpenObserve.
+func (h *LogsHandler) validateAlertParams(params openobserve.LogAlertParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if h.maxAlertWindow > 0 {
+		return params.ValidateWindow(h.maxAlertWindow)
+	}
+	return nil
+}
+
 func toLogAlertParams(req *gen.AlertRuleRequest) openobserve.LogAlertParams {
 	params := openobserve.LogAlertParams{
 		Name:           &req.Metadata.Name,
@@ -743,3 +1000,60 @@ func strPtr(s string) *string {
 	}
 	return &s
 }
+
+// nearLimitHeader is set on log query responses that returned close to the requested
+// limit, signalling that the result is probably truncated and the time window should be narrowed.
+const nearLimitHeader = "X-Result-Near-Limit"
+
+// logStreamHeader names the OpenObserve stream that produced a component log query result,
+// which differs from the configured stream when a fallback stream was used.
+const logStreamHeader = "X-Log-Stream"
+
+// headerQueryLogsResponse adds extra headers to a QueryLogs response.
+type headerQueryLogsResponse struct {
+	gen.QueryLogsResponseObject
+	headers map[string]string
+}
+
+func (response headerQueryLogsResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	for k, v := range response.headers {
+		w.Header().Set(k, v)
+	}
+	return response.QueryLogsResponseObject.VisitQueryLogsResponse(w)
+}
+
+// withResultHeaders wraps response so that it carries the near-limit header when nearLimit is
+// set and the log stream header when stream is known.
+func withResultHeaders(response gen.QueryLogsResponseObject, nearLimit bool, stream string) gen.QueryLogsResponseObject {
+	headers := make(map[string]string)
+	if nearLimit {
+		headers[nearLimitHeader] = "true"
+	}
+	if stream != "" {
+		headers[logStreamHeader] = stream
+	}
+	if len(headers) == 0 {
+		return response
+	}
+	return headerQueryLogsResponse{response, headers}
+}
+
+// queryLogs404JSONResponse is returned for log queries without results when the handler is
+// configured to treat an empty result as not found. The shared API spec does not define it.
+type queryLogs404JSONResponse gen.ErrorResponse
+
+func (response queryLogs404JSONResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+func noLogsFoundResponse() queryLogs404JSONResponse {
+	return queryLogs404JSONResponse{
+		Title:   ptr(gen.NotFound),
+		Message: ptr(noLogsFoundMessage),
+	}
+}
+
+const noLogsFoundMessage = "no logs found matching the query"
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// SearchLogsAcrossOrgs handles POST /api/v1/admin/logs/search. It runs a component log search
+// in every organization of AdminOrgs and returns their logs merged in the requested order,
+// each tagged with its organization, for a cross-tenant view. The endpoint is reserved to
+// platform admins (see adminAuthMiddleware).
+func (h *LogsHandler) SearchLogsAcrossOrgs(w http.ResponseWriter, r *http.Request) {
+	if len(h.adminOrgs) == 0 {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "no organizations are configured for admin search")
+		return
+	}
+
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if params.Before != "" || params.After != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "before and after are not supported across organizations")
+		return
+	}
+	if msg := h.validateSearchParams(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.resolveMinLevel(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.validateLabelSelectors(params.LabelSelectors); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	result, err := h.client.GetComponentLogsAcrossOrgs(r.Context(), h.adminOrgs, params, h.adminOrgConcurrency)
+	if msg, ok := queryRejection(err); ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to search component logs across organizations",
+			slog.String("function", "SearchLogsAcrossOrgs"),
+			slog.String("namespace", params.Namespace),
+			slog.String("orgs", strings.Join(h.adminOrgs, ",")),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	if result.NearLimit {
+		w.Header().Set(nearLimitHeader, "true")
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestSearchLogsAcrossOrgs(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		org := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/"), "/")[0]
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{
+			{"_timestamp": float64(1735732800000000), "log": "hello from " + org, "total": float64(1)},
+		}})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+
+	body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	tests := []struct {
+		name       string
+		orgs       []string
+		auth       APIAuth
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{name: "admin endpoints disabled", orgs: []string{"team-a"}, token: "admin-token", wantStatus: http.StatusForbidden},
+		{name: "missing admin token", orgs: []string{"team-a"}, auth: APIAuth{AdminTokens: []string{"admin-token"}}, wantStatus: http.StatusUnauthorized},
+		{name: "regular token", orgs: []string{"team-a"}, auth: APIAuth{Tokens: []string{"token"}, AdminTokens: []string{"admin-token"}}, token: "token", wantStatus: http.StatusUnauthorized},
+		{name: "no organizations", auth: APIAuth{AdminTokens: []string{"admin-token"}}, token: "admin-token", wantStatus: http.StatusNotFound},
+		{name: "cursor", orgs: []string{"team-a"}, auth: APIAuth{AdminTokens: []string{"admin-token"}}, token: "admin-token", body: `{"namespace":"test-ns","after":"abc"}`, wantStatus: http.StatusBadRequest},
+		{name: "success", orgs: []string{"team-a", "team-b"}, auth: APIAuth{Tokens: []string{"token"}, AdminTokens: []string{"admin-token"}}, token: "admin-token", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{AdminOrgs: tt.orgs}, testLogger())
+			srv := NewServerWithOptions("0", handler, ServerOptions{Auth: tt.auth}, testLogger())
+
+			reqBody := body
+			if tt.body != "" {
+				reqBody = tt.body
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/logs/search", strings.NewReader(reqBody))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			srv.httpServer.Handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+			var result openobserve.OrgLogsResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("invalid JSON response: %v", err)
+			}
+			if len(result.Logs) != 2 || result.Logs[0].Org == result.Logs[1].Org || result.TotalCount != 2 {
+				t.Errorf("expected one log per organization, got %+v", result)
+			}
+		})
+	}
+}
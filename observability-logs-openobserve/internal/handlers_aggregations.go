@@ -0,0 +1,392 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// Query modes of the explore endpoint.
+const (
+	exploreModeLogs      = "logs"
+	exploreModeAggregate = "aggregate"
+	exploreModeHistogram = "histogram"
+	exploreModeCombined  = "combined"
+	exploreModeBuckets   = "buckets"
+	exploreModeLevels    = "levels"
+)
+
+// Aggregations available in the aggregate mode.
+const (
+	aggregationVolume      = "volume"
+	aggregationDistinct    = "distinct"
+	aggregationPods        = "pods"
+	aggregationPercentiles = "percentiles"
+	aggregationGrouped     = "grouped"
+)
+
+// Logs the pods aggregation can add to each pod.
+const (
+	podLineLatest   = "latest"
+	podLineEarliest = "earliest"
+)
+
+// defaultPercentiles are computed when a percentiles request does not list any.
+var defaultPercentiles = []float64{0.5, 0.95, 0.99}
+
+// exploreRequest is the body of the explore endpoint and of the dedicated aggregation
+// endpoints: the usual component log filters plus the mode-specific settings.
+type exploreRequest struct {
+	openobserve.ComponentLogsParams
+	// Mode selects the query to run: logs, aggregate, histogram, combined (logs and histogram),
+	// buckets (histogram with sample logs per bucket) or levels (histogram per log level).
+	Mode string `json:"mode"`
+	// Aggregation selects the aggregate: volume (default), distinct, pods, percentiles or
+	// grouped.
+	Aggregation string `json:"aggregation,omitempty"`
+	// Field and Percentiles configure the percentiles aggregation. Field is also the numeric
+	// field summed or averaged by the grouped aggregation.
+	Field       string    `json:"field,omitempty"`
+	Percentiles []float64 `json:"percentiles,omitempty"`
+	// GroupBy and Function configure the grouped aggregation: the fields the logs are grouped
+	// by and the function computed per group, count (default), sum or avg.
+	GroupBy  []string `json:"groupBy,omitempty"`
+	Function string   `json:"function,omitempty"`
+	// Interval is the histogram bucket width (e.g. "5m") of the histogram, combined, levels and
+	// buckets modes; OpenObserve picks one when empty, and the buckets mode splits the window
+	// into about 60 buckets.
+	Interval string `json:"interval,omitempty"`
+	// Samples is the number of sample logs returned per bucket in the buckets mode.
+	Samples int `json:"samples,omitempty"`
+	// Line adds the latest or earliest log of each pod to the pods aggregation.
+	Line string `json:"line,omitempty"`
+}
+
+// QueryExplore implements POST /api/v1/logs/explore.
+// It is the single entry point for component log queries: the "mode" field selects
+// between plain log search (logs), an aggregation over the window (aggregate), log counts
+// per time bucket (histogram), both the logs and their histogram in one response
+// (combined), log counts per time bucket with a few sample logs each (buckets) and log counts
+// per time bucket and log level (levels). All modes share the same filters and validation.
+func (h *LogsHandler) QueryExplore(w http.ResponseWriter, r *http.Request) {
+	var req exploreRequest
+	if err := decodeJSONBody(r, &req); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	h.serveExplore(w, r, req)
+}
+
+// QueryLogVolume implements POST /api/v1/logs/volume.
+// It returns the number of matching log lines per component for the requested
+// project/environment and time window, sorted from the noisiest component down.
+func (h *LogsHandler) QueryLogVolume(w http.ResponseWriter, r *http.Request) {
+	h.serveAggregation(w, r, aggregationVolume)
+}
+
+// QueryDistinctLogMessages implements POST /api/v1/logs/distinct.
+// It collapses identical log messages in the requested window and returns each unique
+// message with its frequency and last occurrence, giving a "top errors" view.
+func (h *LogsHandler) QueryDistinctLogMessages(w http.ResponseWriter, r *http.Request) {
+	h.serveAggregation(w, r, aggregationDistinct)
+}
+
+// QueryComponentPods implements POST /api/v1/logs/pods.
+// It lists the pods that produced matching logs in the time window with their last-seen
+// time, most recently active first, e.g. to populate a pod picker for a component. With
+// "line" set to latest or earliest, each pod carries its newest or oldest log in the window,
+// a compact overview of which pods are alive and what they last logged.
+func (h *LogsHandler) QueryComponentPods(w http.ResponseWriter, r *http.Request) {
+	h.serveAggregation(w, r, aggregationPods)
+}
+
+// QueryComponentPercentiles implements POST /api/v1/logs/percentiles.
+// It computes approximate percentiles (p50/p95/p99 by default) of a numeric log field per
+// component over the time window. Only fields from the configured allowlist may be queried.
+func (h *LogsHandler) QueryComponentPercentiles(w http.ResponseWriter, r *http.Request) {
+	h.serveAggregation(w, r, aggregationPercentiles)
+}
+
+// QueryGroupedAggregation implements POST /api/v1/logs/aggregate.
+// It groups the matching logs by up to four fields from a fixed list ("groupBy", e.g.
+// componentName and logLevel) and counts the logs of each group, or sums or averages a
+// numeric log field ("function" and "field"), largest group first. The summed or averaged
+// field must be enabled like the percentiles field. One endpoint thereby serves most
+// dashboard panels: logs by level, by component or by pod, bytes written per pod, and so on.
+func (h *LogsHandler) QueryGroupedAggregation(w http.ResponseWriter, r *http.Request) {
+	h.serveAggregation(w, r, aggregationGrouped)
+}
+
+// serveAggregation runs the given aggregation with the filters of the request body.
+func (h *LogsHandler) serveAggregation(w http.ResponseWriter, r *http.Request, aggregation string) {
+	var req exploreRequest
+	if err := decodeJSONBody(r, &req); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	req.Mode = exploreModeAggregate
+	req.Aggregation = aggregation
+	h.serveExplore(w, r, req)
+}
+
+// serveExplore validates req and dispatches it to the query of its mode.
+func (h *LogsHandler) serveExplore(w http.ResponseWriter, r *http.Request, req exploreRequest) {
+	params := req.ComponentLogsParams
+
+	var msg string
+	switch req.Mode {
+	case exploreModeLogs:
+		msg = h.validateSearchParams(&params)
+	case exploreModeAggregate, exploreModeHistogram, exploreModeLevels:
+		msg = h.validateAggregationParams(&params)
+	case exploreModeBuckets:
+		if msg = h.validateAggregationParams(&params); msg == "" && (req.Samples < 0 || req.Samples > openobserve.MaxBucketSamples) {
+			msg = fmt.Sprintf("samples must be between 1 and %d", openobserve.MaxBucketSamples)
+		}
+	case exploreModeCombined:
+		// The histogram covers the whole window, so the logs cannot be pinned to an instant.
+		if msg = h.validateSearchParams(&params); msg == "" && (params.AtTimestamp != 0 || len(params.AtTimestamps) > 0) {
+			msg = "atTimestamp and atTimestamps are not supported in combined mode"
+		}
+	default:
+		msg = "mode must be one of logs, aggregate, histogram, combined, buckets, levels"
+	}
+	if msg == "" {
+		msg = parseExplain(r, &params)
+	}
+	if msg == "" {
+		msg = h.resolveMinLevel(&params)
+	}
+	if msg == "" {
+		msg = h.validateLabelSelectors(params.LabelSelectors)
+	}
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	var result interface{}
+	var err error
+	switch req.Mode {
+	case exploreModeLogs:
+		var logs *openobserve.ComponentLogsResult
+		if logs, err = h.clientFor(r.Context()).GetComponentLogs(r.Context(), scopeToTenant(r.Context(), params)); err == nil {
+			if logs.NearLimit {
+				w.Header().Set(nearLimitHeader, "true")
+			}
+			result = logs
+		}
+	case exploreModeAggregate:
+		result, msg, err = h.runAggregation(r, params, req)
+	case exploreModeHistogram:
+		var interval time.Duration
+		if interval, msg = parseHistogramInterval(req.Interval); msg == "" {
+			result, err = h.clientFor(r.Context()).GetComponentLogHistogram(r.Context(), scopeToTenant(r.Context(), params), interval)
+		}
+	case exploreModeLevels:
+		var interval time.Duration
+		if interval, msg = parseHistogramInterval(req.Interval); msg == "" {
+			result, err = h.clientFor(r.Context()).GetComponentLogLevelHistogram(r.Context(), scopeToTenant(r.Context(), params), interval)
+		}
+	case exploreModeCombined:
+		var interval time.Duration
+		if interval, msg = parseHistogramInterval(req.Interval); msg == "" {
+			var combined *openobserve.ComponentLogsWithHistogramResult
+			if combined, err = h.clientFor(r.Context()).GetComponentLogsWithHistogram(r.Context(), scopeToTenant(r.Context(), params), interval); err == nil {
+				if combined.Logs.NearLimit {
+					w.Header().Set(nearLimitHeader, "true")
+				}
+				result = combined
+			}
+		}
+	case exploreModeBuckets:
+		var interval time.Duration
+		if interval, msg = parseHistogramInterval(req.Interval); msg == "" {
+			result, err = h.clientFor(r.Context()).GetComponentLogBucketSamples(r.Context(), scopeToTenant(r.Context(), params), interval, req.Samples)
+		}
+	}
+	if rejection, ok := queryRejection(err); ok {
+		msg = rejection
+	}
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to query component logs",
+			slog.String("function", "serveExplore"),
+			slog.String("mode", req.Mode),
+			slog.String("aggregation", req.Aggregation),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// runAggregation runs the aggregation selected by req. It returns a user-facing message if
+// the aggregation settings are invalid.
+func (h *LogsHandler) runAggregation(r *http.Request, params openobserve.ComponentLogsParams, req exploreRequest) (interface{}, string, error) {
+	switch req.Aggregation {
+	case "", aggregationVolume:
+		result, err := h.clientFor(r.Context()).GetComponentLogVolume(r.Context(), scopeToTenant(r.Context(), params))
+		return result, "", err
+	case aggregationDistinct:
+		result, err := h.clientFor(r.Context()).GetDistinctLogMessages(r.Context(), scopeToTenant(r.Context(), params))
+		return result, "", err
+	case aggregationPods:
+		switch req.Line {
+		case "":
+			result, err := h.clientFor(r.Context()).GetComponentPods(r.Context(), scopeToTenant(r.Context(), params))
+			return result, "", err
+		case podLineLatest, podLineEarliest:
+			result, err := h.clientFor(r.Context()).GetComponentPodLines(r.Context(), scopeToTenant(r.Context(), params), req.Line == podLineEarliest)
+			return result, "", err
+		default:
+			return nil, "line must be latest or earliest", nil
+		}
+	case aggregationPercentiles:
+		percentiles, msg := h.resolvePercentiles(req.Field, req.Percentiles)
+		if msg != "" {
+			return nil, msg, nil
+		}
+		result, err := h.clientFor(r.Context()).GetComponentPercentiles(r.Context(), scopeToTenant(r.Context(), params), req.Field, percentiles)
+		return result, "", err
+	case aggregationGrouped:
+		agg, msg := h.resolveGroupedAggregation(req)
+		if msg != "" {
+			return nil, msg, nil
+		}
+		result, err := h.clientFor(r.Context()).GetGroupedAggregation(r.Context(), scopeToTenant(r.Context(), params), agg)
+		return result, "", err
+	default:
+		return nil, "aggregation must be one of volume, distinct, pods, percentiles, grouped", nil
+	}
+}
+
+// resolveGroupedAggregation checks the settings of a grouped aggregation and returns the
+// aggregation to run, or a user-facing message describing the problem.
+func (h *LogsHandler) resolveGroupedAggregation(req exploreRequest) (openobserve.GroupedAggregation, string) {
+	agg := openobserve.GroupedAggregation{GroupBy: req.GroupBy, Function: req.Function, Field: req.Field}
+	if len(agg.GroupBy) == 0 || len(agg.GroupBy) > openobserve.MaxGroupByFields {
+		return agg, fmt.Sprintf("groupBy must list between 1 and %d fields", openobserve.MaxGroupByFields)
+	}
+	seen := make(map[string]bool, len(agg.GroupBy))
+	for _, field := range agg.GroupBy {
+		if !openobserve.ValidGroupByField(field) {
+			return agg, fmt.Sprintf("cannot group by %q: groupBy fields must be among %s", field, strings.Join(openobserve.GroupByFields(), ", "))
+		}
+		if seen[field] {
+			return agg, fmt.Sprintf("groupBy lists %q more than once", field)
+		}
+		seen[field] = true
+	}
+
+	switch agg.Function {
+	case "":
+		agg.Function = openobserve.AggregateCount
+		fallthrough
+	case openobserve.AggregateCount:
+		if agg.Field != "" {
+			return agg, "field is only supported with the sum and avg functions"
+		}
+	case openobserve.AggregateSum, openobserve.AggregateAvg:
+		if agg.Field == "" {
+			return agg, "field is required for the sum and avg functions"
+		}
+		if !h.percentileFields[agg.Field] {
+			return agg, fmt.Sprintf("field %q is not enabled for numeric aggregations", agg.Field)
+		}
+	default:
+		return agg, "function must be one of count, sum, avg"
+	}
+	return agg, ""
+}
+
+// resolvePercentiles checks the settings of a percentiles aggregation and returns the
+// percentiles to compute, or a user-facing message describing the problem.
+func (h *LogsHandler) resolvePercentiles(field string, percentiles []float64) ([]float64, string) {
+	if field == "" {
+		return nil, "field is required"
+	}
+	if !h.percentileFields[field] {
+		return nil, fmt.Sprintf("field %q is not enabled for percentile queries", field)
+	}
+	if len(percentiles) == 0 {
+		return defaultPercentiles, ""
+	}
+	for _, p := range percentiles {
+		if p <= 0 || p >= 1 {
+			return nil, "percentiles must be between 0 and 1 (exclusive)"
+		}
+	}
+	return percentiles, ""
+}
+
+// parseHistogramInterval parses the histogram bucket width. An empty value returns zero,
+// letting OpenObserve choose the interval.
+func parseHistogramInterval(value string) (time.Duration, string) {
+	if value == "" {
+		return 0, ""
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval < time.Second || interval%time.Second != 0 {
+		return 0, "interval must be a whole number of seconds of at least 1s (e.g. 30s, 5m, 1h)"
+	}
+	return interval, ""
+}
+
+// validateAggregationParams checks the parameters shared by the aggregation endpoints and
+// returns a user-facing message describing the first problem found, or "" if they are valid.
+func (h *LogsHandler) validateAggregationParams(params *openobserve.ComponentLogsParams) string {
+	if strings.TrimSpace(params.Namespace) == "" {
+		return "namespace is required"
+	}
+	// Without either, the client queries its default window up to now.
+	if params.StartTime.IsZero() != params.EndTime.IsZero() {
+		return "startTime and endTime must be given together"
+	}
+	if params.EndTime.Before(params.StartTime) {
+		return "endTime must not be before startTime"
+	}
+	if msg := h.checkClockSkew(params); msg != "" {
+		return msg
+	}
+	if msg := validateExcludePhrases(params.ExcludePhrases); msg != "" {
+		return msg
+	}
+	return validateLogStream(params.Stream)
+}
+
+// maxExcludePhrases bounds the number of phrases a component log query may exclude, each of
+// which adds a scan of the log line.
+const maxExcludePhrases = 20
+
+// validateExcludePhrases checks the excluded phrases of a component log query.
+func validateExcludePhrases(phrases []string) string {
+	if len(phrases) > maxExcludePhrases {
+		return fmt.Sprintf("excludePhrases may list at most %d phrases", maxExcludePhrases)
+	}
+	return ""
+}
+
+// validateLogStream checks the output stream filter of a component log query.
+func validateLogStream(stream string) string {
+	switch stream {
+	case "", "stdout", "stderr":
+		return ""
+	default:
+		return "stream must be stdout or stderr"
+	}
+}
@@ -0,0 +1,598 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestQueryLogVolume(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Took: 3,
+			Hits: []map[string]interface{}{
+				{"component_uid": "comp-1", "component_name": "api", "total": float64(42)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","projectId":"proj-1","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/volume", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryLogVolume(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.ComponentLogVolumeResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Components) != 1 || result.Components[0].Count != 42 {
+		t.Errorf("unexpected components: %+v", result.Components)
+	}
+}
+
+func TestQueryLogVolume_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"malformed JSON", `{`},
+		{"missing namespace", `{"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"partial time range", `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`},
+		{"inverted time range", `{"namespace":"test-ns","startTime":"2025-01-02T00:00:00Z","endTime":"2025-01-01T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/volume", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.QueryLogVolume(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestQueryLogVolume_ServerError(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/volume", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryLogVolume(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+}
+
+func TestQueryDistinctLogMessages(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Took: 2,
+			Hits: []map[string]interface{}{
+				{"log": "connection refused", "total": float64(7), "last_seen": float64(1735732800000000)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","logLevels":["ERROR"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/distinct", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryDistinctLogMessages(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.DistinctLogMessagesResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Messages) != 1 || result.Messages[0].Count != 7 {
+		t.Errorf("unexpected messages: %+v", result.Messages)
+	}
+}
+
+func TestQueryDistinctLogMessages_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/distinct", strings.NewReader(`{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`))
+	rec := httptest.NewRecorder()
+	handler.QueryDistinctLogMessages(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestQueryComponentPercentiles(t *testing.T) {
+	var gotSQL string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotSQL = body.Query.SQL
+		resp := openobserve.OpenObserveResponse{
+			Took: 3,
+			Hits: []map[string]interface{}{
+				{"component_uid": "c-1", "component_name": "api", "total": float64(10), "p50": float64(5), "p95": float64(20), "p99": float64(31)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{PercentileFields: []string{"latency_ms"}}, testLogger())
+
+	body := `{"namespace":"test-ns","field":"latency_ms","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/percentiles", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryComponentPercentiles(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(gotSQL, "approx_percentile_cont(latency_ms, 0.99) AS p99") {
+		t.Errorf("expected default percentiles in SQL, got: %s", gotSQL)
+	}
+	var result openobserve.ComponentPercentilesResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Components) != 1 || result.Components[0].Percentiles["p95"] != 20 {
+		t.Errorf("unexpected components: %+v", result.Components)
+	}
+}
+
+func TestQueryComponentPercentiles_BadRequest(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{PercentileFields: []string{"latency_ms"}}, testLogger())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"partial time range", `{"namespace":"test-ns","field":"latency_ms","startTime":"2025-01-01T00:00:00Z"}`},
+		{"missing field", `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"field not allowed", `{"namespace":"test-ns","field":"user_id","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"percentile out of range", `{"namespace":"test-ns","field":"latency_ms","percentiles":[95],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/percentiles", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.QueryComponentPercentiles(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestQueryGroupedAggregation(t *testing.T) {
+	var gotSQL string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotSQL = body.Query.SQL
+		resp := openobserve.OpenObserveResponse{
+			Took: 2,
+			Hits: []map[string]interface{}{
+				{"group_0": "api-7f9c", "total": float64(10), "value": float64(4096)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{PercentileFields: []string{"bytes"}}, testLogger())
+
+	body := `{"namespace":"test-ns","groupBy":["podName"],"function":"sum","field":"bytes","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/aggregate", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryGroupedAggregation(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(gotSQL, "sum(bytes) AS value") || !strings.Contains(gotSQL, "GROUP BY kubernetes_pod_name") {
+		t.Errorf("unexpected SQL: %s", gotSQL)
+	}
+	var result openobserve.GroupedAggregationResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Groups) != 1 || result.Groups[0].Keys["podName"] != "api-7f9c" || *result.Groups[0].Value != 4096 {
+		t.Errorf("unexpected groups: %+v", result.Groups)
+	}
+}
+
+func TestQueryGroupedAggregation_BadRequest(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{PercentileFields: []string{"bytes"}}, testLogger())
+
+	const window = `"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"`
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing groupBy", `{` + window + `}`},
+		{"too many groupBy fields", `{"groupBy":["logLevel","podName","podId","stream","version"],` + window + `}`},
+		{"unknown groupBy field", `{"groupBy":["log"],` + window + `}`},
+		{"repeated groupBy field", `{"groupBy":["logLevel","logLevel"],` + window + `}`},
+		{"unknown function", `{"groupBy":["logLevel"],"function":"max","field":"bytes",` + window + `}`},
+		{"sum without field", `{"groupBy":["logLevel"],"function":"sum",` + window + `}`},
+		{"field not allowed", `{"groupBy":["logLevel"],"function":"avg","field":"user_id",` + window + `}`},
+		{"count with field", `{"groupBy":["logLevel"],"field":"bytes",` + window + `}`},
+		{"partial time range", `{"groupBy":["logLevel"],"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/aggregate", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.QueryGroupedAggregation(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryComponentPods(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Took: 1,
+			Hits: []map[string]interface{}{
+				{"pod_id": "uid-1", "pod_name": "api-7d9f-a", "total": float64(3), "last_seen": float64(1735732800000000)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","componentIds":["comp-1"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/pods", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryComponentPods(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.ComponentPodsResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Pods) != 1 || result.Pods[0].PodID != "uid-1" {
+		t.Errorf("unexpected pods: %+v", result.Pods)
+	}
+}
+
+func TestQueryComponentPods_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/pods", strings.NewReader(`{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`))
+	rec := httptest.NewRecorder()
+	handler.QueryComponentPods(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestQueryComponentPods_Line(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		hits := []map[string]interface{}{
+			{"pod_id": "uid-1", "pod_name": "api-7d9f-a", "total": float64(3), "last_seen": float64(1735732800000000)},
+			{"pod_id": "uid-2", "pod_name": "api-7d9f-b", "total": float64(1), "last_seen": float64(1735729200000000)},
+		}
+		if strings.Contains(body.Query.SQL, "pod_row = 1") {
+			hits = []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "still serving", "kubernetes_pod_id": "uid-1", "kubernetes_pod_name": "api-7d9f-a"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: hits})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","line":"latest","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/pods", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryComponentPods(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.ComponentPodsResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Pods) != 2 || result.Pods[0].Line == nil || result.Pods[0].Line.Log != "still serving" || result.Pods[0].Count != 3 {
+		t.Errorf("expected the latest line of the first pod, got %+v", result.Pods)
+	}
+	if result.Pods[1].Line != nil {
+		t.Errorf("expected no line for a pod missing from the line query, got %+v", result.Pods[1].Line)
+	}
+
+	body = `{"namespace":"test-ns","line":"first","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/logs/pods", strings.NewReader(body))
+	rec = httptest.NewRecorder()
+	handler.QueryComponentPods(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown line, got %d", rec.Code)
+	}
+}
+
+func TestQueryExplore(t *testing.T) {
+	var gotSQL []string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotSQL = append(gotSQL, body.Query.SQL)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{PercentileFields: []string{"latency_ms"}}, testLogger())
+
+	const window = `"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"`
+	tests := []struct {
+		name    string
+		body    string
+		wantSQL string
+	}{
+		{"logs", `{"mode":"logs",` + window + `}`, "SELECT *"},
+		{"aggregate defaults to volume", `{"mode":"aggregate",` + window + `}`, "count(*) AS total"},
+		{"aggregate distinct", `{"mode":"aggregate","aggregation":"distinct",` + window + `}`, "GROUP BY log"},
+		{"aggregate pods", `{"mode":"aggregate","aggregation":"pods",` + window + `}`, "GROUP BY kubernetes_pod_id"},
+		{"aggregate percentiles", `{"mode":"aggregate","aggregation":"percentiles","field":"latency_ms",` + window + `}`, "approx_percentile_cont(latency_ms, 0.5)"},
+		{"aggregate grouped", `{"mode":"aggregate","aggregation":"grouped","groupBy":["componentName","logLevel"],` + window + `}`, "GROUP BY kubernetes_labels_openchoreo_dev_component, logLevel"},
+		{"histogram", `{"mode":"histogram","interval":"1h",` + window + `}`, "histogram(_timestamp, '3600 second')"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL = nil
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/explore", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.QueryExplore(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if len(gotSQL) == 0 || !strings.Contains(gotSQL[0], tt.wantSQL) {
+				t.Errorf("expected SQL to contain %q, got: %v", tt.wantSQL, gotSQL)
+			}
+		})
+	}
+}
+
+func TestQueryExplore_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	const window = `"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"`
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing mode", `{` + window + `}`},
+		{"unknown mode", `{"mode":"metrics",` + window + `}`},
+		{"aggregate with a partial time range", `{"mode":"aggregate","namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`},
+		{"unknown aggregation", `{"mode":"aggregate","aggregation":"median",` + window + `}`},
+		{"percentiles field not allowed", `{"mode":"aggregate","aggregation":"percentiles","field":"latency_ms",` + window + `}`},
+		{"invalid histogram interval", `{"mode":"histogram","interval":"500ms",` + window + `}`},
+		{"logs without namespace", `{"mode":"logs"}`},
+		{"combined with a partial time range", `{"mode":"combined","namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`},
+		{"combined with atTimestamp", `{"mode":"combined","atTimestamp":1735732800000000,` + window + `}`},
+		{"buckets with too many samples", `{"mode":"buckets","samples":11,` + window + `}`},
+		{"buckets with a partial time range", `{"mode":"buckets","namespace":"test-ns","startTime":"2025-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/explore", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.QueryExplore(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestQueryExplore_Combined(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "histogram("):
+			w.Write([]byte(`{"took":2,"hits":[{"bucket":"2025-01-01T12:00:00","total":1}]}`))
+		case strings.Contains(string(body), "count(*)"):
+			w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+		default:
+			w.Write([]byte(`{"took":3,"hits":[{"_timestamp":1735732800000000,"log":"hello"}]}`))
+		}
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"mode":"combined","interval":"1h","namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/explore", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryExplore(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp openobserve.ComponentLogsWithHistogramResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Logs == nil || len(resp.Logs.Logs) != 1 || resp.Logs.TotalCount != 1 {
+		t.Errorf("unexpected logs: %+v", resp.Logs)
+	}
+	if resp.Histogram == nil || len(resp.Histogram.Buckets) != 1 {
+		t.Errorf("unexpected histogram: %+v", resp.Histogram)
+	}
+}
+
+func TestQueryExplore_Buckets(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "row_number()"):
+			if !strings.Contains(string(body), `bucket_row \u003c= 2`) {
+				t.Errorf("expected 2 samples per bucket, got %s", body)
+			}
+			w.Write([]byte(`{"took":4,"hits":[
+				{"bucket":"2025-01-01T00:00:00","_timestamp":1735689600000000,"log":"first"},
+				{"bucket":"2025-01-01T00:00:00","_timestamp":1735689660000000,"log":"second"},
+				{"bucket":"2025-01-01T02:00:00","_timestamp":1735696800000000,"log":"later"}
+			]}`))
+		default:
+			w.Write([]byte(`{"took":2,"hits":[
+				{"bucket":"2025-01-01T00:00:00","total":7},
+				{"bucket":"2025-01-01T01:00:00","total":0},
+				{"bucket":"2025-01-01T02:00:00","total":1}
+			]}`))
+		}
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"mode":"buckets","interval":"1h","samples":2,"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-01T03:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/explore", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryExplore(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp openobserve.LogBucketSamplesResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Buckets) != 3 || resp.Interval != "1h0m0s" || resp.Took != 4 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	first := resp.Buckets[0]
+	if first.Count != 7 || len(first.Samples) != 2 || first.Samples[0].Log != "second" {
+		t.Errorf("expected the newest samples of the first bucket, got %+v", first)
+	}
+	if empty := resp.Buckets[1]; empty.Samples == nil || len(empty.Samples) != 0 {
+		t.Errorf("expected an empty sample list, got %+v", empty)
+	}
+	if last := resp.Buckets[2]; last.Count != 1 || len(last.Samples) != 1 || last.Samples[0].Log != "later" {
+		t.Errorf("unexpected last bucket: %+v", last)
+	}
+}
+
+func TestQueryExplore_Levels(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "GROUP BY bucket, level") || !strings.Contains(string(body), "'3600 second'") {
+			t.Errorf("expected counts per bucket and level, got %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":3,"hits":[
+			{"bucket":"2025-01-01T00:00:00","level":"ERROR","total":2},
+			{"bucket":"2025-01-01T00:00:00","level":"INFO","total":5},
+			{"bucket":"2025-01-01T01:00:00","level":"INFO","total":1},
+			{"bucket":"2025-01-01T01:00:00","level":null,"total":4}
+		]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"mode":"levels","interval":"1h","namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-01T02:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/explore", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.QueryExplore(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp openobserve.LogLevelHistogramResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if strings.Join(resp.Levels, ",") != "ERROR,INFO,UNKNOWN" || len(resp.Buckets) != 2 || resp.Took != 3 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	first, second := resp.Buckets[0], resp.Buckets[1]
+	if first.Total != 7 || first.Counts["ERROR"] != 2 || first.Counts["INFO"] != 5 || len(first.Counts) != 3 {
+		t.Errorf("unexpected first bucket: %+v", first)
+	}
+	if second.Total != 5 || second.Counts["ERROR"] != 0 || second.Counts["UNKNOWN"] != 4 || len(second.Counts) != 3 {
+		t.Errorf("expected the missing levels to be filled with zero, got %+v", second)
+	}
+}
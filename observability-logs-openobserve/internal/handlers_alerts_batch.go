@@ -0,0 +1,184 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+const (
+	// maxAlertBatchSize caps the number of alert rules created by a single batch request.
+	maxAlertBatchSize = 500
+	// maxAlertBatchLineBytes caps the length of a single JSON Lines alert definition.
+	maxAlertBatchLineBytes = 1 << 20
+)
+
+// jsonLinesContentTypes are the media types that select JSON Lines input for batch requests.
+var jsonLinesContentTypes = map[string]bool{
+	"application/x-ndjson":    true,
+	"application/jsonl":       true,
+	"application/x-jsonlines": true,
+	"application/json-lines":  true,
+}
+
+// alertBatchItem is a single alert rule definition of a batch, with its position in the body.
+type alertBatchItem struct {
+	raw   json.RawMessage
+	index int // zero-based position in a JSON array
+	line  int // one-based line number in a JSON Lines body; zero for JSON arrays
+}
+
+// alertBatchResult reports the outcome of one alert rule of a batch. Results are identified by
+// their line in a JSON Lines body or their index in a JSON array.
+type alertBatchResult struct {
+	Line          int     `json:"line,omitempty"`
+	Index         *int    `json:"index,omitempty"`
+	Name          string  `json:"name,omitempty"`
+	Status        int     `json:"status"`
+	RuleBackendID *string `json:"ruleBackendId,omitempty"`
+	Error         string  `json:"error,omitempty"`
+}
+
+type alertBatchResponse struct {
+	Created int                `json:"created"`
+	Failed  int                `json:"failed"`
+	Results []alertBatchResult `json:"results"`
+}
+
+// CreateAlertRules implements POST /api/v1alpha1/alerts/rules/batch.
+// It creates several alert rules in one request. The body is a JSON array of alert rule
+// requests or, with a JSON Lines content type (e.g. application/x-ndjson), one alert rule
+// request per line, so that generated definitions can be piped in directly. Each rule is
+// created as by POST /api/v1alpha1/alerts/rules, and may additionally carry a mute window
+// schedule (see openobserve.AlertSchedule); a failing rule does not stop the batch, and the
+// response reports the status of every rule with its line number or array index.
+func (h *LogsHandler) CreateAlertRules(w http.ResponseWriter, r *http.Request) {
+	var items []alertBatchItem
+	var err error
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if jsonLinesContentTypes[mediaType] {
+		items, err = readAlertBatchLines(r.Body)
+	} else {
+		items, err = readAlertBatchArray(r.Body)
+	}
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, err.Error())
+		return
+	}
+	if len(items) == 0 {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "at least one alert rule is required")
+		return
+	}
+	if len(items) > maxAlertBatchSize {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest,
+			fmt.Sprintf("a batch may contain at most %d alert rules, got %d", maxAlertBatchSize, len(items)))
+		return
+	}
+
+	resp := alertBatchResponse{Results: make([]alertBatchResult, 0, len(items))}
+	for _, item := range items {
+		result := h.createBatchAlertRule(r, item)
+		if result.Status == http.StatusCreated {
+			resp.Created++
+		} else {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// createBatchAlertRule creates the alert rule of a single batch item.
+func (h *LogsHandler) createBatchAlertRule(r *http.Request, item alertBatchItem) alertBatchResult {
+	var result alertBatchResult
+	if item.line > 0 {
+		result.Line = item.line
+	} else {
+		index := item.index
+		result.Index = &index
+	}
+
+	var body gen.AlertRuleRequest
+	if err := json.Unmarshal(item.raw, &body); err != nil {
+		result.Status = http.StatusBadRequest
+		result.Error = "invalid alert rule: " + err.Error()
+		return result
+	}
+	result.Name = body.Metadata.Name
+
+	// Batch items may also carry a mute window schedule, which the shared API spec lacks.
+	var extensions struct {
+		Schedule *openobserve.AlertSchedule `json:"schedule"`
+	}
+	if err := json.Unmarshal(item.raw, &extensions); err != nil {
+		result.Status = http.StatusBadRequest
+		result.Error = "invalid alert rule: " + err.Error()
+		return result
+	}
+
+	switch resp := h.createAlertRule(r.Context(), &body, extensions.Schedule).(type) {
+	case gen.CreateAlertRule201JSONResponse:
+		result.Status = http.StatusCreated
+		result.RuleBackendID = resp.RuleBackendId
+	case gen.CreateAlertRule400JSONResponse:
+		result.Status = http.StatusBadRequest
+		result.Error = derefString(resp.Message)
+	case gen.CreateAlertRule409JSONResponse:
+		result.Status = http.StatusConflict
+		result.Error = derefString(resp.Message)
+	default:
+		result.Status = http.StatusInternalServerError
+		result.Error = "internal server error"
+	}
+	return result
+}
+
+// readAlertBatchArray reads a JSON array of alert rule definitions.
+func readAlertBatchArray(body io.Reader) ([]alertBatchItem, error) {
+	var raws []json.RawMessage
+	if err := json.NewDecoder(body).Decode(&raws); err != nil {
+		return nil, fmt.Errorf("request body must be a JSON array of alert rules or JSON Lines with a JSON Lines content type")
+	}
+	items := make([]alertBatchItem, len(raws))
+	for i, raw := range raws {
+		items[i] = alertBatchItem{raw: raw, index: i}
+	}
+	return items, nil
+}
+
+// readAlertBatchLines reads one alert rule definition per line, skipping blank lines. Lines
+// that are not valid JSON are reported per rule rather than failing the whole batch.
+func readAlertBatchLines(body io.Reader) ([]alertBatchItem, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAlertBatchLineBytes)
+
+	var items []alertBatchItem
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		items = append(items, alertBatchItem{raw: append(json.RawMessage(nil), text...), line: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read JSON Lines body: %v", err)
+	}
+	return items, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,190 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// newAlertBatchHandler returns a handler backed by a mock OpenObserve that creates every alert
+// except those named "existing", which already exist.
+func newAlertBatchHandler(t *testing.T) *LogsHandler {
+	t.Helper()
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(string(body), `"name":"existing"`) {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"alert already exists"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "alert-123"})
+	}))
+	t.Cleanup(ooServer.Close)
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	return NewLogsHandler(client, nil, testLogger())
+}
+
+func alertBatchLine(t *testing.T, name string) string {
+	t.Helper()
+	req := validAlertRuleRequest()
+	req.Metadata.Name = name
+	b, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("failed to marshal alert rule: %v", err)
+	}
+	return string(b)
+}
+
+func postAlertBatch(t *testing.T, handler *LogsHandler, contentType, body string) (int, alertBatchResponse) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/alerts/rules/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.CreateAlertRules(rec, req)
+
+	var resp alertBatchResponse
+	if rec.Code == http.StatusOK {
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+	return rec.Code, resp
+}
+
+func TestCreateAlertRules_Array(t *testing.T) {
+	handler := newAlertBatchHandler(t)
+	body := "[" + alertBatchLine(t, "first") + "," + alertBatchLine(t, "existing") + "]"
+
+	code, resp := postAlertBatch(t, handler, "application/json", body)
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if resp.Created != 1 || resp.Failed != 1 || len(resp.Results) != 2 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	first, second := resp.Results[0], resp.Results[1]
+	if first.Index == nil || *first.Index != 0 || first.Line != 0 {
+		t.Errorf("expected first result at index 0, got %+v", first)
+	}
+	if first.Status != http.StatusCreated || first.RuleBackendID == nil || *first.RuleBackendID != "alert-123" {
+		t.Errorf("expected first rule to be created, got %+v", first)
+	}
+	if second.Index == nil || *second.Index != 1 || second.Status != http.StatusConflict || second.Name != "existing" {
+		t.Errorf("expected second rule to conflict, got %+v", second)
+	}
+}
+
+func TestCreateAlertRules_JSONLines(t *testing.T) {
+	handler := newAlertBatchHandler(t)
+	invalid := validAlertRuleRequest()
+	invalid.Metadata.Name = "invalid"
+	invalid.Source.Query = ""
+	invalidLine, _ := json.Marshal(invalid)
+	body := strings.Join([]string{
+		alertBatchLine(t, "first"),
+		"",
+		`{"metadata": {"name": "broken"`,
+		string(invalidLine),
+		alertBatchLine(t, "second"),
+	}, "\n") + "\n"
+
+	code, resp := postAlertBatch(t, handler, "application/x-ndjson; charset=utf-8", body)
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if resp.Created != 2 || resp.Failed != 2 || len(resp.Results) != 4 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	want := []struct {
+		line   int
+		status int
+	}{
+		{1, http.StatusCreated},
+		{3, http.StatusBadRequest},
+		{4, http.StatusBadRequest},
+		{5, http.StatusCreated},
+	}
+	for i, w := range want {
+		got := resp.Results[i]
+		if got.Line != w.line || got.Status != w.status || got.Index != nil {
+			t.Errorf("result %d: expected line %d with status %d, got %+v", i, w.line, w.status, got)
+		}
+	}
+	if !strings.Contains(resp.Results[1].Error, "invalid alert rule") {
+		t.Errorf("expected a parse error for line 3, got %q", resp.Results[1].Error)
+	}
+	if !strings.Contains(resp.Results[2].Error, "source.query") {
+		t.Errorf("expected a validation error for line 4, got %q", resp.Results[2].Error)
+	}
+}
+
+func TestCreateAlertRules_BadRequest(t *testing.T) {
+	handler := newAlertBatchHandler(t)
+	tooMany := strings.Repeat(alertBatchLine(t, "rule")+"\n", maxAlertBatchSize+1)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{"JSON Lines sent as JSON", "application/json", alertBatchLine(t, "a") + "\n" + alertBatchLine(t, "b")},
+		{"not an array", "application/json", `{"rules": []}`},
+		{"empty array", "application/json", `[]`},
+		{"blank JSON Lines", "application/jsonl", "\n\n"},
+		{"too many rules", "application/x-ndjson", tooMany},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _ := postAlertBatch(t, handler, tt.contentType, tt.body); code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", code)
+			}
+		})
+	}
+}
+
+func TestCreateAlertRules_Schedule(t *testing.T) {
+	var alertBody string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		alertBody = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "alert-123"})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	withSchedule := func(name, schedule string) string {
+		line := alertBatchLine(t, name)
+		return line[:len(line)-1] + `,"schedule":` + schedule + "}"
+	}
+	body := withSchedule("nightly", `{"muteWindows":[{"start":"01:00","end":"03:00"}],"timeZone":"UTC"}`) + "\n" +
+		withSchedule("broken", `{"muteWindows":[{"start":"01:30","end":"03:00"}]}`) + "\n"
+
+	code, resp := postAlertBatch(t, handler, "application/x-ndjson", body)
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	if resp.Created != 1 || resp.Failed != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if !strings.Contains(alertBody, `"cron":"0 */1 0,3-23 * * *"`) {
+		t.Errorf("expected the mute window as a cron schedule, got %s", alertBody)
+	}
+	if broken := resp.Results[1]; broken.Status != http.StatusBadRequest || !strings.Contains(broken.Error, "must fall on the hour") {
+		t.Errorf("expected the malformed schedule to be rejected, got %+v", broken)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// maxAlertEvidenceLimit caps the number of log lines returned as the evidence of an alert.
+const maxAlertEvidenceLimit = 1000
+
+// GetAlertEvidence implements GET /api/v1alpha1/alerts/rules/{ruleName}/evidence.
+// It returns the log lines an alert rule counted, newest first, by running the rule's own
+// search, to show what pushed it over its threshold. The startTime and endTime query
+// parameters (RFC3339) select the window, by default the rule's evaluation window up to now;
+// "limit" (default 100, at most 1000) caps the lines returned.
+func (h *LogsHandler) GetAlertEvidence(w http.ResponseWriter, r *http.Request) {
+	ruleName := r.PathValue("ruleName")
+	var startTime, endTime time.Time
+	for _, param := range []struct {
+		name string
+		t    *time.Time
+	}{{"startTime", &startTime}, {"endTime", &endTime}} {
+		if v := r.URL.Query().Get(param.name); v != "" {
+			var err error
+			if *param.t, err = time.Parse(time.RFC3339, v); err != nil {
+				h.writeError(w, http.StatusBadRequest, gen.BadRequest, param.name+" must be an RFC3339 timestamp")
+				return
+			}
+		}
+	}
+	if !startTime.IsZero() && !endTime.IsZero() && endTime.Before(startTime) {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "endTime must not be before startTime")
+		return
+	}
+	limit, msg := parsePageParam(r, "limit", openobserve.DefaultAlertEvidenceLimit, maxAlertEvidenceLimit)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).GetAlertEvidence(r.Context(), ruleName, startTime, endTime, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			h.writeError(w, http.StatusNotFound, gen.NotFound, "alert rule not found")
+			return
+		}
+		h.logger.Error("Failed to get alert evidence",
+			slog.String("function", "GetAlertEvidence"),
+			slog.String("ruleName", ruleName),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
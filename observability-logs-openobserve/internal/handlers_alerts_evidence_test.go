@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestGetAlertEvidence(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"list": []map[string]string{}})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	mux := http.NewServeMux()
+	NewLogsHandler(client, nil, testLogger()).registerRoutes(mux)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{"malformed start", "?startTime=yesterday", http.StatusBadRequest},
+		{"reversed window", "?startTime=2025-01-02T00:00:00Z&endTime=2025-01-01T00:00:00Z", http.StatusBadRequest},
+		{"limit too large", "?limit=5000", http.StatusBadRequest},
+		{"unknown rule", "?limit=10", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/rules/my-rule/evidence"+tt.query, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+const (
+	// defaultAlertPageSize is the number of alert rules listed per page unless requested otherwise.
+	defaultAlertPageSize = 50
+	// maxAlertPageSize caps the number of alert rules listed per page.
+	maxAlertPageSize = 500
+	// maxAlertPage caps the requested page number, keeping page offsets from overflowing.
+	maxAlertPage = 1 << 20
+)
+
+// ListAlertRules implements GET /api/v1alpha1/alerts/rules.
+// It lists the alert rules ordered by name, one page at a time. The "page" (one-based,
+// default 1) and "pageSize" (default 50, at most 500) query parameters select the page; the
+// response carries the total number of rules and, unless it is the last page, nextPage.
+func (h *LogsHandler) ListAlertRules(w http.ResponseWriter, r *http.Request) {
+	page, msg := parsePageParam(r, "page", 1, maxAlertPage)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	pageSize, msg := parsePageParam(r, "pageSize", defaultAlertPageSize, maxAlertPageSize)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).ListAlerts(r.Context(), page, pageSize)
+	if err != nil {
+		h.logger.Error("Failed to list alert rules",
+			slog.String("function", "ListAlertRules"),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// parsePageParam parses a positive integer query parameter of at most limit, returning def if
+// it is absent. It returns a user-facing message if the parameter is invalid.
+func parsePageParam(r *http.Request, name string, def, limit int) (int, string) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return def, ""
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 || n > limit {
+		return 0, name + " must be an integer between 1 and " + strconv.Itoa(limit)
+	}
+	return n, ""
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestListAlertRules(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		list := make([]map[string]interface{}, 0, 120)
+		for i := 0; i < 120; i++ {
+			list = append(list, map[string]interface{}{"alert_id": fmt.Sprint(i), "name": fmt.Sprintf("rule-%03d", i), "enabled": true})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"list": list})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	srv := NewServer("0", NewLogsHandler(client, nil, testLogger()), testLogger())
+
+	tests := []struct {
+		query     string
+		wantFirst string
+		wantLen   int
+		wantNext  int
+	}{
+		{"", "rule-000", 50, 2},
+		{"?page=3", "rule-100", 20, 0},
+		{"?page=2&pageSize=100", "rule-100", 20, 0},
+		{"?pageSize=500", "rule-000", 120, 0},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/rules"+tt.query, nil)
+		rec := httptest.NewRecorder()
+		srv.httpServer.Handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%q: expected 200, got %d: %s", tt.query, rec.Code, rec.Body.String())
+		}
+		var page openobserve.AlertPage
+		if err := json.Unmarshal(rec.Body.Bytes(), &page); err != nil {
+			t.Fatalf("invalid JSON response: %v", err)
+		}
+		if page.Total != 120 || len(page.Alerts) != tt.wantLen || page.Alerts[0].Name != tt.wantFirst || page.NextPage != tt.wantNext {
+			t.Errorf("%q: unexpected page: total %d, %d alerts from %q, next %d", tt.query, page.Total, len(page.Alerts), page.Alerts[0].Name, page.NextPage)
+		}
+	}
+}
+
+func TestListAlertRules_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	for _, query := range []string{"page=0", "page=abc", "pageSize=0", "pageSize=501"} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/alerts/rules?"+query, nil)
+		rec := httptest.NewRecorder()
+		handler.ListAlertRules(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", query, rec.Code)
+		}
+	}
+}
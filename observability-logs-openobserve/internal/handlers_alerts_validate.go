@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// alertValidationResult reports whether an alert rule request would be accepted.
+type alertValidationResult struct {
+	Valid    bool     `json:"valid"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+	// Query is the SQL query the alert would run on every evaluation.
+	Query string `json:"query"`
+	// Checked reports whether OpenObserve was asked to run the query (see "dryRun").
+	Checked bool `json:"checked"`
+}
+
+// ValidateAlertRule implements POST /api/v1alpha1/alerts/rules/{ruleName}/validate.
+// It takes the body of POST /api/v1alpha1/alerts/rules and reports whether the rule would be
+// accepted, without creating it: the search pattern is checked (see
+// openobserve.CheckSearchPattern) along with the rest of the rule. With "dryRun=true", a
+// valid rule's query is also run by OpenObserve with a size of zero, to confirm that it is
+// accepted there. Problems with the rule are reported in a 200 response.
+func (h *LogsHandler) ValidateAlertRule(w http.ResponseWriter, r *http.Request) {
+	ruleName := r.PathValue("ruleName")
+	dryRun := false
+	if value := r.URL.Query().Get("dryRun"); value != "" {
+		var err error
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "dryRun must be true or false")
+			return
+		}
+	}
+
+	var body gen.AlertRuleRequest
+	if err := decodeJSONBody(r, &body); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if body.Metadata.Name == "" {
+		body.Metadata.Name = ruleName
+	} else if body.Metadata.Name != ruleName {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "metadata.name must match the rule name of the path")
+		return
+	}
+
+	params := toLogAlertParams(&body)
+	result := alertValidationResult{Query: h.clientFor(r.Context()).AlertQuery(params)}
+	result.Errors, result.Warnings = openobserve.CheckSearchPattern(params.SearchPattern)
+	if len(result.Errors) == 0 {
+		if err := h.validateAlertParams(params); err != nil {
+			result.Errors = append(result.Errors, err.Error())
+		}
+	}
+
+	if dryRun && len(result.Errors) == 0 {
+		err := h.clientFor(r.Context()).CheckAlertQuery(r.Context(), params)
+		var rejected *openobserve.AlertQueryError
+		switch {
+		case errors.As(err, &rejected):
+			result.Errors = append(result.Errors, rejected.Error())
+		case err != nil:
+			h.logger.Error("Failed to check alert query",
+				slog.String("function", "ValidateAlertRule"),
+				slog.String("ruleName", ruleName),
+				slog.Any("error", err),
+			)
+			h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+			return
+		}
+		result.Checked = true
+	}
+	result.Valid = len(result.Errors) == 0
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestValidateAlertRule(t *testing.T) {
+	searches := 0
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	mux := http.NewServeMux()
+	NewLogsHandler(client, nil, testLogger()).registerRoutes(mux)
+
+	rule := func(name, query string) string {
+		return `{"metadata":{"name":"` + name + `","namespace":"default","projectUid":"11111111-1111-1111-1111-111111111111",` +
+			`"environmentUid":"22222222-2222-2222-2222-222222222222","componentUid":"33333333-3333-3333-3333-333333333333"},` +
+			`"source":{"query":"` + query + `"},` +
+			`"condition":{"enabled":true,"window":"5m","interval":"1m","operator":"gt","threshold":10}}`
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		body         string
+		wantStatus   int
+		wantValid    bool
+		wantWarnings bool
+		wantChecked  bool
+	}{
+		{"valid", "", rule("my-rule", "connection refused"), http.StatusOK, true, false, false},
+		{"name from path", "", rule("", "connection refused"), http.StatusOK, true, false, false},
+		{"empty pattern", "", rule("my-rule", " "), http.StatusOK, false, false, false},
+		{"regex pattern", "", rule("my-rule", "error.*timeout"), http.StatusOK, true, true, false},
+		{"dry run", "?dryRun=true", rule("my-rule", "connection refused"), http.StatusOK, true, false, true},
+		{"dry run of invalid rule", "?dryRun=true", rule("my-rule", ""), http.StatusOK, false, false, false},
+		{"mismatched name", "", rule("other-rule", "panic"), http.StatusBadRequest, false, false, false},
+		{"invalid dryRun", "?dryRun=maybe", rule("my-rule", "panic"), http.StatusBadRequest, false, false, false},
+		{"malformed body", "", "{", http.StatusBadRequest, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			searches = 0
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/alerts/rules/my-rule/validate"+tt.query, strings.NewReader(tt.body))
+			mux.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var result alertValidationResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if result.Valid != tt.wantValid || (len(result.Errors) == 0) != tt.wantValid {
+				t.Errorf("expected valid=%v, got %+v", tt.wantValid, result)
+			}
+			if (len(result.Warnings) > 0) != tt.wantWarnings {
+				t.Errorf("expected warnings=%v, got %v", tt.wantWarnings, result.Warnings)
+			}
+			if result.Checked != tt.wantChecked || (searches > 0) != tt.wantChecked {
+				t.Errorf("expected checked=%v, got %v after %d searches", tt.wantChecked, result.Checked, searches)
+			}
+			if !strings.Contains(result.Query, "str_match(log, ") {
+				t.Errorf("expected the alert query, got %q", result.Query)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// Compression formats supported by the export endpoint.
+const (
+	exportCompressionGzip = "gzip"
+	exportCompressionZstd = "zstd"
+	exportCompressionNone = "none"
+)
+
+// exportFileExtensions maps each export compression to the suffix of the download file name.
+var exportFileExtensions = map[string]string{
+	exportCompressionGzip: ".gz",
+	exportCompressionZstd: ".zst",
+	exportCompressionNone: "",
+}
+
+// exportManifestRecord is the leading record of an export requested with "manifest=true".
+type exportManifestRecord struct {
+	Manifest *openobserve.ExportManifest `json:"manifest"`
+}
+
+// ExportLogs implements POST /api/v1/logs/export.
+// It streams every matching component log as newline-delimited JSON. The output is
+// gzip-compressed by default; zstd (faster for large exports) or no compression can be
+// selected with the "compression" query parameter or negotiated through Accept-Encoding.
+// With the "manifest" query parameter set to true, the logs are preceded by a record
+// describing the query, its effective time window and the number of matching logs.
+// The export can be canceled through POST /api/v1/logs/{id}/cancel with the ID sent in the
+// X-Operation-Id header.
+func (h *LogsHandler) ExportLogs(w http.ResponseWriter, r *http.Request) {
+	compression, ok := negotiateExportCompression(r)
+	if !ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "compression must be one of gzip, zstd, none")
+		return
+	}
+	withManifest, msg := parseExportManifest(r)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if msg := h.validateExportParams(r.Context(), &params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	ctx, operationID, cancel := h.operations.start(r.Context())
+	defer cancel()
+
+	var manifest *openobserve.ExportManifest
+	if withManifest {
+		var err error
+		manifest, err = h.exportManifest(ctx, &params)
+		if msg, ok := queryRejection(err); ok {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+			return
+		}
+		if err != nil {
+			h.logger.Error("Failed to count component logs for the export manifest",
+				slog.String("function", "ExportLogs"),
+				slog.String("namespace", params.Namespace),
+				slog.Any("error", err),
+			)
+			h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+			return
+		}
+	}
+
+	w.Header().Set(operationIDHeader, operationID)
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Add("Vary", "Accept-Encoding")
+	if compression != exportCompressionNone {
+		w.Header().Set("Content-Encoding", compression)
+	}
+	w.Header().Set("Content-Disposition", `attachment; filename="logs.ndjson`+exportFileExtensions[compression]+`"`)
+
+	// Headers are only sent once the first bytes reach the client, so errors until then can
+	// still be reported with a proper status code.
+	cw := &countingWriter{w: &deadlineWriter{rc: http.NewResponseController(w), w: w, timeout: h.stream.writeTimeout}}
+	bw := bufio.NewWriter(cw)
+	out, err := newExportWriter(bw, compression)
+	if err != nil {
+		h.logger.Error("Failed to create export encoder",
+			slog.String("function", "ExportLogs"),
+			slog.String("compression", compression),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+
+	enc := json.NewEncoder(out)
+	if manifest != nil {
+		err = enc.Encode(exportManifestRecord{Manifest: manifest})
+	}
+	if err == nil {
+		err = h.clientFor(ctx).ExportComponentLogs(ctx, scopeToTenant(ctx, params), func(entry openobserve.ComponentLogsEntry) error {
+			mapped, err := h.fieldMapper.mapLogEntry(&entry)
+			if err != nil {
+				return err
+			}
+			return enc.Encode(mapped)
+		})
+	}
+	if err == nil {
+		err = out.Close()
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil && ctx.Err() != nil && r.Context().Err() == nil {
+		// Canceled by ID; the truncated stream fails decompression on the client side.
+		h.logger.Info("Component log export canceled",
+			slog.String("function", "ExportLogs"),
+			slog.String("namespace", params.Namespace),
+			slog.String("operationId", operationID),
+		)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to export component logs",
+			slog.String("function", "ExportLogs"),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		if cw.n == 0 {
+			w.Header().Del("Content-Encoding")
+			w.Header().Del("Content-Disposition")
+			h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		}
+		// Otherwise the response is already partially sent; the truncated stream fails
+		// decompression on the client side.
+		return
+	}
+}
+
+// validateExportParams validates the query of an export and resolves its minLevel. Like
+// searches, exports reject a startTime in the future and clamp the endTime (see
+// checkClockSkew, run by validateAggregationParams). It returns a user-facing message
+// describing the first invalid parameter, or "" if they are valid. The stream, ingestion lag
+// and component IDs are checked against the client of the tenant of ctx, which runs the export.
+func (h *LogsHandler) validateExportParams(ctx context.Context, params *openobserve.ComponentLogsParams) string {
+	if msg := h.validateAggregationParams(params); msg != "" {
+		return msg
+	}
+	if params.Sample || params.SampleRate != 0 {
+		return "sampling is not supported for exports"
+	}
+	if params.SortField != "" && params.SortField != openobserve.SortFieldTimestamp {
+		return "exports are sorted by timestamp; sortField is not supported"
+	}
+	if err := openobserve.ValidateExpressions(params.Expressions, h.expressionFields); err != nil {
+		return err.Error()
+	}
+	if msg := h.resolveMinLevel(params); msg != "" {
+		return msg
+	}
+	if msg := h.validateLabelSelectors(params.LabelSelectors); msg != "" {
+		return msg
+	}
+	client := h.clientFor(ctx)
+	if err := client.ValidateLogStream(params.LogStream); err != nil {
+		return err.Error()
+	}
+	if err := client.ValidateIngestionLag(params.MinIngestionLag); err != nil {
+		return err.Error()
+	}
+	if err := client.ValidateComponentIDs(params.ComponentIDs); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// parseExportManifest reports whether the "manifest" query parameter requests a leading
+// manifest record. It returns a user-facing message if the parameter is invalid.
+func parseExportManifest(r *http.Request) (bool, string) {
+	value := r.URL.Query().Get("manifest")
+	if value == "" {
+		return false, ""
+	}
+	manifest, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, "manifest must be true or false"
+	}
+	return manifest, ""
+}
+
+// exportManifest describes the export of params and pins params to the time window of the
+// manifest, so that the exported logs are the ones it counted.
+func (h *LogsHandler) exportManifest(ctx context.Context, params *openobserve.ComponentLogsParams) (*openobserve.ExportManifest, error) {
+	manifest, err := h.clientFor(ctx).ExportManifest(ctx, scopeToTenant(ctx, *params))
+	if err != nil {
+		return nil, err
+	}
+	params.StartTime, params.EndTime = manifest.Window.StartTime, manifest.Window.EndTime
+	return manifest, nil
+}
+
+// negotiateExportCompression picks the export compression from the "compression" query
+// parameter, falling back to Accept-Encoding (zstd only when explicitly accepted) and
+// finally to gzip. It reports false for an unsupported query parameter value.
+func negotiateExportCompression(r *http.Request) (string, bool) {
+	if v := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("compression"))); v != "" {
+		switch v {
+		case exportCompressionGzip, exportCompressionZstd, exportCompressionNone:
+			return v, true
+		default:
+			return "", false
+		}
+	}
+
+	for _, part := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, weight, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), exportCompressionZstd) {
+			continue
+		}
+		// An explicit q=0 means the client refuses zstd.
+		if q, ok := strings.CutPrefix(strings.TrimSpace(weight), "q="); ok {
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v <= 0 {
+				continue
+			}
+		}
+		return exportCompressionZstd, true
+	}
+	return exportCompressionGzip, true
+}
+
+// newExportWriter wraps w with the encoder for the given compression. Closing the
+// returned writer flushes the encoder without closing w.
+func newExportWriter(w io.Writer, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case exportCompressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	case exportCompressionNone:
+		return nopWriteCloser{w}, nil
+	default:
+		return gzip.NewWriter(w), nil
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// extendWriteDeadline gives the response w timeout from now to be written.
+func extendWriteDeadline(w http.ResponseWriter, timeout time.Duration) {
+	// Responses that do not support deadlines have none to extend.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout))
+}
+
+// deadlineWriter extends the write deadline of an HTTP response before each write, so that an
+// export outlasting the server's WriteTimeout is not cut off, while a client that stops
+// reading is still detected.
+type deadlineWriter struct {
+	rc      *http.ResponseController
+	w       io.Writer
+	timeout time.Duration
+}
+
+func (d *deadlineWriter) Write(p []byte) (int, error) {
+	if err := d.rc.SetWriteDeadline(time.Now().Add(d.timeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+	return d.w.Write(p)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,169 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/s3"
+)
+
+// bucketExportResponse describes the object written by an export to object storage.
+type bucketExportResponse struct {
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+	Logs   int64  `json:"logs"`
+	Bytes  int64  `json:"bytes"`
+}
+
+// ExportLogsToBucket implements POST /api/v1/logs/export/s3.
+// It streams every matching component log as newline-delimited JSON into a new object in the
+// configured S3-compatible bucket and responds with its key once the upload completes. The
+// object is gzip-compressed by default; zstd or no compression can be selected with the
+// "compression" query parameter and "manifest" adds a leading manifest record. Like ExportLogs, the export can be canceled through
+// POST /api/v1/logs/{id}/cancel with the ID sent in the X-Operation-Id header.
+func (h *LogsHandler) ExportLogsToBucket(w http.ResponseWriter, r *http.Request) {
+	if h.exportBucket == nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "exporting to object storage is not enabled")
+		return
+	}
+	compression := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("compression")))
+	if compression == "" {
+		compression = exportCompressionGzip
+	}
+	if _, ok := exportFileExtensions[compression]; !ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "compression must be one of gzip, zstd, none")
+		return
+	}
+	withManifest, msg := parseExportManifest(r)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if msg := h.validateExportParams(r.Context(), &params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	ctx, operationID, cancel := h.operations.start(r.Context())
+	defer cancel()
+	w.Header().Set(operationIDHeader, operationID)
+
+	var manifest *openobserve.ExportManifest
+	if withManifest {
+		var err error
+		manifest, err = h.exportManifest(ctx, &params)
+		if msg, ok := queryRejection(err); ok {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+			return
+		}
+		if err != nil {
+			h.logger.Error("Failed to count component logs for the export manifest",
+				slog.String("function", "ExportLogsToBucket"),
+				slog.String("namespace", params.Namespace),
+				slog.Any("error", err),
+			)
+			h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+			return
+		}
+	}
+
+	key := h.exportBucket.Key(params.Namespace + "/" + time.Now().UTC().Format("20060102T150405Z") + "-" +
+		operationID + ".ndjson" + exportFileExtensions[compression])
+	opts := s3.UploadOptions{ContentType: "application/x-ndjson"}
+	if compression != exportCompressionNone {
+		opts.ContentEncoding = compression
+	}
+
+	// The export is piped into the upload, so only the part being uploaded is held in memory.
+	pr, pw := io.Pipe()
+	var logs int64
+	exported := make(chan error, 1)
+	go func() {
+		err := h.exportTo(ctx, pw, params, manifest, compression, &logs)
+		pw.CloseWithError(err)
+		exported <- err
+	}()
+	size, err := h.exportBucket.Upload(ctx, key, pr, opts)
+	// Unblock the export if the upload gave up before reading all of it.
+	pr.CloseWithError(io.ErrClosedPipe)
+	if exportErr := <-exported; err == nil {
+		err = exportErr
+	}
+	// The upload may have outlasted the server's WriteTimeout; the response gets its own.
+	extendWriteDeadline(w, h.stream.writeTimeout)
+
+	if err != nil && ctx.Err() != nil && r.Context().Err() == nil {
+		h.logger.Info("Component log export to object storage canceled",
+			slog.String("function", "ExportLogsToBucket"),
+			slog.String("namespace", params.Namespace),
+			slog.String("operationId", operationID),
+		)
+		h.writeError(w, http.StatusConflict, gen.Conflict, "export canceled")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to export component logs to object storage",
+			slog.String("function", "ExportLogsToBucket"),
+			slog.String("namespace", params.Namespace),
+			slog.String("key", key),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, bucketExportResponse{
+		Bucket: h.exportBucket.Bucket(),
+		Key:    key,
+		Logs:   logs,
+		Bytes:  size,
+	})
+}
+
+// exportTo writes the logs matching params to w as compressed newline-delimited JSON,
+// counting them in logs. A non-nil manifest is written as the leading record.
+func (h *LogsHandler) exportTo(ctx context.Context, w io.Writer, params openobserve.ComponentLogsParams, manifest *openobserve.ExportManifest, compression string, logs *int64) error {
+	bw := bufio.NewWriter(w)
+	out, err := newExportWriter(bw, compression)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(out)
+	if manifest != nil {
+		if err := enc.Encode(exportManifestRecord{Manifest: manifest}); err != nil {
+			return err
+		}
+	}
+	err = h.clientFor(ctx).ExportComponentLogs(ctx, scopeToTenant(ctx, params), func(entry openobserve.ComponentLogsEntry) error {
+		*logs++
+		mapped, err := h.fieldMapper.mapLogEntry(&entry)
+		if err != nil {
+			return err
+		}
+		return enc.Encode(mapped)
+	})
+	if err == nil {
+		err = out.Close()
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/s3"
+)
+
+// newBucketTestServer serves object uploads, recording the uploaded objects by path.
+func newBucketTestServer(t *testing.T, status int) (*httptest.Server, map[string][]byte) {
+	t.Helper()
+	var mu sync.Mutex
+	objects := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.Header.Get("Content-Encoding") != "gzip" {
+			t.Errorf("unexpected upload: %s %s %v", r.Method, r.URL, r.Header)
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+			return
+		}
+		mu.Lock()
+		objects[r.URL.Path] = body
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+	return server, objects
+}
+
+func newBucketTestHandler(t *testing.T, endpoint string) *LogsHandler {
+	t.Helper()
+	handler := newExportTestHandler(t)
+	bucket, err := s3.NewClient(s3.Config{
+		Endpoint:        endpoint,
+		Bucket:          "archive",
+		Prefix:          "logs/",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	handler.exportBucket = bucket
+	return handler
+}
+
+func TestExportLogsToBucket(t *testing.T) {
+	server, objects := newBucketTestServer(t, http.StatusOK)
+	handler := newBucketTestHandler(t, server.URL)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export/s3", strings.NewReader(exportRequestBody))
+	rec := httptest.NewRecorder()
+	handler.ExportLogsToBucket(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp bucketExportResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+	operationID := rec.Header().Get(operationIDHeader)
+	if resp.Bucket != "archive" || resp.Logs != 2 || !strings.HasPrefix(resp.Key, "logs/test-ns/") ||
+		!strings.HasSuffix(resp.Key, "-"+operationID+".ndjson.gz") {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	object, ok := objects["/archive/"+resp.Key]
+	if !ok || int64(len(object)) != resp.Bytes {
+		t.Fatalf("expected a %d byte object at %s, got %v", resp.Bytes, resp.Key, objects)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(object))
+	if err != nil {
+		t.Fatalf("object is not gzip-compressed: %v", err)
+	}
+	if logs := readExportedLogs(t, gz); strings.Join(logs, ",") != "first,second" {
+		t.Errorf("unexpected exported logs: %v", logs)
+	}
+}
+
+func TestExportLogsToBucket_Errors(t *testing.T) {
+	t.Run("not enabled", func(t *testing.T) {
+		handler := newExportTestHandler(t)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export/s3", strings.NewReader(exportRequestBody))
+		rec := httptest.NewRecorder()
+		handler.ExportLogsToBucket(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("unsupported compression", func(t *testing.T) {
+		handler := newBucketTestHandler(t, "http://127.0.0.1:1")
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export/s3?compression=brotli", strings.NewReader(exportRequestBody))
+		rec := httptest.NewRecorder()
+		handler.ExportLogsToBucket(rec, req)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("upload failed", func(t *testing.T) {
+		server, objects := newBucketTestServer(t, http.StatusForbidden)
+		handler := newBucketTestHandler(t, server.URL)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export/s3", strings.NewReader(exportRequestBody))
+		rec := httptest.NewRecorder()
+		handler.ExportLogsToBucket(rec, req)
+		if rec.Code != http.StatusInternalServerError || len(objects) != 0 {
+			t.Errorf("expected 500 and no object, got %d and %v", rec.Code, objects)
+		}
+	})
+
+	t.Run("query failed", func(t *testing.T) {
+		ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ooServer.Close()
+		server, objects := newBucketTestServer(t, http.StatusOK)
+		handler := newBucketTestHandler(t, server.URL)
+		handler.client = openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export/s3", strings.NewReader(exportRequestBody))
+		rec := httptest.NewRecorder()
+		handler.ExportLogsToBucket(rec, req)
+		if rec.Code != http.StatusInternalServerError || len(objects) != 0 {
+			t.Errorf("expected 500 and no object, got %d and %v", rec.Code, objects)
+		}
+	})
+}
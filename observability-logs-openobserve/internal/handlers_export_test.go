@@ -0,0 +1,240 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+const exportRequestBody = `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+
+func newExportTestHandler(t *testing.T) *LogsHandler {
+	t.Helper()
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(1735689600000000), "log": "first"},
+				{"_timestamp": float64(1735689601000000), "log": "second"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(ooServer.Close)
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	return NewLogsHandler(client, nil, testLogger())
+}
+
+func readExportedLogs(t *testing.T, r io.Reader) []string {
+	t.Helper()
+	var logs []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var entry openobserve.ComponentLogsEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid NDJSON line %q: %v", scanner.Text(), err)
+		}
+		logs = append(logs, entry.Log)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	return logs
+}
+
+func TestExportLogs(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		acceptEncoding string
+		wantEncoding   string
+	}{
+		{"gzip by default", "", "", "gzip"},
+		{"gzip when zstd is not accepted", "", "gzip, deflate", "gzip"},
+		{"zstd via Accept-Encoding", "", "gzip, zstd", "zstd"},
+		{"zstd refused via Accept-Encoding", "", "zstd;q=0, gzip", "gzip"},
+		{"zstd via query parameter", "?compression=zstd", "", "zstd"},
+		{"uncompressed via query parameter", "?compression=none", "zstd", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newExportTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export"+tt.query, strings.NewReader(exportRequestBody))
+			if tt.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+			handler.ExportLogs(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if got := rec.Header().Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Fatalf("expected Content-Encoding %q, got %q", tt.wantEncoding, got)
+			}
+			if rec.Header().Get(operationIDHeader) == "" {
+				t.Errorf("expected an %s header", operationIDHeader)
+			}
+
+			var body io.Reader = rec.Body
+			switch tt.wantEncoding {
+			case "gzip":
+				gz, err := gzip.NewReader(rec.Body)
+				if err != nil {
+					t.Fatalf("invalid gzip body: %v", err)
+				}
+				body = gz
+			case "zstd":
+				zr, err := zstd.NewReader(rec.Body)
+				if err != nil {
+					t.Fatalf("invalid zstd body: %v", err)
+				}
+				defer zr.Close()
+				body = zr
+			}
+
+			logs := readExportedLogs(t, body)
+			if len(logs) != 2 || logs[0] != "first" || logs[1] != "second" {
+				t.Errorf("unexpected exported logs: %v", logs)
+			}
+		})
+	}
+}
+
+func TestExportLogs_OutlastsWriteTimeout(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{{"_timestamp": float64(1735689600000000), "log": "first"}},
+		})
+	}))
+	t.Cleanup(ooServer.Close)
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	adapter := httptest.NewUnstartedServer(http.HandlerFunc(handler.ExportLogs))
+	adapter.Config.WriteTimeout = 100 * time.Millisecond
+	adapter.Start()
+	t.Cleanup(adapter.Close)
+
+	resp, err := http.Post(adapter.URL+"/api/v1/logs/export?compression=none", "application/json", strings.NewReader(exportRequestBody))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if logs := readExportedLogs(t, resp.Body); len(logs) != 1 || logs[0] != "first" {
+		t.Errorf("unexpected exported logs: %v", logs)
+	}
+}
+
+func TestExportLogs_Manifest(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(string(body), "count(*)") {
+			w.Write([]byte(`{"took":1,"hits":[{"total":2}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":1,"hits":[{"_timestamp":1735689600000000,"log":"first"},{"_timestamp":1735689601000000,"log":"second"}]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export?compression=none&manifest=true", strings.NewReader(exportRequestBody))
+	rec := httptest.NewRecorder()
+	handler.ExportLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	first, rest, _ := strings.Cut(rec.Body.String(), "\n")
+	var record struct {
+		Manifest openobserve.ExportManifest `json:"manifest"`
+	}
+	if err := json.Unmarshal([]byte(first), &record); err != nil {
+		t.Fatalf("invalid manifest record %q: %v", first, err)
+	}
+	if record.Manifest.TotalCount != 2 || record.Manifest.Query.Namespace != "test-ns" {
+		t.Errorf("unexpected manifest: %+v", record.Manifest)
+	}
+	if got := record.Manifest.Window.StartTime.Format(time.RFC3339); got != "2025-01-01T00:00:00Z" {
+		t.Errorf("expected the window to start at 2025-01-01T00:00:00Z, got %s", got)
+	}
+
+	logs := readExportedLogs(t, strings.NewReader(rest))
+	if len(logs) != 2 || logs[0] != "first" || logs[1] != "second" {
+		t.Errorf("unexpected exported logs: %v", logs)
+	}
+}
+
+func TestExportLogs_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name  string
+		query string
+		body  string
+	}{
+		{"unsupported compression", "?compression=brotli", exportRequestBody},
+		{"invalid body", "", "{"},
+		{"missing namespace", "", `{"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"sampling", "", `{"namespace":"ns","sample":true,"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"invalid manifest", "?manifest=maybe", exportRequestBody},
+		{"sort field", "", `{"namespace":"ns","sortField":"level","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export"+tt.query, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.ExportLogs(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestExportLogs_ServerError(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/export", strings.NewReader(exportRequestBody))
+	rec := httptest.NewRecorder()
+	handler.ExportLogs(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Content-Encoding"); got != "" {
+		t.Errorf("expected no Content-Encoding on error response, got %q", got)
+	}
+}
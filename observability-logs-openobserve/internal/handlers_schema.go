@@ -0,0 +1,37 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// GetStreamSchema implements GET /api/v1/logs/schema.
+// It lists the fields of the log stream with their type (string, number, boolean, timestamp
+// or other), so that query builders can offer the filter controls matching each field. The
+// optional "logStream" query parameter selects an allowed stream as for component log
+// searches; a tenant's stream takes precedence over it.
+func (h *LogsHandler) GetStreamSchema(w http.ResponseWriter, r *http.Request) {
+	params := scopeToTenant(r.Context(), openobserve.ComponentLogsParams{LogStream: r.URL.Query().Get("logStream")})
+
+	result, err := h.clientFor(r.Context()).GetStreamSchema(r.Context(), params.LogStream)
+	if msg, ok := queryRejection(err); ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to get stream schema",
+			slog.String("function", "GetStreamSchema"),
+			slog.String("logStream", params.LogStream),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
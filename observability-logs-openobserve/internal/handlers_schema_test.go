@@ -0,0 +1,51 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestGetStreamSchema(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/default/streams/audit/schema" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"schema":[{"name":"user","type":"Utf8"},{"name":"status","type":"Int64"}]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{AllowedStreams: []openobserve.StreamRef{{Name: "audit"}}}, testLogger())
+	srv := NewServer("0", NewLogsHandler(client, nil, testLogger()), testLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/logs/schema?logStream=audit", nil)
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var schema openobserve.StreamSchema
+	if err := json.Unmarshal(rec.Body.Bytes(), &schema); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if schema.Stream != "audit" || len(schema.Fields) != 2 ||
+		schema.Fields[0].Name != "status" || schema.Fields[0].Type != openobserve.FieldTypeNumber ||
+		schema.Fields[1].Name != "user" || schema.Fields[1].Type != openobserve.FieldTypeString {
+		t.Errorf("unexpected schema: %+v", schema)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/logs/schema?logStream=secrets", nil)
+	rec = httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a stream that is not allowed, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/observer"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// SearchLogs implements POST /api/v1/logs/search.
+// It runs a component log query with the full set of OpenObserve adapter parameters,
+// including those not covered by the shared logs adapter API (such as atTimestamp).
+// The "emptyResultStatus" query parameter (200 or 404) overrides the configured status
+// returned when no logs match, and "explain=true" adds OpenObserve's scan details to the
+// result. With "persist=true", the result is stored for sharing and the response carries the
+// URL it is served from instead (see GetPersistedResult). "annotations=true" adds the
+// deployment events of the time window as timeline markers, when an events endpoint is
+// configured. Clients sending "Accept: application/x-protobuf" receive the result encoded as
+// the ComponentLogsResult message of internal/api/proto/logs.proto instead of JSON, and
+// clients sending "Accept: text/plain" receive one log per line (see formatPlainTextLog).
+func (h *LogsHandler) SearchLogs(w http.ResponseWriter, r *http.Request) {
+	emptyResultNotFound := h.emptyResultNotFound
+	switch r.URL.Query().Get("emptyResultStatus") {
+	case "":
+	case "200":
+		emptyResultNotFound = false
+	case "404":
+		emptyResultNotFound = true
+	default:
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "emptyResultStatus must be 200 or 404")
+		return
+	}
+
+	persist := false
+	if value := r.URL.Query().Get("persist"); value != "" {
+		var err error
+		if persist, err = strconv.ParseBool(value); err != nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "persist must be true or false")
+			return
+		}
+		if persist && h.results == nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "persisting results is not enabled")
+			return
+		}
+	}
+
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if msg := h.validateSearchParams(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := parseExplain(r, &params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.resolveMinLevel(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.validateLabelSelectors(params.LabelSelectors); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	annotations, msg := h.parseAnnotations(r)
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	// Persisted results are always stored as JSON.
+	protobuf := acceptsProtobuf(r) && !persist
+	plainText := !protobuf && acceptsPlainText(r) && !persist
+	// Searches pinned to an exact timestamp have no time window to annotate, and protobuf and
+	// plain-text responses cannot carry annotations.
+	annotations = annotations && params.AtTimestamp == 0 && len(params.AtTimestamps) == 0 && !protobuf && !plainText
+	var awaitAnnotations func() []observer.DeploymentEvent
+	if annotations {
+		awaitAnnotations = h.fetchAnnotations(r.Context(), params)
+	}
+
+	result, err := h.clientFor(r.Context()).GetComponentLogs(r.Context(), scopeToTenant(r.Context(), params))
+	if msg, ok := queryRejection(err); ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to search component logs",
+			slog.String("function", "SearchLogs"),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+
+	if len(result.Logs) == 0 && emptyResultNotFound {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, noLogsFoundMessage)
+		return
+	}
+	if result.NearLimit {
+		w.Header().Set(nearLimitHeader, "true")
+	}
+	if result.Stream != "" {
+		w.Header().Set(logStreamHeader, result.Stream)
+	}
+	w.Header().Add("Vary", "Accept")
+	if protobuf {
+		h.writeProtobuf(w, http.StatusOK, toProtoLogsResult(result))
+		return
+	}
+	if plainText {
+		h.writePlainText(w, http.StatusOK, result)
+		return
+	}
+	var response interface{} = result
+	if annotations {
+		events := awaitAnnotations()
+		response = annotatedLogsResult{
+			ComponentLogsResult:    result,
+			Annotations:            events,
+			AnnotationsUnavailable: events == nil,
+		}
+	}
+	if persist {
+		h.persistResult(w, response)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+// CountLogs implements POST /api/v1/logs/count.
+// It takes the same body as POST /api/v1/logs/search and returns only the number of matching
+// logs, computed with a count query, so that a UI can warn before fetching a huge result.
+func (h *LogsHandler) CountLogs(w http.ResponseWriter, r *http.Request) {
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if msg := h.validateSearchParams(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.resolveMinLevel(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.validateLabelSelectors(params.LabelSelectors); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).CountComponentLogs(r.Context(), scopeToTenant(r.Context(), params))
+	if msg, ok := queryRejection(err); ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to count component logs",
+			slog.String("function", "CountLogs"),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// CorrelateLogs implements POST /api/v1/logs/correlate.
+// It reconstructs a request across services from its correlation ID: the body takes the
+// search filters with a required correlationId and returns the logs carrying it from every
+// component of the namespace, oldest first, with the components that handled the request
+// in the order they first logged it. Project and environment filters are ignored, since a
+// request may cross both.
+func (h *LogsHandler) CorrelateLogs(w http.ResponseWriter, r *http.Request) {
+	var params openobserve.ComponentLogsParams
+	if err := decodeJSONBody(r, &params); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	if strings.TrimSpace(params.CorrelationID) == "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "correlationId is required")
+		return
+	}
+	if msg := h.validateAggregationParams(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.resolveMinLevel(&params); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if msg := h.validateLabelSelectors(params.LabelSelectors); msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).GetCorrelatedLogs(r.Context(), scopeToTenant(r.Context(), params))
+	if msg, ok := queryRejection(err); ok {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to query correlated component logs",
+			slog.String("function", "CorrelateLogs"),
+			slog.String("namespace", params.Namespace),
+			slog.String("correlationId", params.CorrelationID),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	if result.Logs.NearLimit {
+		w.Header().Set(nearLimitHeader, "true")
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// parseExplain enables the scan details of params when the "explain" query parameter is
+// true. It returns a user-facing message if the parameter is invalid.
+func parseExplain(r *http.Request, params *openobserve.ComponentLogsParams) string {
+	value := r.URL.Query().Get("explain")
+	if value == "" {
+		return ""
+	}
+	explain, err := strconv.ParseBool(value)
+	if err != nil {
+		return "explain must be true or false"
+	}
+	params.Explain = params.Explain || explain
+	return ""
+}
+
+// queryRejection returns a user-facing message if err reports that a query was rejected
+// before running: as too expensive (see openobserve.QueryTooExpensiveError), for selecting
+// a stream that is not allowed (see openobserve.LogStreamError), for an ingestion lag
+// filter that cannot be applied (see openobserve.IngestionLagError) or for listing too many
+// component IDs (see openobserve.ComponentIDsError).
+func queryRejection(err error) (string, bool) {
+	var tooExpensive *openobserve.QueryTooExpensiveError
+	if errors.As(err, &tooExpensive) {
+		return tooExpensive.Error(), true
+	}
+	var logStream *openobserve.LogStreamError
+	if errors.As(err, &logStream) {
+		return logStream.Error(), true
+	}
+	var ingestionLag *openobserve.IngestionLagError
+	if errors.As(err, &ingestionLag) {
+		return ingestionLag.Error(), true
+	}
+	var componentIDs *openobserve.ComponentIDsError
+	if errors.As(err, &componentIDs) {
+		return componentIDs.Error(), true
+	}
+	return "", false
+}
+
+// validateSearchParams checks the parameters of a search request and returns a user-facing
+// message describing the first problem found, or "" if they are valid. The time range is
+// optional when the query is pinned to an exact timestamp.
+func (h *LogsHandler) validateSearchParams(params *openobserve.ComponentLogsParams) string {
+	if strings.TrimSpace(params.Namespace) == "" {
+		return "namespace is required"
+	}
+	if err := params.ValidateAtTimestamps(); err != nil {
+		return err.Error()
+	}
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return "sampleRate must be between 0 and 1"
+	}
+	if err := params.ValidateCursors(); err != nil {
+		return err.Error()
+	}
+	if err := params.ValidateSortField(); err != nil {
+		return err.Error()
+	}
+	if err := params.ValidateOffset(); err != nil {
+		return err.Error()
+	}
+	if err := openobserve.ValidateExpressions(params.Expressions, h.expressionFields); err != nil {
+		return err.Error()
+	}
+	if params.AtTimestamp > 0 || len(params.AtTimestamps) > 0 {
+		return validateLogStream(params.Stream)
+	}
+	return h.validateAggregationParams(params)
+}
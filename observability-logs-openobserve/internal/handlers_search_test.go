@@ -0,0 +1,564 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestSearchLogs(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Took: 2,
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "pinned line", "total": float64(1)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","atTimestamp":1735732800000000}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var result openobserve.ComponentLogsResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Logs) != 1 || result.Logs[0].Log != "pinned line" {
+		t.Errorf("unexpected logs: %+v", result.Logs)
+	}
+}
+
+func TestSearchLogs_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid body", "{"},
+		{"missing namespace", `{"atTimestamp":1735732800000000}`},
+		{"negative atTimestamp", `{"namespace":"ns","atTimestamp":-1}`},
+		{"atTimestamp with atTimestamps", `{"namespace":"ns","atTimestamp":1735732800000000,"atTimestamps":[1735732800000000]}`},
+		{"zero in atTimestamps", `{"namespace":"ns","atTimestamps":[1735732800000000,0]}`},
+		{"negative offset", `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","offset":-1}`},
+		{"offset with sampling", `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","offset":100,"sample":true}`},
+		{"too many atTimestamps", `{"namespace":"ns","atTimestamps":[` + strings.Repeat("1735732800000000,", openobserve.MaxAtTimestamps) + `1735732800000000]}`},
+		{"partial time range", `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z"}`},
+		{"end before start", `{"namespace":"ns","startTime":"2025-01-02T00:00:00Z","endTime":"2025-01-01T00:00:00Z"}`},
+		{"unknown stream", `{"namespace":"ns","stream":"stdin","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"malformed cursor", `{"namespace":"ns","before":"???","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"cursor with sampling", `{"namespace":"ns","after":"MTA6MQ","sample":true,"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"unknown sort field", `{"namespace":"ns","sortField":"log","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"unsupported expression function", `{"namespace":"ns","expressions":{"x":"sleep(10)"},"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"expression on an unknown field", `{"namespace":"ns","expressions":{"x":"lower(api_token)"},"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"sample rate above 1", `{"namespace":"ns","sampleRate":1.5,"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"too many excluded phrases", `{"namespace":"ns","excludePhrases":["p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p","p"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.SearchLogs(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestSearchLogs_ServerError(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected 500, got %d", rec.Code)
+	}
+}
+
+func TestSearchLogs_EmptyResultStatus(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+
+	tests := []struct {
+		name     string
+		notFound bool
+		query    string
+		wantCode int
+	}{
+		{"default", false, "", http.StatusOK},
+		{"configured 404", true, "", http.StatusNotFound},
+		{"request overrides to 404", false, "?emptyResultStatus=404", http.StatusNotFound},
+		{"request overrides to 200", true, "?emptyResultStatus=200", http.StatusOK},
+		{"invalid override", false, "?emptyResultStatus=204", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{EmptyResultNotFound: tt.notFound}, testLogger())
+
+			body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search"+tt.query, strings.NewReader(body))
+			rec := httptest.NewRecorder()
+			handler.SearchLogs(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("expected %d, got %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCountLogs(t *testing.T) {
+	var requests int
+	var gotSQL string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotSQL = body.Query.SQL
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":4,"hits":[{"total":4200}]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","searchPhrase":"timeout","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/count", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.CountLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if strings.TrimSpace(rec.Body.String()) != `{"count":4200,"took":4}` {
+		t.Errorf("unexpected response: %s", rec.Body.String())
+	}
+	if requests != 1 {
+		t.Errorf("expected a single OpenObserve query, got %d", requests)
+	}
+	if !strings.HasPrefix(gotSQL, "SELECT count(*)") || !strings.Contains(gotSQL, "log ILIKE '%timeout%'") {
+		t.Errorf("unexpected SQL: %s", gotSQL)
+	}
+}
+
+func TestCountLogs_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	for _, body := range []string{"{", `{"namespace":"ns","endTime":"2025-01-02T00:00:00Z"}`, `{"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/count", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.CountLogs(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("body %s: expected 400, got %d", body, rec.Code)
+		}
+	}
+}
+
+func TestCorrelateLogs(t *testing.T) {
+	var mu sync.Mutex
+	var gotSQL []string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		gotSQL = append(gotSQL, body.Query.SQL)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body.Query.SQL, "first_seen"):
+			w.Write([]byte(`{"took":1,"hits":[{"component_uid":"c-gw","component_name":"gateway","total":1,"first_seen":1735732800000000,"last_seen":1735732800000000}]}`))
+		case strings.HasPrefix(body.Query.SQL, "SELECT count(*)"):
+			w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+		default:
+			w.Write([]byte(`{"took":1,"hits":[{"_timestamp":1735732800000000,"log":"gateway received"}]}`))
+		}
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","projectId":"proj-1","correlationId":"req-42","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/correlate", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.CorrelateLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.CorrelatedLogsResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if result.CorrelationID != "req-42" || len(result.Components) != 1 || result.Components[0].ComponentName != "gateway" ||
+		len(result.Logs.Logs) != 1 || result.Logs.Logs[0].Log != "gateway received" {
+		t.Errorf("unexpected result: %s", rec.Body.String())
+	}
+	for _, sql := range gotSQL {
+		if !strings.Contains(sql, "correlation_id = 'req-42'") || strings.Contains(sql, "proj-1") {
+			t.Errorf("expected a correlated query across projects, got: %s", sql)
+		}
+	}
+}
+
+func TestCorrelateLogs_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing correlationId", `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"missing namespace", `{"correlationId":"req-42","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`},
+		{"partial time range", `{"namespace":"test-ns","correlationId":"req-42","startTime":"2025-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/correlate", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.CorrelateLogs(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestSearchLogs_QueryTooExpensive(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/_search_partition") {
+			t.Errorf("unexpected search request: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"file_num": 10, "records": 500, "original_size": 2 << 30})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{MaxScanBytes: 1 << 30}, testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-02-01T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "2.0 GiB") || !strings.Contains(rec.Body.String(), "narrow the time window") {
+		t.Errorf("expected the estimate in the rejection, got %s", rec.Body.String())
+	}
+}
+
+func TestSearchLogs_Explain(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":2,"trace_id":"abc123","scan_size":1.5,"hits":[{"total":1}]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+	body := `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+
+	tests := []struct {
+		query      string
+		wantStatus int
+		wantDebug  bool
+	}{
+		{"", http.StatusOK, false},
+		{"?explain=false", http.StatusOK, false},
+		{"?explain=true", http.StatusOK, true},
+		{"?explain=maybe", http.StatusBadRequest, false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search"+tt.query, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, req)
+
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%q: expected %d, got %d", tt.query, tt.wantStatus, rec.Code)
+			continue
+		}
+		if tt.wantStatus != http.StatusOK {
+			continue
+		}
+		var resp struct {
+			Debug *openobserve.ComponentLogsDebug `json:"debug"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if (resp.Debug != nil) != tt.wantDebug {
+			t.Errorf("%q: expected debug %v, got %+v", tt.query, tt.wantDebug, resp.Debug)
+		}
+		if tt.wantDebug && (len(resp.Debug.Queries) != 2 || resp.Debug.Queries[0].TraceID != "abc123") {
+			t.Errorf("%q: unexpected debug details: %+v", tt.query, resp.Debug)
+		}
+	}
+}
+
+func TestSearchLogs_LogStreamNotAllowed(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{AllowedStreams: []openobserve.StreamRef{{Folder: "team-a", Name: "app_logs"}}}, testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"default","logStream":"team-b/app_logs","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "team-b/app_logs") {
+		t.Errorf("expected the rejected stream in the error, got %s", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.StreamLogs(rec, httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream?namespace=default&logStream=team-b/app_logs", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for the stream endpoint, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestSearchLogs_IngestionLag(t *testing.T) {
+	var sql string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sql = body.Query.SQL
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+
+	body := `{"namespace":"default","minIngestionLag":"2m","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+
+	handler := NewLogsHandler(openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger()), nil, testLogger())
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 without an event time field, got %d: %s", rec.Code, rec.Body.String())
+	}
+	rec = httptest.NewRecorder()
+	handler.StreamLogs(rec, httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream?namespace=default&minIngestionLag=2m", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for the stream endpoint, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{EventTimeField: "event_time"}, testLogger())
+	handler = NewLogsHandler(client, nil, testLogger())
+	rec = httptest.NewRecorder()
+	handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(sql, "_timestamp - event_time > 120000000") {
+		t.Errorf("expected a lag filter, got %s", sql)
+	}
+}
+
+func TestSearchLogs_MaxComponentIDs(t *testing.T) {
+	requests := 0
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{MaxComponentIDs: 2}, testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"default","componentIds":["c1","c2","c3"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	for _, path := range []string{"/api/v1/logs/search", "/api/v1/logs/count"} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if path == "/api/v1/logs/search" {
+			handler.SearchLogs(rec, req)
+		} else {
+			handler.CountLogs(rec, req)
+		}
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "at most 2") {
+			t.Errorf("%s: expected 400, got %d: %s", path, rec.Code, rec.Body.String())
+		}
+	}
+	rec := httptest.NewRecorder()
+	handler.StreamLogs(rec, httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream?namespace=default&componentId=c1,c2,c3", nil))
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "componentIds") {
+		t.Errorf("expected 400 for the stream endpoint, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if requests != 0 {
+		t.Errorf("expected no query to be sent, got %d", requests)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search",
+		strings.NewReader(`{"namespace":"default","componentIds":["c1","c2"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`)))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200 at the limit, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestSearchLogs_LabelSelectors(t *testing.T) {
+	var sql string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sql = body.Query.SQL
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	search := func(handler *LogsHandler, selectors string) *httptest.ResponseRecorder {
+		body := `{"namespace":"default","labelSelectors":` + selectors + `,"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+		return rec
+	}
+
+	handler := NewLogsHandler(client, nil, testLogger())
+	if rec := search(handler, `{"app.kubernetes.io/name":"checkout"}`); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for a default key, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(sql, "kubernetes_labels_app_kubernetes_io_name = 'checkout'") {
+		t.Errorf("expected a label filter, got %s", sql)
+	}
+	rec := search(handler, `{"team":"payments","app":"checkout"}`)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "team") {
+		t.Errorf("expected 400 naming the denied key, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	handler = NewLogsHandlerWithOptions(client, nil, HandlerOptions{LabelSelectorKeys: []string{"team"}}, testLogger())
+	if rec := search(handler, `{"team":"payments"}`); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 for a configured key, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := search(handler, `{"app":"checkout"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a default key not in the configured allowlist, got %d", rec.Code)
+	}
+	rec = httptest.NewRecorder()
+	handler.StreamLogs(rec, httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream?namespace=default&label=app=checkout", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for the stream endpoint, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestSearchLogs_MalformedTimeParams(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty", `{"namespace":"ns","startTime":"","endTime":"2025-01-02T00:00:00Z"}`, "startTime must not be empty; expected an RFC3339 timestamp such as 2025-01-01T00:00:00Z"},
+		{"malformed", `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"yesterday"}`, `endTime must be an RFC3339 timestamp such as 2025-01-01T00:00:00Z, got "yesterday"`},
+		{"not a string", `{"namespace":"ns","startTime":1735689600,"endTime":"2025-01-02T00:00:00Z"}`, `startTime must be an RFC3339 timestamp such as 2025-01-01T00:00:00Z, got "1735689600"`},
+		{"in the future", `{"namespace":"ns","startTime":"2999-01-01T00:00:00Z","endTime":"2999-01-02T00:00:00Z"}`, "startTime must not be in the future: 2999-01-01T00:00:00Z is more than 0s ahead of the adapter's clock; check the client's clock for skew"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.SearchLogs(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d", rec.Code)
+			}
+			var resp struct {
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("invalid JSON response: %v", err)
+			}
+			if resp.Message != tt.want {
+				t.Errorf("expected message %q, got %q", tt.want, resp.Message)
+			}
+		})
+	}
+}
+
+func TestQueryLogs_MalformedTimeParams(t *testing.T) {
+	srv := NewServer("0", NewLogsHandler(nil, nil, testLogger()), testLogger())
+
+	body := `{"searchScope":{"namespace":"ns"},"startTime":"yesterday","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/query", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("expected a JSON error, got Content-Type %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), `RFC3339 timestamp such as 2025-01-01T00:00:00Z, got \"yesterday\"`) {
+		t.Errorf("expected the offending value in the message, got %s", rec.Body.String())
+	}
+}
@@ -0,0 +1,422 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// Defaults for the live-tail stream settings in HandlerOptions.
+const (
+	DefaultStreamHeartbeatInterval = 15 * time.Second
+	DefaultStreamBufferSize        = 1000
+	DefaultStreamWriteTimeout      = 10 * time.Second
+)
+
+// errStreamBackpressure is returned to the poller when a client does not drain its
+// buffer fast enough; the connection is then closed instead of buffering without bound.
+var errStreamBackpressure = errors.New("stream buffer full")
+
+// streamSettings holds the normalized live-tail stream settings of a LogsHandler.
+type streamSettings struct {
+	pollInterval      time.Duration
+	heartbeatInterval time.Duration
+	bufferSize        int
+	writeTimeout      time.Duration
+	idleTimeout       time.Duration
+	maxDuration       time.Duration
+}
+
+func newStreamSettings(opts HandlerOptions) streamSettings {
+	s := streamSettings{
+		pollInterval:      opts.StreamPollInterval,
+		heartbeatInterval: opts.StreamHeartbeatInterval,
+		bufferSize:        opts.StreamBufferSize,
+		writeTimeout:      opts.StreamWriteTimeout,
+		idleTimeout:       opts.StreamIdleTimeout,
+		maxDuration:       opts.StreamMaxDuration,
+	}
+	if s.pollInterval <= 0 {
+		s.pollInterval = openobserve.DefaultStreamPollInterval
+	}
+	if s.heartbeatInterval <= 0 {
+		s.heartbeatInterval = DefaultStreamHeartbeatInterval
+	}
+	if s.bufferSize <= 0 {
+		s.bufferSize = DefaultStreamBufferSize
+	}
+	if s.writeTimeout <= 0 {
+		s.writeTimeout = DefaultStreamWriteTimeout
+	}
+	return s
+}
+
+// StreamLogs implements GET /api/v1/logs/stream.
+// It keeps the connection open and pushes new component logs as Server-Sent Events
+// (one "data: <json>" frame per entry). During quiet periods a comment line is sent every
+// heartbeat interval so that proxies and load balancers keep the connection alive.
+// Clients that fall more than the buffer size behind, or whose writes stall for longer
+// than the write timeout, are disconnected. When configured, the stream is also closed,
+// with a final "close" event, once no log was sent for the idle timeout or once it has
+// been open for the maximum duration, and when the server shuts down. The stream can be
+// closed early through POST /api/v1/logs/{id}/cancel with the ID sent in the X-Operation-Id
+// header and the initial "operation" event.
+func (h *LogsHandler) StreamLogs(w http.ResponseWriter, r *http.Request) {
+	params, msg := parseStreamParams(r)
+	if msg == "" {
+		msg = h.resolveMinLevel(&params)
+	}
+	if msg == "" {
+		msg = h.validateLabelSelectors(params.LabelSelectors)
+	}
+	// Validate against the client the stream polls, which is the tenant's own when it has one.
+	client := h.clientFor(r.Context())
+	if msg == "" {
+		if err := client.ValidateLogStream(params.LogStream); err != nil {
+			msg = err.Error()
+		}
+	}
+	if msg == "" {
+		if err := client.ValidateIngestionLag(params.MinIngestionLag); err != nil {
+			msg = err.Error()
+		}
+	}
+	if msg == "" {
+		if err := client.ValidateComponentIDs(params.ComponentIDs); err != nil {
+			msg = err.Error()
+		}
+	}
+	if msg != "" {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, msg)
+		return
+	}
+	h.serveLogStream(w, r, params)
+}
+
+// StreamAlertLogs implements GET /api/v1alpha1/alerts/rules/{ruleName}/stream.
+// It streams the component logs matching an alert rule as they arrive, using the rule's
+// search pattern and component scope, to show what triggers the alert. The stream works
+// like GET /api/v1/logs/stream and accepts its startTime parameter; an initial "alert"
+// event describes the rule's search.
+func (h *LogsHandler) StreamAlertLogs(w http.ResponseWriter, r *http.Request) {
+	ruleName := r.PathValue("ruleName")
+	var startTime time.Time
+	if v := r.URL.Query().Get("startTime"); v != "" {
+		var err error
+		if startTime, err = time.Parse(time.RFC3339, v); err != nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "startTime must be an RFC3339 timestamp")
+			return
+		}
+	}
+
+	alert, err := h.clientFor(r.Context()).GetAlert(r.Context(), ruleName)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			h.writeError(w, http.StatusNotFound, gen.NotFound, "alert rule not found")
+			return
+		}
+		h.logger.Error("Failed to get alert",
+			slog.String("function", "StreamAlertLogs"),
+			slog.String("ruleName", ruleName),
+			slog.Any("error", err),
+		)
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+
+	params := openobserve.ComponentLogsParams{
+		Namespace:     alert.Namespace,
+		ProjectID:     alert.ProjectUID,
+		EnvironmentID: alert.EnvironmentUID,
+		SearchPhrase:  openobserve.ExtractSearchPattern(alert.SQL),
+		StartTime:     startTime,
+		// The alert matches its pattern with the exact case (str_match), so the stream does too.
+		CaseSensitive: true,
+	}
+	if alert.ComponentUID != "" {
+		params.ComponentIDs = []string{alert.ComponentUID}
+	}
+	if params.Namespace == "" {
+		h.writeError(w, http.StatusConflict, gen.Conflict, "alert rule has no namespace to stream logs from")
+		return
+	}
+
+	event, err := json.Marshal(map[string]string{
+		"name":          alert.Name,
+		"pattern":       params.SearchPhrase,
+		"namespace":     params.Namespace,
+		"environmentId": params.EnvironmentID,
+		"componentId":   alert.ComponentUID,
+	})
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.serveLogStream(w, r, params, "event: alert\ndata: "+string(event)+"\n\n")
+}
+
+// serveLogStream streams the component logs matching params as Server-Sent Events until
+// the client disconnects or the stream is canceled. The initial frames are sent after the
+// "operation" event.
+func (h *LogsHandler) serveLogStream(w http.ResponseWriter, r *http.Request, params openobserve.ComponentLogsParams, initialFrames ...string) {
+	if err := h.streams.add(); err != nil {
+		retryAfter := 1
+		if errors.Is(err, errTooManyStreams) {
+			h.logger.Warn("Refused log stream, too many are open",
+				slog.String("clientIP", clientIPFromContext(r.Context())),
+				slog.Int("max", h.streams.stats().Max),
+			)
+			retryAfter = streamRetryAfter
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		h.writeError(w, http.StatusServiceUnavailable, serviceUnavailable, err.Error())
+		return
+	}
+	defer h.streams.done()
+
+	ctx, operationID, cancel := h.operations.start(r.Context())
+	defer cancel()
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set(operationIDHeader, operationID)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable response buffering in nginx-based ingress controllers.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		h.logger.Error("Streaming is not supported by the response writer",
+			slog.String("function", "StreamLogs"),
+			slog.Any("error", err),
+		)
+		return
+	}
+	// Browsers' EventSource cannot read response headers, so the ID is also sent as an event.
+	if err := h.writeStreamFrame(rc, w, "event: operation\ndata: {\"id\":\""+operationID+"\"}\n\n"); err != nil {
+		h.logStreamWriteError(params, err)
+		return
+	}
+	for _, frame := range initialFrames {
+		if err := h.writeStreamFrame(rc, w, frame); err != nil {
+			h.logStreamWriteError(params, err)
+			return
+		}
+	}
+
+	entries := make(chan openobserve.ComponentLogsEntry, h.stream.bufferSize)
+	pollErr := make(chan error, 1)
+	go func() {
+		pollErr <- h.clientFor(ctx).StreamComponentLogs(ctx, scopeToTenant(ctx, params), h.stream.pollInterval, func(entry openobserve.ComponentLogsEntry) error {
+			select {
+			case entries <- entry:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+				return errStreamBackpressure
+			}
+		})
+	}()
+	// Stop the poller before returning so that it never outlives the request.
+	defer func() {
+		cancel()
+		<-pollErr
+	}()
+
+	heartbeat := time.NewTicker(h.stream.heartbeatInterval)
+	defer heartbeat.Stop()
+	// Both limits are disabled when unset; a nil channel never fires.
+	var idle, expired <-chan time.Time
+	var idleTimer *time.Timer
+	if h.stream.idleTimeout > 0 {
+		idleTimer = time.NewTimer(h.stream.idleTimeout)
+		defer idleTimer.Stop()
+		idle = idleTimer.C
+	}
+	if h.stream.maxDuration > 0 {
+		maxTimer := time.NewTimer(h.stream.maxDuration)
+		defer maxTimer.Stop()
+		expired = maxTimer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.streams.closing:
+			h.closeLogStream(rc, w, params, operationID, "shutdown")
+			return
+		case <-idle:
+			h.closeLogStream(rc, w, params, operationID, "idle", slog.Duration("limit", h.stream.idleTimeout))
+			return
+		case <-expired:
+			h.closeLogStream(rc, w, params, operationID, "maxDuration", slog.Duration("limit", h.stream.maxDuration))
+			return
+		case err := <-pollErr:
+			// Put the result back for the deferred wait.
+			pollErr <- err
+			if errors.Is(err, errStreamBackpressure) {
+				h.logger.Warn("Dropping slow log stream client",
+					slog.String("function", "StreamLogs"),
+					slog.String("namespace", params.Namespace),
+					slog.Int("bufferSize", h.stream.bufferSize),
+				)
+			} else if err != nil {
+				h.logger.Error("Log stream stopped",
+					slog.String("function", "StreamLogs"),
+					slog.String("namespace", params.Namespace),
+					slog.Any("error", err),
+				)
+			}
+			return
+		case entry := <-entries:
+			if err := h.writeStreamEvent(rc, w, entry); err != nil {
+				h.logStreamWriteError(params, err)
+				return
+			}
+			heartbeat.Reset(h.stream.heartbeatInterval)
+			if idleTimer != nil {
+				idleTimer.Reset(h.stream.idleTimeout)
+			}
+		case <-heartbeat.C:
+			if err := h.writeStreamFrame(rc, w, ": heartbeat\n\n"); err != nil {
+				h.logStreamWriteError(params, err)
+				return
+			}
+		}
+	}
+}
+
+// closeLogStream ends a log stream that reached one of its limits or is closed by a server
+// shutdown, telling the client why with a final "close" event, e.g. {"reason":"idle"}.
+func (h *LogsHandler) closeLogStream(rc *http.ResponseController, w io.Writer, params openobserve.ComponentLogsParams, operationID, reason string, attrs ...slog.Attr) {
+	args := []any{
+		slog.String("function", "StreamLogs"),
+		slog.String("namespace", params.Namespace),
+		slog.String("operationId", operationID),
+		slog.String("reason", reason),
+	}
+	for _, attr := range attrs {
+		args = append(args, attr)
+	}
+	h.logger.Info("Closing log stream", args...)
+	if err := h.writeStreamFrame(rc, w, "event: close\ndata: {\"reason\":\""+reason+"\"}\n\n"); err != nil {
+		h.logStreamWriteError(params, err)
+	}
+}
+
+// writeStreamEvent writes entry as an SSE data frame.
+func (h *LogsHandler) writeStreamEvent(rc *http.ResponseController, w io.Writer, entry openobserve.ComponentLogsEntry) error {
+	mapped, err := h.fieldMapper.mapLogEntry(&entry)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(mapped)
+	if err != nil {
+		return fmt.Errorf("failed to marshal log entry: %w", err)
+	}
+	return h.writeStreamFrame(rc, w, "data: "+string(data)+"\n\n")
+}
+
+// writeStreamFrame writes and flushes a single SSE frame. Each write gets its own
+// deadline, so a client that stops reading is detected instead of blocking forever.
+func (h *LogsHandler) writeStreamFrame(rc *http.ResponseController, w io.Writer, frame string) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(h.stream.writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := io.WriteString(w, frame); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
+
+func (h *LogsHandler) logStreamWriteError(params openobserve.ComponentLogsParams, err error) {
+	h.logger.Warn("Closing log stream after failed write",
+		slog.String("function", "StreamLogs"),
+		slog.String("namespace", params.Namespace),
+		slog.Any("error", err),
+	)
+}
+
+// parseStreamParams reads the stream filters from the query string. It returns a
+// user-facing message describing the first invalid parameter, or "" if they are valid.
+func parseStreamParams(r *http.Request) (openobserve.ComponentLogsParams, string) {
+	q := r.URL.Query()
+	params := openobserve.ComponentLogsParams{
+		Namespace:       strings.TrimSpace(q.Get("namespace")),
+		ProjectID:       q.Get("projectId"),
+		EnvironmentID:   q.Get("environmentId"),
+		ComponentIDs:    splitQueryValues(q["componentId"]),
+		PodID:           q.Get("podId"),
+		Version:         q.Get("version"),
+		CorrelationID:   q.Get("correlationId"),
+		Stream:          q.Get("stream"),
+		SearchPhrase:    q.Get("searchPhrase"),
+		ExcludePhrases:  q["excludePhrase"],
+		LogLevels:       splitQueryValues(q["logLevel"]),
+		MinLevel:        q.Get("minLevel"),
+		LogStream:       q.Get("logStream"),
+		MinIngestionLag: q.Get("minIngestionLag"),
+	}
+	if params.Namespace == "" {
+		return params, "namespace is required"
+	}
+	if msg := validateLogStream(params.Stream); msg != "" {
+		return params, msg
+	}
+	if msg := validateExcludePhrases(params.ExcludePhrases); msg != "" {
+		return params, msg
+	}
+	if v := q.Get("caseSensitive"); v != "" {
+		caseSensitive, err := strconv.ParseBool(v)
+		if err != nil {
+			return params, "caseSensitive must be true or false"
+		}
+		params.CaseSensitive = caseSensitive
+	}
+	for _, selector := range q["label"] {
+		key, value, ok := strings.Cut(selector, "=")
+		if !ok || key == "" {
+			return params, "label must be a key=value pod label selector"
+		}
+		if params.LabelSelectors == nil {
+			params.LabelSelectors = make(map[string]string)
+		}
+		params.LabelSelectors[key] = value
+	}
+	if v := q.Get("startTime"); v != "" {
+		startTime, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return params, "startTime must be an RFC3339 timestamp"
+		}
+		params.StartTime = startTime
+	}
+	return params, ""
+}
+
+// splitQueryValues flattens repeated and comma-separated query parameter values.
+func splitQueryValues(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
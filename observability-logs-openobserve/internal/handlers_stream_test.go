@@ -0,0 +1,431 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func newStreamTestServer(t *testing.T, hits func() []map[string]interface{}, opts HandlerOptions) *httptest.Server {
+	t.Helper()
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: hits()})
+	}))
+	t.Cleanup(ooServer.Close)
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, opts, testLogger())
+
+	mux := http.NewServeMux()
+	handler.registerRoutes(mux)
+	adapter := httptest.NewServer(mux)
+	t.Cleanup(adapter.Close)
+	return adapter
+}
+
+func TestStreamLogs(t *testing.T) {
+	sent := false
+	adapter := newStreamTestServer(t, func() []map[string]interface{} {
+		if sent {
+			return nil
+		}
+		sent = true
+		return []map[string]interface{}{
+			{"_timestamp": float64(time.Now().UnixMicro()), "log": "live line"},
+		}
+	}, HandlerOptions{StreamPollInterval: 10 * time.Millisecond, StreamHeartbeatInterval: 20 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1/logs/stream?namespace=test-ns", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected text/event-stream, got %q", ct)
+	}
+
+	var gotData, gotHeartbeat bool
+	var event string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && !(gotData && gotHeartbeat) {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			event = ""
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: ") && event == "operation":
+			if !strings.Contains(line, resp.Header.Get(operationIDHeader)) {
+				t.Errorf("expected operation event with ID %q, got %q", resp.Header.Get(operationIDHeader), line)
+			}
+		case strings.HasPrefix(line, "data: "):
+			var entry openobserve.ComponentLogsEntry
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &entry); err != nil {
+				t.Fatalf("invalid event payload %q: %v", line, err)
+			}
+			if entry.Log != "live line" {
+				t.Errorf("expected log 'live line', got %q", entry.Log)
+			}
+			gotData = true
+		case strings.HasPrefix(line, ":"):
+			gotHeartbeat = true
+		}
+	}
+	if !gotData || !gotHeartbeat {
+		t.Fatalf("expected a data event and a heartbeat, got data=%v heartbeat=%v", gotData, gotHeartbeat)
+	}
+}
+
+func TestNewStreamSettings_Defaults(t *testing.T) {
+	s := newStreamSettings(HandlerOptions{})
+	if s.heartbeatInterval != 15*time.Second {
+		t.Errorf("expected default heartbeat interval 15s, got %s", s.heartbeatInterval)
+	}
+	if s.pollInterval != openobserve.DefaultStreamPollInterval {
+		t.Errorf("expected default poll interval %s, got %s", openobserve.DefaultStreamPollInterval, s.pollInterval)
+	}
+}
+
+func TestStreamLogs_StopsOnClientDisconnect(t *testing.T) {
+	var polls atomic.Int64
+	adapter := newStreamTestServer(t, func() []map[string]interface{} {
+		polls.Add(1)
+		return nil
+	}, HandlerOptions{StreamPollInterval: 5 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1/logs/stream?namespace=test-ns", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); polls.Load() < 2; {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the stream to poll OpenObserve")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	resp.Body.Close()
+
+	// Once the server notices the disconnect, the poller must stop for good.
+	last := polls.Load()
+	for deadline := time.Now().Add(5 * time.Second); ; last = polls.Load() {
+		time.Sleep(50 * time.Millisecond)
+		if polls.Load() == last {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected polling to stop after the client disconnected")
+		}
+	}
+	time.Sleep(50 * time.Millisecond)
+	if got := polls.Load(); got != last {
+		t.Errorf("expected no polls after the client disconnected, got %d more", got-last)
+	}
+}
+
+func TestStreamLogs_DropsSlowClient(t *testing.T) {
+	adapter := newStreamTestServer(t, func() []map[string]interface{} {
+		hits := make([]map[string]interface{}, 10)
+		for i := range hits {
+			hits[i] = map[string]interface{}{"_timestamp": float64(time.Now().UnixMicro() + int64(i)), "log": strings.Repeat("x", 1024)}
+		}
+		return hits
+	}, HandlerOptions{StreamPollInterval: time.Millisecond, StreamBufferSize: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1/logs/stream?namespace=test-ns", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	// The poller produces batches larger than the buffer, so the server must close
+	// the stream rather than buffer the backlog.
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+	}
+	if ctx.Err() != nil {
+		t.Fatal("expected the server to close the stream of a client that fell behind")
+	}
+}
+
+func TestStreamLogs_Limits(t *testing.T) {
+	tests := []struct {
+		name string
+		opts HandlerOptions
+		want string
+	}{
+		{"idle", HandlerOptions{StreamIdleTimeout: 50 * time.Millisecond}, `{"reason":"idle"}`},
+		{"max duration", HandlerOptions{StreamMaxDuration: 50 * time.Millisecond}, `{"reason":"maxDuration"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.StreamPollInterval = 10 * time.Millisecond
+			adapter := newStreamTestServer(t, func() []map[string]interface{} { return nil }, tt.opts)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1/logs/stream?namespace=test-ns", nil)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			// The server must end the stream itself, with a close event as the last frame.
+			var event, data string
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				line := scanner.Text()
+				switch {
+				case strings.HasPrefix(line, "event: "):
+					event = strings.TrimPrefix(line, "event: ")
+				case strings.HasPrefix(line, "data: "):
+					data = strings.TrimPrefix(line, "data: ")
+				}
+			}
+			if ctx.Err() != nil {
+				t.Fatal("expected the server to close the stream")
+			}
+			if event != "close" || data != tt.want {
+				t.Errorf("expected a final close event with %s, got event %q with %s", tt.want, event, data)
+			}
+		})
+	}
+}
+
+func TestStreamLogs_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"missing namespace", ""},
+		{"invalid startTime", "?namespace=ns&startTime=yesterday"},
+		{"unknown stream", "?namespace=ns&stream=stdin"},
+		{"invalid caseSensitive", "?namespace=ns&caseSensitive=maybe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/logs/stream"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			handler.StreamLogs(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestSplitQueryValues(t *testing.T) {
+	got := splitQueryValues([]string{"a,b", " c ", ""})
+	if len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+		t.Errorf("unexpected values: %v", got)
+	}
+}
+
+func TestStreamLogs_CancelByID(t *testing.T) {
+	adapter := newStreamTestServer(t, func() []map[string]interface{} { return nil },
+		HandlerOptions{StreamPollInterval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1/logs/stream?namespace=test-ns", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	id := resp.Header.Get(operationIDHeader)
+	if id == "" {
+		t.Fatalf("expected an %s header", operationIDHeader)
+	}
+
+	cancelResp, err := http.Post(adapter.URL+"/api/v1/logs/"+id+"/cancel", "application/json", nil)
+	if err != nil {
+		t.Fatalf("cancel request failed: %v", err)
+	}
+	cancelResp.Body.Close()
+	if cancelResp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", cancelResp.StatusCode)
+	}
+
+	// The server must close the canceled stream.
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+	}
+	if ctx.Err() != nil {
+		t.Fatal("expected the server to close the canceled stream")
+	}
+
+	// The operation is gone once canceled.
+	cancelResp, err = http.Post(adapter.URL+"/api/v1/logs/"+id+"/cancel", "application/json", nil)
+	if err != nil {
+		t.Fatalf("cancel request failed: %v", err)
+	}
+	cancelResp.Body.Close()
+	if cancelResp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected 404 for an already canceled operation, got %d", cancelResp.StatusCode)
+	}
+}
+
+// newAlertStreamTestServer returns an adapter whose mock OpenObserve knows the alert rule
+// "test-alert", matching "timeout" in the logs of component comp-1.
+func newAlertStreamTestServer(t *testing.T, searches chan<- string) *httptest.Server {
+	t.Helper()
+	sent := false
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/default/alerts":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"list": []map[string]string{{"alert_id": "alert-789", "name": "test-alert"}},
+			})
+		case "/api/v2/default/alerts/alert-789":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"name": "test-alert",
+				"query_condition": map[string]interface{}{
+					"sql": `SELECT _timestamp FROM "default" WHERE str_match(log, 'timeout') AND kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'`,
+				},
+				"trigger_condition":  map[string]interface{}{"operator": ">", "threshold": float64(1)},
+				"context_attributes": map[string]interface{}{"namespace": "test-ns", "componentUid": "comp-1"},
+			})
+		default:
+			var body struct {
+				Query struct {
+					SQL string `json:"sql"`
+				} `json:"query"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			select {
+			case searches <- body.Query.SQL:
+			default:
+			}
+			var hits []map[string]interface{}
+			if !sent {
+				sent = true
+				hits = []map[string]interface{}{{"_timestamp": float64(time.Now().UnixMicro()), "log": "request timeout"}}
+			}
+			json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: hits})
+		}
+	}))
+	t.Cleanup(ooServer.Close)
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{StreamPollInterval: 10 * time.Millisecond}, testLogger())
+	mux := http.NewServeMux()
+	handler.registerRoutes(mux)
+	adapter := httptest.NewServer(mux)
+	t.Cleanup(adapter.Close)
+	return adapter
+}
+
+func TestStreamAlertLogs(t *testing.T) {
+	searches := make(chan string, 1)
+	adapter := newAlertStreamTestServer(t, searches)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, adapter.URL+"/api/v1alpha1/alerts/rules/test-alert/stream", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", resp.StatusCode)
+	}
+
+	var gotAlert, gotData bool
+	var event string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && !gotData {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			event = ""
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: ") && event == "alert":
+			var alert map[string]string
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &alert); err != nil {
+				t.Fatalf("invalid alert event %q: %v", line, err)
+			}
+			if alert["name"] != "test-alert" || alert["pattern"] != "timeout" || alert["componentId"] != "comp-1" {
+				t.Errorf("unexpected alert event: %v", alert)
+			}
+			gotAlert = true
+		case strings.HasPrefix(line, "data: ") && event == "":
+			if !gotAlert {
+				t.Fatal("expected the alert event before any log")
+			}
+			if !strings.Contains(line, "request timeout") {
+				t.Errorf("unexpected log event: %q", line)
+			}
+			gotData = true
+		}
+	}
+	if !gotData {
+		t.Fatal("expected a matching log event")
+	}
+
+	sql := <-searches
+	for _, want := range []string{"log LIKE '%timeout%'", "comp-1", "test-ns"} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("expected the stream query to contain %q, got %s", want, sql)
+		}
+	}
+}
+
+func TestStreamAlertLogs_Errors(t *testing.T) {
+	adapter := newAlertStreamTestServer(t, make(chan string, 1))
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{"unknown rule", "/api/v1alpha1/alerts/rules/missing/stream", http.StatusNotFound},
+		{"invalid startTime", "/api/v1alpha1/alerts/rules/test-alert/stream?startTime=yesterday", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(adapter.URL + tt.path)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
@@ -537,11 +537,97 @@ func TestQueryLogs_ComponentScope(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	if _, ok := resp.(gen.QueryLogs200JSONResponse); !ok {
+	if _, ok := resp.(componentQueryLogsResponse); !ok {
 		t.Fatalf("expected 200 response, got %T", resp)
 	}
 }
 
+func TestQueryLogs_ComponentScope_Offset(t *testing.T) {
+	var from int
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL  string `json:"sql"`
+				From int    `json:"from"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(body.Query.SQL, "count(*)") {
+			json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(5)}}})
+			return
+		}
+		from = body.Query.From
+		resp := openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(time.Date(2025, 1, 1, 12, 0, 2, 0, time.UTC).UnixMicro()), "log": "third"},
+				{"_timestamp": float64(time.Date(2025, 1, 1, 12, 0, 3, 0, time.UTC).UnixMicro()), "log": "fourth"},
+			},
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	server := NewServer("0", NewLogsHandler(client, nil, testLogger()), testLogger()).httpServer.Handler
+	post := func(body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/query", strings.NewReader(body)))
+		return rec
+	}
+	const window = `"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","limit":2`
+
+	rec := post(`{"searchScope":{"namespace":"test-ns"},` + window + `,"offset":2}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 response, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Logs       []gen.ComponentLogEntry `json:"logs"`
+		Offset     *int                    `json:"offset"`
+		NextOffset *int                    `json:"nextOffset"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if from != 2 {
+		t.Errorf("expected the query to skip 2 logs, got from=%d", from)
+	}
+	if body.Offset == nil || *body.Offset != 2 {
+		t.Errorf("expected the offset 2 to be echoed, got %v", body.Offset)
+	}
+	if body.NextOffset == nil || *body.NextOffset != 4 {
+		t.Errorf("expected next offset 4, got %v", body.NextOffset)
+	}
+	if len(body.Logs) != 2 || *body.Logs[0].Log != "fourth" {
+		t.Errorf("unexpected logs: %+v", body.Logs)
+	}
+
+	rec = post(`{"searchScope":{"namespace":"test-ns"},` + window + `}`)
+	body.Offset = nil
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil || rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 response, got %d: %v", rec.Code, err)
+	}
+	if from != 0 || body.Offset == nil || *body.Offset != 0 {
+		t.Errorf("expected the first page at offset 0, got from=%d and offset %v", from, body.Offset)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"negative offset", `{"searchScope":{"namespace":"test-ns"},` + window + `,"offset":-1}`},
+		{"non-integer offset", `{"searchScope":{"namespace":"test-ns"},` + window + `,"offset":"2"}`},
+		{"offset of workflow logs", `{"searchScope":{"namespace":"test-ns","workflowRunName":"run-1"},` + window + `,"offset":2}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := post(tt.body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
 func TestCreateAlertRule_Success(t *testing.T) {
 	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -813,6 +899,11 @@ func TestUpdateAlertRule_NotFound(t *testing.T) {
 	resp, err := handler.UpdateAlertRule(context.Background(), gen.UpdateAlertRuleRequestObject{
 		RuleName: "nonexistent",
 		Body: &gen.AlertRuleRequest{
+			Source: struct {
+				Query string `json:"query"`
+			}{
+				Query: "error",
+			},
 			Condition: struct {
 				Enabled   bool                                 `json:"enabled"`
 				Interval  string                               `json:"interval"`
@@ -864,6 +955,11 @@ func TestUpdateAlertRule_InvalidError(t *testing.T) {
 	resp, err := handler.UpdateAlertRule(context.Background(), gen.UpdateAlertRuleRequestObject{
 		RuleName: "test-alert",
 		Body: &gen.AlertRuleRequest{
+			Source: struct {
+				Query string `json:"query"`
+			}{
+				Query: "error",
+			},
 			Condition: struct {
 				Enabled   bool                                 `json:"enabled"`
 				Interval  string                               `json:"interval"`
@@ -915,6 +1011,11 @@ func TestUpdateAlertRule_GenericError(t *testing.T) {
 	resp, err := handler.UpdateAlertRule(context.Background(), gen.UpdateAlertRuleRequestObject{
 		RuleName: "test-alert",
 		Body: &gen.AlertRuleRequest{
+			Source: struct {
+				Query string `json:"query"`
+			}{
+				Query: "error",
+			},
 			Condition: struct {
 				Enabled   bool                                 `json:"enabled"`
 				Interval  string                               `json:"interval"`
@@ -1603,3 +1704,271 @@ func isEventsCountQuery(r *http.Request) bool {
 	sql, _ := q["sql"].(string)
 	return size == 0 && strings.Contains(strings.ToLower(sql), "count")
 }
+
+func TestQueryLogs_ComponentScope_NearLimitHeader(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).UnixMicro()), "log": "only log"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	scope := gen.LogsQueryRequest_SearchScope{}
+	_ = scope.FromComponentSearchScope(gen.ComponentSearchScope{Namespace: "test-ns"})
+
+	for _, tc := range []struct {
+		limit      int
+		wantHeader string
+	}{
+		{limit: 1, wantHeader: "true"},
+		{limit: 100, wantHeader: ""},
+	} {
+		resp, err := handler.QueryLogs(context.Background(), gen.QueryLogsRequestObject{
+			Body: &gen.LogsQueryRequest{
+				StartTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+				Limit:       ptr(tc.limit),
+				SearchScope: scope,
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		if err := resp.VisitQueryLogsResponse(rec); err != nil {
+			t.Fatalf("unexpected error writing response: %v", err)
+		}
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+		if got := rec.Header().Get(nearLimitHeader); got != tc.wantHeader {
+			t.Errorf("limit %d: expected %s header %q, got %q", tc.limit, nearLimitHeader, tc.wantHeader, got)
+		}
+	}
+}
+
+func validAlertRuleRequest() *gen.AlertRuleRequest {
+	req := &gen.AlertRuleRequest{}
+	req.Metadata.Name = "test-alert"
+	req.Metadata.Namespace = "test-ns"
+	req.Source.Query = "error"
+	req.Condition.Enabled = true
+	req.Condition.Operator = gen.AlertRuleRequestConditionOperator("gt")
+	req.Condition.Threshold = 5
+	req.Condition.Window = "5m"
+	req.Condition.Interval = "1m"
+	return req
+}
+
+func TestAlertRule_InvalidParams(t *testing.T) {
+	// OpenObserve must never be called for invalid alert parameters.
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to OpenObserve: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	tests := []struct {
+		name      string
+		mutate    func(*gen.AlertRuleRequest)
+		wantField string
+	}{
+		{"empty query", func(r *gen.AlertRuleRequest) { r.Source.Query = "" }, "source.query"},
+		{"negative threshold", func(r *gen.AlertRuleRequest) { r.Condition.Threshold = -2 }, "condition.threshold"},
+		{"negative window", func(r *gen.AlertRuleRequest) { r.Condition.Window = "-5m" }, "condition.window"},
+		{"zero interval", func(r *gen.AlertRuleRequest) { r.Condition.Interval = "0m" }, "condition.interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createBody := validAlertRuleRequest()
+			tt.mutate(createBody)
+			createResp, err := handler.CreateAlertRule(context.Background(), gen.CreateAlertRuleRequestObject{Body: createBody})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			badCreate, ok := createResp.(gen.CreateAlertRule400JSONResponse)
+			if !ok {
+				t.Fatalf("expected 400 response from CreateAlertRule, got %T", createResp)
+			}
+			if badCreate.Message == nil || !strings.Contains(*badCreate.Message, tt.wantField) {
+				t.Errorf("expected message about %s, got %v", tt.wantField, badCreate.Message)
+			}
+
+			updateBody := validAlertRuleRequest()
+			tt.mutate(updateBody)
+			updateResp, err := handler.UpdateAlertRule(context.Background(), gen.UpdateAlertRuleRequestObject{RuleName: "test-alert", Body: updateBody})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			badUpdate, ok := updateResp.(gen.UpdateAlertRule400JSONResponse)
+			if !ok {
+				t.Fatalf("expected 400 response from UpdateAlertRule, got %T", updateResp)
+			}
+			if badUpdate.Message == nil || !strings.Contains(*badUpdate.Message, tt.wantField) {
+				t.Errorf("expected message about %s, got %v", tt.wantField, badUpdate.Message)
+			}
+		})
+	}
+}
+
+func TestQueryLogs_EmptyResult(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+
+	componentScope := gen.LogsQueryRequest_SearchScope{}
+	_ = componentScope.FromComponentSearchScope(gen.ComponentSearchScope{Namespace: "test-ns"})
+	workflowScope := gen.LogsQueryRequest_SearchScope{}
+	_ = workflowScope.FromWorkflowSearchScope(gen.WorkflowSearchScope{Namespace: "test-ns", WorkflowRunName: ptr("run-1")})
+
+	tests := []struct {
+		name     string
+		notFound bool
+		scope    gen.LogsQueryRequest_SearchScope
+		wantCode int
+	}{
+		{"component scope defaults to 200", false, componentScope, http.StatusOK},
+		{"component scope with 404 option", true, componentScope, http.StatusNotFound},
+		{"workflow scope defaults to 200", false, workflowScope, http.StatusOK},
+		{"workflow scope with 404 option", true, workflowScope, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{EmptyResultNotFound: tt.notFound}, testLogger())
+
+			resp, err := handler.QueryLogs(context.Background(), gen.QueryLogsRequestObject{
+				Body: &gen.LogsQueryRequest{
+					StartTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					EndTime:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+					SearchScope: tt.scope,
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			rec := httptest.NewRecorder()
+			if err := resp.VisitQueryLogsResponse(rec); err != nil {
+				t.Fatalf("unexpected error writing response: %v", err)
+			}
+			if rec.Code != tt.wantCode {
+				t.Errorf("expected %d, got %d", tt.wantCode, rec.Code)
+			}
+		})
+	}
+}
+
+func TestCreateAlertRule_AlreadyExists(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"Alert with name test-alert already exists"}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	resp, err := handler.CreateAlertRule(context.Background(), gen.CreateAlertRuleRequestObject{Body: validAlertRuleRequest()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	conflict, ok := resp.(gen.CreateAlertRule409JSONResponse)
+	if !ok {
+		t.Fatalf("expected 409 response, got %T", resp)
+	}
+	if *conflict.Title != gen.Conflict || !strings.Contains(*conflict.Message, "test-alert") {
+		t.Errorf("unexpected conflict response: %s: %s", *conflict.Title, *conflict.Message)
+	}
+}
+
+func TestAlertRule_WindowExceedsMaximum(t *testing.T) {
+	// OpenObserve must never be called for an over-large evaluation window.
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to OpenObserve: %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{MaxAlertWindow: time.Hour}, testLogger())
+
+	body := validAlertRuleRequest()
+	body.Condition.Window = "2h"
+
+	createResp, err := handler.CreateAlertRule(context.Background(), gen.CreateAlertRuleRequestObject{Body: body})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := createResp.(gen.CreateAlertRule400JSONResponse); !ok {
+		t.Errorf("expected 400 from CreateAlertRule, got %T", createResp)
+	}
+
+	updateResp, err := handler.UpdateAlertRule(context.Background(), gen.UpdateAlertRuleRequestObject{RuleName: "test-alert", Body: body})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := updateResp.(gen.UpdateAlertRule400JSONResponse); !ok {
+		t.Errorf("expected 400 from UpdateAlertRule, got %T", updateResp)
+	}
+}
+
+func TestQueryLogs_ComponentScope_FallbackStreamHeader(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var resp openobserve.OpenObserveResponse
+		if strings.Contains(string(body), `FROM \"archive\"`) {
+			resp.Hits = []map[string]interface{}{{"_timestamp": float64(1735732800000000), "log": "archived", "total": float64(1)}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{FallbackStreams: []string{"archive"}}, testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	scope := gen.LogsQueryRequest_SearchScope{}
+	_ = scope.FromComponentSearchScope(gen.ComponentSearchScope{
+		Namespace: "test-ns",
+	})
+
+	resp, err := handler.QueryLogs(context.Background(), gen.QueryLogsRequestObject{
+		Body: &gen.LogsQueryRequest{
+			StartTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			SearchScope: scope,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	if err := resp.VisitQueryLogsResponse(rec); err != nil {
+		t.Fatalf("failed to write response: %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(logStreamHeader); got != "archive" {
+		t.Errorf("expected %s header %q, got %q", logStreamHeader, "archive", got)
+	}
+}
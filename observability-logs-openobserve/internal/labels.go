@@ -0,0 +1,47 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultLabelSelectorKeys are the pod label keys that labelSelectors may filter on when no
+// allowlist is configured: the labels OpenChoreo sets on workloads and the recommended
+// Kubernetes application labels.
+var DefaultLabelSelectorKeys = []string{
+	"openchoreo.dev/namespace",
+	"openchoreo.dev/project",
+	"openchoreo.dev/project-uid",
+	"openchoreo.dev/environment",
+	"openchoreo.dev/environment-uid",
+	"openchoreo.dev/component",
+	"openchoreo.dev/component-uid",
+	"app",
+	"app.kubernetes.io/name",
+	"app.kubernetes.io/instance",
+	"app.kubernetes.io/version",
+	"app.kubernetes.io/component",
+	"app.kubernetes.io/part-of",
+	"app.kubernetes.io/managed-by",
+}
+
+// validateLabelSelectors checks the keys of a labelSelectors filter against the allowed label
+// keys. Other labels may be sensitive or unindexed and expensive to scan, so they are
+// rejected with a user-facing message.
+func (h *LogsHandler) validateLabelSelectors(selectors map[string]string) string {
+	var denied []string
+	for key := range selectors {
+		if !h.labelSelectorKeys[key] {
+			denied = append(denied, key)
+		}
+	}
+	if len(denied) == 0 {
+		return ""
+	}
+	sort.Strings(denied)
+	return fmt.Sprintf("labelSelectors may not filter on %s", strings.Join(denied, ", "))
+}
@@ -0,0 +1,258 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// defaultQueryRange is the time range queried when a Loki or Elasticsearch-style request
+// does not specify its start.
+const defaultQueryRange = time.Hour
+
+// logLabelFilters maps the label names accepted by the Loki and Elasticsearch-compatible
+// endpoints to the component log filter they set.
+var logLabelFilters = map[string]func(*openobserve.ComponentLogsParams, string){
+	"namespace":       func(p *openobserve.ComponentLogsParams, v string) { p.Namespace = v },
+	"project_uid":     func(p *openobserve.ComponentLogsParams, v string) { p.ProjectID = v },
+	"environment_uid": func(p *openobserve.ComponentLogsParams, v string) { p.EnvironmentID = v },
+	"component_uid":   func(p *openobserve.ComponentLogsParams, v string) { p.ComponentIDs = []string{v} },
+	"pod_id":          func(p *openobserve.ComponentLogsParams, v string) { p.PodID = v },
+	"level":           func(p *openobserve.ComponentLogsParams, v string) { p.LogLevels = []string{v} },
+	"stream":          func(p *openobserve.ComponentLogsParams, v string) { p.Stream = v },
+}
+
+// lokiLabelNames are the labels attached to the returned Loki streams.
+var lokiLabelNames = []string{
+	"namespace", "project", "project_uid", "environment", "environment_uid",
+	"component", "component_uid", "pod", "pod_id", "container", "level", "stream",
+}
+
+var (
+	lokiQueryPattern   = regexp.MustCompile(`^\{(.*)\}\s*(.*)$`)
+	lokiMatcherPattern = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*)\s*=\s*("(?:[^"\\]|\\.)*")\s*$`)
+	lokiFilterPattern  = regexp.MustCompile(`^\|=\s*("(?:[^"\\]|\\.)*")$`)
+)
+
+// lokiStream is a set of log lines sharing the same labels.
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// lokiQueryResponse is the response shape of Loki's query_range API for log queries.
+type lokiQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string       `json:"resultType"`
+		Result     []lokiStream `json:"result"`
+	} `json:"data"`
+}
+
+// LokiQueryRange implements GET /loki/api/v1/query_range.
+// It answers log queries from Grafana's Loki datasource in Loki's "streams" response shape.
+// Only a subset of LogQL is supported: a stream selector with equality matchers on the
+// labels in logLabelFilters (namespace is required), optionally followed by a single
+// `|= "text"` line filter. Errors are returned as plain text, as Loki does.
+func (h *LogsHandler) LokiQueryRange(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	params, err := parseLokiQuery(q.Get("query"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	params.EndTime = time.Now()
+	if v := q.Get("end"); v != "" {
+		if params.EndTime, err = parseLokiTime(v); err != nil {
+			http.Error(w, "invalid end: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	params.StartTime = params.EndTime.Add(-defaultQueryRange)
+	if v := q.Get("start"); v != "" {
+		if params.StartTime, err = parseLokiTime(v); err != nil {
+			http.Error(w, "invalid start: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if params.EndTime.Before(params.StartTime) {
+		http.Error(w, "end must not be before start", http.StatusBadRequest)
+		return
+	}
+	if v := q.Get("limit"); v != "" {
+		if params.Limit, err = strconv.Atoi(v); err != nil || params.Limit <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+	}
+	switch strings.ToLower(q.Get("direction")) {
+	case "", "backward":
+		params.SortOrder = "desc"
+	case "forward":
+		params.SortOrder = "asc"
+	default:
+		http.Error(w, "direction must be forward or backward", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.clientFor(r.Context()).GetComponentLogs(r.Context(), scopeToTenant(r.Context(), params))
+	if msg, ok := queryRejection(err); ok {
+		http.Error(w, msg, http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to query component logs",
+			slog.String("function", "LokiQueryRange"),
+			slog.String("namespace", params.Namespace),
+			slog.Any("error", err),
+		)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	var resp lokiQueryResponse
+	resp.Status = "success"
+	resp.Data.ResultType = "streams"
+	resp.Data.Result = toLokiStreams(result.Logs)
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// LokiLabels implements GET /loki/api/v1/labels, which Grafana also uses to test the datasource.
+func (h *LogsHandler) LokiLabels(w http.ResponseWriter, _ *http.Request) {
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": "success",
+		"data":   lokiLabelNames,
+	})
+}
+
+// parseLokiQuery translates the supported LogQL subset into component log filters.
+func parseLokiQuery(query string) (openobserve.ComponentLogsParams, error) {
+	var params openobserve.ComponentLogsParams
+
+	m := lokiQueryPattern.FindStringSubmatch(strings.TrimSpace(query))
+	if m == nil {
+		return params, fmt.Errorf("query must be a stream selector such as {namespace=\"default\"}")
+	}
+
+	for _, matcher := range strings.Split(m[1], ",") {
+		if strings.TrimSpace(matcher) == "" {
+			continue
+		}
+		mm := lokiMatcherPattern.FindStringSubmatch(matcher)
+		if mm == nil {
+			return params, fmt.Errorf("unsupported label matcher %q: only label=\"value\" is supported", strings.TrimSpace(matcher))
+		}
+		set, ok := logLabelFilters[mm[1]]
+		if !ok {
+			return params, fmt.Errorf("unsupported label %q in stream selector", mm[1])
+		}
+		value, err := strconv.Unquote(mm[2])
+		if err != nil {
+			return params, fmt.Errorf("invalid value for label %q: %w", mm[1], err)
+		}
+		set(&params, value)
+	}
+	if params.Namespace == "" {
+		return params, fmt.Errorf("the stream selector must match a namespace label")
+	}
+
+	if pipeline := strings.TrimSpace(m[2]); pipeline != "" {
+		fm := lokiFilterPattern.FindStringSubmatch(pipeline)
+		if fm == nil {
+			return params, fmt.Errorf("unsupported log pipeline %q: only a single |= \"text\" line filter is supported", pipeline)
+		}
+		phrase, err := strconv.Unquote(fm[1])
+		if err != nil {
+			return params, fmt.Errorf("invalid line filter: %w", err)
+		}
+		params.SearchPhrase = phrase
+		// Loki line filters match the exact case.
+		params.CaseSensitive = true
+	}
+	return params, nil
+}
+
+// parseLokiTime parses a Loki timestamp: nanoseconds since the epoch or RFC3339.
+func parseLokiTime(value string) (time.Time, error) {
+	if ns, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, ns), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be nanoseconds since the epoch or RFC3339")
+	}
+	return t, nil
+}
+
+// toLokiStreams groups log entries by their labels, keeping the order of the entries.
+func toLokiStreams(entries []openobserve.ComponentLogsEntry) []lokiStream {
+	streams := make([]lokiStream, 0)
+	index := make(map[string]int)
+	for _, entry := range entries {
+		labels := lokiLabels(entry)
+		key := lokiLabelsKey(labels)
+		i, ok := index[key]
+		if !ok {
+			i = len(streams)
+			index[key] = i
+			streams = append(streams, lokiStream{Stream: labels})
+		}
+		streams[i].Values = append(streams[i].Values, [2]string{
+			strconv.FormatInt(entry.Timestamp.UnixNano(), 10),
+			entry.Log,
+		})
+	}
+	return streams
+}
+
+// lokiLabels maps the Kubernetes metadata of an entry to Loki labels, omitting empty values.
+func lokiLabels(entry openobserve.ComponentLogsEntry) map[string]string {
+	values := map[string]string{
+		"namespace":       entry.Namespace,
+		"project":         entry.ProjectName,
+		"project_uid":     entry.ProjectUID,
+		"environment":     entry.EnvironmentName,
+		"environment_uid": entry.EnvironmentUID,
+		"component":       entry.ComponentName,
+		"component_uid":   entry.ComponentUID,
+		"pod":             entry.PodName,
+		"pod_id":          entry.PodID,
+		"container":       entry.ContainerName,
+		"level":           entry.LogLevel,
+		"stream":          entry.Stream,
+	}
+	labels := make(map[string]string, len(values))
+	for k, v := range values {
+		if v != "" {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+func lokiLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(labels[k]))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
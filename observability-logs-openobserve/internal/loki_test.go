@@ -0,0 +1,170 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestParseLokiQuery(t *testing.T) {
+	params, err := parseLokiQuery(`{namespace="default", component_uid="comp-1", level="ERROR", stream="stderr"} |= "timed out"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if params.Namespace != "default" || len(params.ComponentIDs) != 1 || params.ComponentIDs[0] != "comp-1" {
+		t.Errorf("unexpected selector filters: %+v", params)
+	}
+	if len(params.LogLevels) != 1 || params.LogLevels[0] != "ERROR" || params.Stream != "stderr" || params.SearchPhrase != "timed out" {
+		t.Errorf("unexpected level or line filter: %+v", params)
+	}
+
+	invalid := []string{
+		``,
+		`namespace="default"`,
+		`{component_uid="comp-1"}`,
+		`{namespace=~"def.*"}`,
+		`{namespace="default", app="x"}`,
+		`{namespace="default"} != "debug"`,
+		`{namespace="default"} |= "a" |= "b"`,
+		`{namespace="default"} | json`,
+	}
+	for _, query := range invalid {
+		if _, err := parseLokiQuery(query); err == nil {
+			t.Errorf("expected error for query %q", query)
+		}
+	}
+}
+
+func TestParseLokiTime(t *testing.T) {
+	want := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"1735689600000000000", "2025-01-01T00:00:00Z"} {
+		got, err := parseLokiTime(value)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", value, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseLokiTime(%q) = %v, want %v", value, got, want)
+		}
+	}
+	if _, err := parseLokiTime("yesterday"); err == nil {
+		t.Error("expected error for invalid time")
+	}
+}
+
+func TestToLokiStreams(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	streams := toLokiStreams([]openobserve.ComponentLogsEntry{
+		{Timestamp: ts, Log: "a", Namespace: "default", PodName: "pod-1", LogLevel: "INFO"},
+		{Timestamp: ts.Add(time.Second), Log: "b", Namespace: "default", PodName: "pod-2", LogLevel: "INFO"},
+		{Timestamp: ts.Add(2 * time.Second), Log: "c", Namespace: "default", PodName: "pod-1", LogLevel: "INFO"},
+	})
+
+	if len(streams) != 2 {
+		t.Fatalf("expected 2 streams, got %d", len(streams))
+	}
+	first := streams[0]
+	if first.Stream["pod"] != "pod-1" || first.Stream["level"] != "INFO" || first.Stream["namespace"] != "default" {
+		t.Errorf("unexpected labels: %v", first.Stream)
+	}
+	if _, ok := first.Stream["container"]; ok {
+		t.Error("expected empty labels to be omitted")
+	}
+	if len(first.Values) != 2 || first.Values[0] != [2]string{"1735689600000000000", "a"} || first.Values[1][1] != "c" {
+		t.Errorf("unexpected values: %v", first.Values)
+	}
+}
+
+func TestLokiQueryRange(t *testing.T) {
+	var gotSQL string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var query struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.Unmarshal(body, &query)
+		resp := openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(1)}}}
+		if !strings.Contains(query.Query.SQL, "count(*)") {
+			gotSQL = query.Query.SQL
+			resp.Hits = []map[string]interface{}{{
+				"_timestamp": float64(1735689600000000),
+				"log":        "request timed out",
+				"logLevel":   "ERROR",
+				"kubernetes_labels_openchoreo_dev_namespace": "default",
+				"kubernetes_labels_openchoreo_dev_component": "api",
+			}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	q := url.Values{}
+	q.Set("query", `{namespace="default"} |= "timed out"`)
+	q.Set("start", "1735686000000000000")
+	q.Set("end", "1735693200000000000")
+	q.Set("direction", "forward")
+	req := httptest.NewRequest(http.MethodGet, "/loki/api/v1/query_range?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	handler.LokiQueryRange(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(gotSQL, "log LIKE '%timed out%'") || !strings.Contains(gotSQL, "ASC") {
+		t.Errorf("expected line filter and forward order in SQL, got: %s", gotSQL)
+	}
+
+	var resp lokiQueryResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if resp.Status != "success" || resp.Data.ResultType != "streams" || len(resp.Data.Result) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	stream := resp.Data.Result[0]
+	if stream.Stream["component"] != "api" || stream.Values[0] != [2]string{"1735689600000000000", "request timed out"} {
+		t.Errorf("unexpected stream: %+v", stream)
+	}
+}
+
+func TestLokiQueryRange_BadRequest(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{"missing query", url.Values{}},
+		{"unsupported matcher", url.Values{"query": {`{namespace!="default"}`}}},
+		{"invalid start", url.Values{"query": {`{namespace="default"}`}, "start": {"soon"}}},
+		{"end before start", url.Values{"query": {`{namespace="default"}`}, "start": {"2000"}, "end": {"1000"}}},
+		{"invalid limit", url.Values{"query": {`{namespace="default"}`}, "limit": {"-1"}}},
+		{"invalid direction", url.Values{"query": {`{namespace="default"}`}, "direction": {"sideways"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/loki/api/v1/query_range?"+tt.query.Encode(), nil)
+			rec := httptest.NewRecorder()
+			handler.LokiQueryRange(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// longRunningRoutes lists the paths that stream their response and are therefore not
+// subject to the request timeout.
+var longRunningRoutes = map[string]bool{
+	"/api/v1/logs/stream":    true,
+	"/api/v1/logs/export":    true,
+	"/api/v1/logs/export/s3": true,
+}
+
+// isLongRunningRoute reports whether path streams its response: one of longRunningRoutes or
+// the log stream of an alert rule.
+func isLongRunningRoute(path string) bool {
+	if longRunningRoutes[path] {
+		return true
+	}
+	ruleName, ok := strings.CutPrefix(path, "/api/v1alpha1/alerts/rules/")
+	return ok && strings.HasSuffix(ruleName, "/stream") && strings.Count(ruleName, "/") == 1
+}
+
+// tooManyRequests is the title of 429 responses, which the generated API does not define.
+const tooManyRequests gen.ErrorResponseTitle = "tooManyRequests"
+
+// isQueryRoute reports whether path runs log queries against OpenObserve, and is therefore
+// subject to scan budgets.
+func isQueryRoute(path string) bool {
+	switch {
+	case strings.HasPrefix(path, "/loki/"):
+		return true
+	case strings.HasPrefix(path, resultsPath), strings.HasSuffix(path, "/cancel"):
+		return false
+	case strings.HasPrefix(path, "/api/v1/logs/templates"):
+		return strings.HasSuffix(path, "/run")
+	case strings.HasPrefix(path, "/api/v1/logs/"):
+		return true
+	}
+	ruleName, ok := strings.CutPrefix(path, "/api/v1alpha1/alerts/rules/")
+	return ok && (strings.HasSuffix(ruleName, "/stream") || strings.HasSuffix(ruleName, "/evidence"))
+}
+
+// scanBudgetMiddleware rejects the requests to query endpoints with 429 while the queries of
+// the organization serving the request, the tenant's own one if it has one, have used up their
+// scan budget (see openobserve.ScanBudgetError), with a Retry-After header announcing when
+// queries are accepted again. It must run inside tenantMiddleware.
+func scanBudgetMiddleware(next http.Handler, h *LogsHandler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var exhausted *openobserve.ScanBudgetError
+		if !isQueryRoute(r.URL.Path) || !errors.As(h.clientFor(r.Context()).CheckScanBudget(), &exhausted) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		logger.Warn("Rejected query over the organization's scan budget",
+			slog.String("path", r.URL.Path),
+			slog.String("org", exhausted.Org),
+			slog.Float64("usedMb", exhausted.UsedMB),
+			slog.Float64("budgetMb", exhausted.BudgetMB),
+			slog.String("clientIP", clientIPFromContext(r.Context())),
+		)
+		retryAfter := int(math.Ceil(time.Until(exhausted.ResetAt).Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusTooManyRequests)
+		if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+			Title:   ptr(tooManyRequests),
+			Message: ptr(exhausted.Error()),
+		}); err != nil {
+			logger.Error("Failed to write too many requests response", slog.Any("error", err))
+		}
+	})
+}
+
+// alertRoutesPrefix is the path prefix of the alert endpoints.
+const alertRoutesPrefix = "/api/v1alpha1/alerts"
+
+// alertsDisabledMiddleware rejects the requests to the alert endpoints with 403, so that a
+// read-only adapter can neither create nor delete alerts in OpenObserve.
+func alertsDisabledMiddleware(next http.Handler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != alertRoutesPrefix && !strings.HasPrefix(r.URL.Path, alertRoutesPrefix+"/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		logger.Debug("Rejected request to a disabled alert endpoint",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("clientIP", clientIPFromContext(r.Context())),
+		)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+			Title:   ptr(gen.Forbidden),
+			Message: ptr("alert endpoints are disabled"),
+		}); err != nil {
+			logger.Error("Failed to write forbidden response", slog.Any("error", err))
+		}
+	})
+}
+
+// gatewayTimeout is the title of 504 responses, which the generated API does not define.
+const gatewayTimeout gen.ErrorResponseTitle = "gatewayTimeout"
+
+// requestTimeoutMiddleware bounds the duration of each request, like http.TimeoutHandler. The
+// handler runs in its own goroutine with a context that expires after timeout, which cancels
+// any in-flight OpenObserve call, and its response is buffered. Once the deadline is exceeded,
+// 504 is returned right away and whatever the handler writes afterwards is discarded.
+func requestTimeoutMiddleware(next http.Handler, timeout time.Duration, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isLongRunningRoute(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		tw := &timeoutResponseWriter{buffered: bufferedResponseWriter{header: make(http.Header), status: http.StatusOK}}
+		done := make(chan struct{})
+		panicked := make(chan any, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicked <- p
+				}
+			}()
+			next.ServeHTTP(tw, r.WithContext(ctx))
+			close(done)
+		}()
+
+		select {
+		case p := <-panicked:
+			panic(p)
+		case <-done:
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			for k, v := range tw.buffered.header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(tw.buffered.status)
+			if _, err := w.Write(tw.buffered.body.Bytes()); err != nil {
+				logger.Error("Failed to write response", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			tw.timedOut = true
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				// The client went away; there is no one left to answer.
+				return
+			}
+			logger.Warn("Request exceeded the maximum duration",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("clientIP", clientIPFromContext(r.Context())),
+				slog.Duration("timeout", timeout),
+			)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusGatewayTimeout)
+			if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+				Title:   ptr(gatewayTimeout),
+				Message: ptr(fmt.Sprintf("request exceeded the maximum duration of %s", timeout)),
+			}); err != nil {
+				logger.Error("Failed to write timeout response", slog.Any("error", err))
+			}
+		}
+	})
+}
+
+// timeoutResponseWriter buffers the response of a handler run by requestTimeoutMiddleware,
+// and drops it once the request has timed out.
+type timeoutResponseWriter struct {
+	mu       sync.Mutex
+	buffered bufferedResponseWriter
+	timedOut bool
+}
+
+func (w *timeoutResponseWriter) Header() http.Header {
+	return w.buffered.Header()
+}
+
+func (w *timeoutResponseWriter) WriteHeader(status int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return
+	}
+	w.buffered.WriteHeader(status)
+}
+
+func (w *timeoutResponseWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	return w.buffered.Write(p)
+}
+
+// bufferedResponseWriter holds a response in memory until the handler has finished.
+type bufferedResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+}
+
+func (w *bufferedResponseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(p)
+}
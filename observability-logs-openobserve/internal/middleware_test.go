@@ -0,0 +1,250 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// slowHandler blocks until the request context is done or delay elapses, like a handler
+// waiting on OpenObserve.
+func slowHandler(delay time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			http.Error(w, "cancelled", http.StatusInternalServerError)
+		case <-time.After(delay):
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+}
+
+func TestRequestTimeoutMiddleware_Timeout(t *testing.T) {
+	handler := requestTimeoutMiddleware(slowHandler(time.Second), 20*time.Millisecond, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/query", nil)
+	rec := httptest.NewRecorder()
+	start := time.Now()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Errorf("expected 504, got %d", rec.Code)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the handler to be cancelled promptly, took %s", elapsed)
+	}
+}
+
+func TestRequestTimeoutMiddleware_AnswersAtTheDeadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	// The handler ignores its context, like a handler stuck on a call that cannot be cancelled.
+	handler := requestTimeoutMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte("late"))
+	}), 20*time.Millisecond, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/query", nil)
+	rec := httptest.NewRecorder()
+	start := time.Now()
+	handler.ServeHTTP(rec, req)
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected 504 at the deadline, took %s", elapsed)
+	}
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected 504, got %d", rec.Code)
+	}
+	var body map[string]string
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body["title"] != "gatewayTimeout" {
+		t.Errorf("expected the gatewayTimeout title, got %q", body["title"])
+	}
+}
+
+func TestRequestTimeoutMiddleware_PassThrough(t *testing.T) {
+	handler := requestTimeoutMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Test", "value")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("created"))
+	}), time.Second, testLogger())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/alerts/rules", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("expected 201, got %d", rec.Code)
+	}
+	if rec.Header().Get("X-Test") != "value" {
+		t.Errorf("expected handler headers to be preserved, got %v", rec.Header())
+	}
+	if rec.Body.String() != "created" {
+		t.Errorf("expected handler body to be preserved, got %q", rec.Body.String())
+	}
+}
+
+func TestRequestTimeoutMiddleware_SkipsLongRunningRoutes(t *testing.T) {
+	handler := requestTimeoutMiddleware(slowHandler(50*time.Millisecond), 10*time.Millisecond, testLogger())
+
+	for route := range longRunningRoutes {
+		req := httptest.NewRequest(http.MethodGet, route, nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: expected 200, got %d", route, rec.Code)
+		}
+	}
+}
+
+func TestIsLongRunningRoute(t *testing.T) {
+	tests := map[string]bool{
+		"/api/v1/logs/stream":                           true,
+		"/api/v1/logs/export/s3":                        true,
+		"/api/v1alpha1/alerts/rules/high-errors/stream": true,
+		"/api/v1alpha1/alerts/rules/high-errors":        false,
+		"/api/v1alpha1/alerts/rules/a/b/stream":         false,
+		"/api/v1/logs/query":                            false,
+	}
+	for path, want := range tests {
+		if got := isLongRunningRoute(path); got != want {
+			t.Errorf("isLongRunningRoute(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestNewServer_DisableAlerts(t *testing.T) {
+	srv := NewServerWithOptions("0", NewLogsHandler(nil, nil, testLogger()), ServerOptions{DisableAlerts: true}, testLogger())
+
+	for _, tt := range []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, "/api/v1alpha1/alerts/rules"},
+		{http.MethodDelete, "/api/v1alpha1/alerts/rules/my-rule"},
+		{http.MethodGet, "/api/v1alpha1/alerts/rules"},
+		{http.MethodPost, "/api/v1alpha1/alerts/rules/batch"},
+		{http.MethodPost, "/api/v1alpha1/alerts/webhook"},
+	} {
+		rec := httptest.NewRecorder()
+		srv.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("%s %s: expected 403, got %d", tt.method, tt.path, rec.Code)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if rec.Code == http.StatusForbidden {
+		t.Error("expected the other endpoints to be served")
+	}
+}
+
+func TestNewServer_EnforceScanBudgets(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{}, "total": 0, "scan_size": 80})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{ScanBudgets: map[string]float64{"default": 50}}, testLogger())
+	srv := NewServerWithOptions("0", NewLogsHandler(client, nil, testLogger()), ServerOptions{EnforceScanBudgets: true}, testLogger())
+	search := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		body := `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		srv.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+		return rec
+	}
+
+	if rec := search(); rec.Code != http.StatusOK {
+		t.Fatalf("expected the first search to run, got %d: %s", rec.Code, rec.Body.String())
+	}
+	rec := search()
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 over the scan budget, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After")); err != nil || retryAfter < 1 || retryAfter > 3600 {
+		t.Errorf("expected a Retry-After within the budget window, got %q", rec.Header().Get("Retry-After"))
+	}
+	if !strings.Contains(rec.Body.String(), "budget") {
+		t.Errorf("expected the message to explain the budget, got %s", rec.Body.String())
+	}
+
+	for _, path := range []string{"/health", "/api/v1/logs/templates", "/api/v1alpha1/alerts/rules"} {
+		rec := httptest.NewRecorder()
+		srv.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code == http.StatusTooManyRequests {
+			t.Errorf("GET %s: expected endpoints that do not query logs to be served", path)
+		}
+	}
+}
+
+func TestNewServer_EnforceScanBudgetsPerTenant(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{}, "total": 0, "scan_size": 80})
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClientWithOptions(ooServer.URL, "default", "default", "k8s_events", "admin", "pass",
+		openobserve.ClientOptions{ScanBudgets: map[string]float64{"payments": 50}}, testLogger())
+	srv := NewServerWithOptions("0", NewLogsHandler(client, nil, testLogger()), ServerOptions{
+		EnforceScanBudgets: true,
+		Tenants: TenantSettings{Tenants: map[string]Tenant{
+			"payments": {Org: "payments"},
+			"search":   {Org: "search"},
+		}},
+	}, testLogger())
+	search := func(tenant string) int {
+		rec := httptest.NewRecorder()
+		body := `{"namespace":"ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+		req.Header.Set(DefaultTenantHeader, tenant)
+		srv.httpServer.Handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := search("payments"); code != http.StatusOK {
+		t.Fatalf("expected the first search to run, got %d", code)
+	}
+	if code := search("payments"); code != http.StatusTooManyRequests {
+		t.Errorf("expected 429 over the tenant's scan budget, got %d", code)
+	}
+	if code := search("search"); code != http.StatusOK {
+		t.Errorf("expected another tenant's organization not to be limited, got %d", code)
+	}
+}
+
+func TestIsQueryRoute(t *testing.T) {
+	for path, want := range map[string]bool{
+		"/api/v1/logs/search":                    true,
+		"/api/v1/logs/query":                     true,
+		"/api/v1/logs/templates/errors/run":      true,
+		"/loki/api/v1/query_range":               true,
+		"/api/v1alpha1/alerts/rules/r1/evidence": true,
+		"/api/v1alpha1/alerts/rules/r1/stream":   true,
+		"/api/v1/logs/templates/errors":          false,
+		"/api/v1/logs/results/0123456789abcdef":  false,
+		"/api/v1/logs/0123456789abcdef/cancel":   false,
+		"/api/v1alpha1/alerts/rules/r1":          false,
+		"/api/v1/admin/logs/search":              false,
+		"/health":                                false,
+	} {
+		if got := isQueryRoute(path); got != want {
+			t.Errorf("isQueryRoute(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DeploymentEvent is a deployment of a component, such as a release or a rollback, shown as a
+// marker on a log timeline.
+type DeploymentEvent struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Type          string    `json:"type,omitempty"`
+	Message       string    `json:"message,omitempty"`
+	ComponentID   string    `json:"componentId,omitempty"`
+	EnvironmentID string    `json:"environmentId,omitempty"`
+}
+
+// DeploymentEventsQuery selects the deployment events of a log query's scope and time window.
+type DeploymentEventsQuery struct {
+	Namespace     string
+	ProjectID     string
+	EnvironmentID string
+	ComponentIDs  []string
+	StartTime     time.Time
+	EndTime       time.Time
+}
+
+// EventsClient fetches deployment events from an OpenChoreo (or compatible) events endpoint.
+// The endpoint is called with GET, the query as parameters (namespace, projectId,
+// environmentId, componentId repeated per component, and RFC3339 startTime and endTime), and
+// responds with {"events": [...]}.
+type EventsClient struct {
+	url        string
+	httpClient *http.Client
+}
+
+func NewEventsClient(url string) *EventsClient {
+	return &EventsClient{
+		url: url,
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+}
+
+// DeploymentEvents returns the deployment events matching query, oldest first.
+func (c *EventsClient) DeploymentEvents(ctx context.Context, query DeploymentEventsQuery) ([]DeploymentEvent, error) {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid events endpoint: %w", err)
+	}
+	values := u.Query()
+	values.Set("namespace", query.Namespace)
+	if query.ProjectID != "" {
+		values.Set("projectId", query.ProjectID)
+	}
+	if query.EnvironmentID != "" {
+		values.Set("environmentId", query.EnvironmentID)
+	}
+	for _, id := range query.ComponentIDs {
+		values.Add("componentId", id)
+	}
+	values.Set("startTime", query.StartTime.UTC().Format(time.RFC3339))
+	values.Set("endTime", query.EndTime.UTC().Format(time.RFC3339))
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call events endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("events endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var result struct {
+		Events []DeploymentEvent `json:"events"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode events response: %w", err)
+	}
+
+	// Keep only the events within the window, in case the endpoint ignores it.
+	events := make([]DeploymentEvent, 0, len(result.Events))
+	for _, event := range result.Events {
+		if event.Timestamp.Before(query.StartTime) || event.Timestamp.After(query.EndTime) {
+			continue
+		}
+		events = append(events, event)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+	return events, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDeploymentEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/events" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("source") != "deployments" || q.Get("namespace") != "ns" || q.Get("environmentId") != "env-1" ||
+			!reflect.DeepEqual(q["componentId"], []string{"c1", "c2"}) || q.Get("projectId") != "" ||
+			q.Get("startTime") != "2025-01-01T00:00:00Z" || q.Get("endTime") != "2025-01-02T00:00:00Z" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"events":[
+			{"timestamp":"2025-01-01T18:00:00Z","type":"RolledBack","componentId":"c1"},
+			{"timestamp":"2024-12-31T23:00:00Z","type":"Deployed","componentId":"c1"},
+			{"timestamp":"2025-01-01T06:00:00Z","type":"Deployed","message":"release r42","componentId":"c2"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewEventsClient(server.URL + "/events?source=deployments")
+	events, err := client.DeploymentEvents(context.Background(), DeploymentEventsQuery{
+		Namespace:     "ns",
+		EnvironmentID: "env-1",
+		ComponentIDs:  []string{"c1", "c2"},
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []DeploymentEvent{
+		{Timestamp: time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC), Type: "Deployed", Message: "release r42", ComponentID: "c2"},
+		{Timestamp: time.Date(2025, 1, 1, 18, 0, 0, 0, time.UTC), Type: "RolledBack", ComponentID: "c1"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("expected the events of the window oldest first, got %+v", events)
+	}
+}
+
+func TestDeploymentEvents_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewEventsClient(server.URL).DeploymentEvents(context.Background(), DeploymentEventsQuery{Namespace: "ns"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The helpers below parse the hits of aggregation queries, which OpenObserve returns as
+// generic JSON objects, into the typed results of this package, as parseApplicationLogEntry
+// does for log hits. Missing or malformed values are left at their zero value.
+
+// countField returns the count stored under key in hit. OpenObserve returns counts as JSON
+// numbers, or as strings in some versions.
+func countField(hit map[string]interface{}, key string) int {
+	switch v := hit[key].(type) {
+	case float64:
+		return int(v)
+	case string:
+		if n, ok := parseCount(strings.TrimSpace(v)); ok {
+			return n
+		}
+	}
+	return 0
+}
+
+// floatField returns the number stored under key in hit, and whether there is one.
+func floatField(hit map[string]interface{}, key string) (float64, bool) {
+	switch v := hit[key].(type) {
+	case float64:
+		return v, true
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// timeField returns the microsecond timestamp stored under key in hit, or the zero time if
+// there is none.
+func timeField(hit map[string]interface{}, key string) time.Time {
+	micros := timestampMicros(hit[key])
+	if micros == 0 {
+		return time.Time{}
+	}
+	return time.UnixMicro(micros)
+}
+
+// parseHistogramBucketHit parses a hit of a histogram query.
+func parseHistogramBucketHit(hit map[string]interface{}) LogHistogramBucket {
+	return LogHistogramBucket{
+		Start: parseHistogramBucket(hit["bucket"]),
+		Count: countField(hit, "total"),
+	}
+}
+
+// parseComponentLogVolumeHit parses a hit of a component log volume query.
+func parseComponentLogVolumeHit(hit map[string]interface{}) ComponentLogVolume {
+	return ComponentLogVolume{
+		ComponentUID:  stringField(hit, "component_uid"),
+		ComponentName: stringField(hit, "component_name"),
+		Count:         countField(hit, "total"),
+	}
+}
+
+// parseComponentPercentilesHit parses a hit of a component percentiles query for the given
+// percentiles.
+func parseComponentPercentilesHit(hit map[string]interface{}, percentiles []float64) ComponentPercentiles {
+	component := ComponentPercentiles{
+		ComponentUID:  stringField(hit, "component_uid"),
+		ComponentName: stringField(hit, "component_name"),
+		Count:         countField(hit, "total"),
+		Percentiles:   make(map[string]float64, len(percentiles)),
+	}
+	for _, p := range percentiles {
+		alias := percentileAlias(p)
+		if v, ok := floatField(hit, alias); ok {
+			component.Percentiles[alias] = v
+		}
+	}
+	return component
+}
+
+// parseDistinctLogMessageHit parses a hit of a distinct log messages query.
+func parseDistinctLogMessageHit(hit map[string]interface{}) DistinctLogMessage {
+	return DistinctLogMessage{
+		Log:      stringField(hit, "log"),
+		Count:    countField(hit, "total"),
+		LastSeen: timeField(hit, "last_seen"),
+	}
+}
+
+// parseComponentPodHit parses a hit of a component pods query.
+func parseComponentPodHit(hit map[string]interface{}) ComponentPod {
+	return ComponentPod{
+		PodID:    stringField(hit, "pod_id"),
+		PodName:  stringField(hit, "pod_name"),
+		Count:    countField(hit, "total"),
+		LastSeen: timeField(hit, "last_seen"),
+	}
+}
+
+// parseLogGroupHit parses a hit of the grouped aggregation agg. The value is only set for
+// the functions other than count.
+func parseLogGroupHit(hit map[string]interface{}, agg GroupedAggregation) LogGroup {
+	group := LogGroup{
+		Keys:  make(map[string]string, len(agg.GroupBy)),
+		Count: countField(hit, "total"),
+	}
+	for i, field := range agg.GroupBy {
+		value, _ := scalarString(hit[groupAlias(i)])
+		group.Keys[field] = value
+	}
+	if value, ok := floatField(hit, "value"); ok && agg.Function != AggregateCount {
+		group.Value = &value
+	}
+	return group
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCountField(t *testing.T) {
+	tests := []struct {
+		name string
+		hit  map[string]interface{}
+		want int
+	}{
+		{"number", map[string]interface{}{"total": float64(42)}, 42},
+		{"string", map[string]interface{}{"total": " 42 "}, 42},
+		{"float string", map[string]interface{}{"total": "42.0"}, 42},
+		{"malformed", map[string]interface{}{"total": "many"}, 0},
+		{"missing", map[string]interface{}{}, 0},
+	}
+	for _, tt := range tests {
+		if got := countField(tt.hit, "total"); got != tt.want {
+			t.Errorf("%s: countField() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseHistogramBucketHit(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	bucket := parseHistogramBucketHit(map[string]interface{}{"bucket": "2025-01-01T12:00:00", "total": "7"})
+	if !bucket.Start.Equal(start) || bucket.Count != 7 {
+		t.Errorf("unexpected bucket %+v", bucket)
+	}
+}
+
+func TestParseDistinctLogMessageHit(t *testing.T) {
+	lastSeen := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	message := parseDistinctLogMessageHit(map[string]interface{}{
+		"log":       "connection refused",
+		"total":     float64(3),
+		"last_seen": float64(lastSeen.UnixMicro()),
+	})
+	if message.Log != "connection refused" || message.Count != 3 || !message.LastSeen.Equal(lastSeen) {
+		t.Errorf("unexpected message %+v", message)
+	}
+
+	// A missing last_seen is left unset rather than parsed as the Unix epoch.
+	if message := parseDistinctLogMessageHit(map[string]interface{}{"log": "x"}); !message.LastSeen.IsZero() {
+		t.Errorf("expected a zero last seen time, got %v", message.LastSeen)
+	}
+}
+
+func TestParseComponentPercentilesHit(t *testing.T) {
+	component := parseComponentPercentilesHit(map[string]interface{}{
+		"component_uid":  "comp-1",
+		"component_name": "checkout",
+		"total":          float64(10),
+		"p50":            float64(12.5),
+		"p99":            "250",
+	}, []float64{0.5, 0.95, 0.99})
+	if component.ComponentUID != "comp-1" || component.ComponentName != "checkout" || component.Count != 10 {
+		t.Errorf("unexpected component %+v", component)
+	}
+	if component.Percentiles["p50"] != 12.5 || component.Percentiles["p99"] != 250 {
+		t.Errorf("unexpected percentiles %v", component.Percentiles)
+	}
+	if _, ok := component.Percentiles["p95"]; ok {
+		t.Error("expected a missing percentile to be left out")
+	}
+}
+
+func TestParseLogGroupHit(t *testing.T) {
+	hit := map[string]interface{}{
+		groupAlias(0): "checkout",
+		groupAlias(1): "ERROR",
+		"total":       float64(4),
+		"value":       float64(1.5),
+	}
+
+	group := parseLogGroupHit(hit, GroupedAggregation{GroupBy: []string{"component", "logLevel"}, Function: AggregateAvg, Field: "latency"})
+	if group.Keys["component"] != "checkout" || group.Keys["logLevel"] != "ERROR" || group.Count != 4 {
+		t.Errorf("unexpected group %+v", group)
+	}
+	if group.Value == nil || *group.Value != 1.5 {
+		t.Errorf("expected the aggregated value, got %v", group.Value)
+	}
+
+	if group := parseLogGroupHit(hit, GroupedAggregation{GroupBy: []string{"component"}, Function: AggregateCount}); group.Value != nil {
+		t.Errorf("expected no value for a count aggregation, got %v", *group.Value)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// AlertQueryError is returned by CheckAlertQuery when OpenObserve rejects the search of an
+// alert, with the reason it gave.
+type AlertQueryError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *AlertQueryError) Error() string {
+	return fmt.Sprintf("openobserve rejected the alert query (status %d): %s", e.StatusCode, e.Message)
+}
+
+// regexLikeSyntax lists regular expression constructs that are unlikely to appear in a
+// literal search pattern, which suggest that the author expected the pattern to be a regex.
+var regexLikeSyntax = []string{".*", ".+", `\d`, `\w`, `\s`, `\b`, "(?", "[0-9]", "[a-z]"}
+
+// CheckSearchPattern checks an alert search pattern before the alert is saved. Alerts match
+// the pattern as a literal substring of the log line (str_match), so regular expression
+// syntax is not interpreted. It returns the problems that make the pattern unusable and
+// warnings about patterns that are accepted but are unlikely to match as intended.
+func CheckSearchPattern(pattern string) (problems, warnings []string) {
+	if strings.TrimSpace(pattern) == "" {
+		return []string{"source.query must not be empty"}, nil
+	}
+	// The pattern of a saved alert is recovered from its SQL, so it must survive escaping.
+	if got := ExtractSearchPattern(alertConditions(LogAlertParams{SearchPattern: pattern})); got != pattern {
+		problems = append(problems, fmt.Sprintf("source.query does not survive SQL escaping: read back as %q", got))
+	}
+	if strings.TrimSpace(pattern) != pattern {
+		warnings = append(warnings, "source.query has leading or trailing whitespace, which must also match")
+	}
+	if strings.IndexFunc(pattern, unicode.IsControl) >= 0 {
+		warnings = append(warnings, "source.query contains control characters such as newlines, which single-line logs never match")
+	}
+	for _, syntax := range regexLikeSyntax {
+		if strings.Contains(pattern, syntax) {
+			warnings = append(warnings, fmt.Sprintf("source.query is matched literally; regular expression syntax such as %q is not interpreted", syntax))
+			break
+		}
+	}
+	return problems, warnings
+}
+
+// AlertQuery returns the SQL query the alert described by params runs on every evaluation.
+func (c *Client) AlertQuery(params LogAlertParams) string {
+	return alertQuery(c.withMessageField(params), c.stream)
+}
+
+// CheckAlertQuery runs the search of the alert described by params over its evaluation
+// window with a size of zero, so that OpenObserve parses and plans it without returning
+// logs. A query OpenObserve rejects is reported as an *AlertQueryError. params must have
+// passed Validate.
+func (c *Client) CheckAlertQuery(ctx context.Context, params LogAlertParams) error {
+	minutes, err := parseDurationMinutes(params.Window)
+	if err != nil {
+		return fmt.Errorf("invalid alert window: %w", err)
+	}
+	endTime := time.Now()
+	startTime := endTime.Add(-time.Duration(minutes) * time.Minute)
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        alertQuery(c.withMessageField(params), c.stream),
+			"start_time": startTime.UnixMicro(),
+			"end_time":   endTime.UnixMicro(),
+			"from":       0,
+			"size":       0,
+		},
+		"timeout": 0,
+	}
+	if c.logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated alert check query for %s:\n", c.stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to marshal alert check query: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/%s/_search", c.baseURL, c.org)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(queryJSON))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.user, c.token)
+
+	resp, err := c.doWithRetry(req, c.queryRetry)
+	if err != nil {
+		c.logger.Error("Failed to execute alert check request against OpenObserve", slog.Any("error", err))
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusBadRequest:
+		return &AlertQueryError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+	default:
+		c.logger.Error("OpenObserve returned error",
+			slog.Int("statusCode", resp.StatusCode),
+			slog.String("body", string(body)))
+		return fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
+	}
+}
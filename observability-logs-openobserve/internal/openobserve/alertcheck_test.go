@@ -0,0 +1,96 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckSearchPattern(t *testing.T) {
+	tests := []struct {
+		name         string
+		pattern      string
+		wantProblems int
+		wantWarning  string
+	}{
+		{"plain", "connection refused", 0, ""},
+		{"quotes and backslashes", `it's a C:\path\`, 0, ""},
+		{"empty", "", 1, ""},
+		{"blank", "   ", 1, ""},
+		{"surrounding whitespace", " timeout ", 0, "whitespace"},
+		{"newline", "line one\nline two", 0, "control characters"},
+		{"regex", "error.*timeout", 0, "matched literally"},
+		{"regex class", `status \d+`, 0, "matched literally"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, warnings := CheckSearchPattern(tt.pattern)
+			if len(problems) != tt.wantProblems {
+				t.Errorf("expected %d problems, got %v", tt.wantProblems, problems)
+			}
+			if tt.wantWarning == "" {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning) {
+				t.Errorf("expected a warning containing %q, got %v", tt.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestCheckAlertQuery(t *testing.T) {
+	var query map[string]interface{}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query map[string]interface{} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		query = body.Query
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			json.NewEncoder(w).Encode(OpenObserveResponse{})
+			return
+		}
+		w.Write([]byte(`{"code":400,"message":"Search SQL not supported"}`))
+	}))
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	params := LogAlertParams{SearchPattern: "panic", EnvironmentUID: "env-1", ComponentUID: "comp-1", Window: "10m"}
+	if err := client.CheckAlertQuery(context.Background(), params); err != nil {
+		t.Fatalf("CheckAlertQuery() error = %v", err)
+	}
+	if query["size"] != float64(0) {
+		t.Errorf("expected a zero-size query, got size %v", query["size"])
+	}
+	if query["sql"] != client.AlertQuery(params) {
+		t.Errorf("expected the alert query, got %v", query["sql"])
+	}
+	if window := query["end_time"].(float64) - query["start_time"].(float64); window != 10*60*1e6 {
+		t.Errorf("expected the alert's 10m window, got %vµs", window)
+	}
+
+	status = http.StatusBadRequest
+	err := client.CheckAlertQuery(context.Background(), params)
+	var rejected *AlertQueryError
+	if !errors.As(err, &rejected) || !strings.Contains(rejected.Message, "Search SQL not supported") {
+		t.Fatalf("expected an AlertQueryError, got %v", err)
+	}
+
+	status = http.StatusInternalServerError
+	err = client.CheckAlertQuery(context.Background(), params)
+	if err == nil || errors.As(err, &rejected) {
+		t.Fatalf("expected a plain error for a server failure, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import "testing"
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"plain line", "plain line"},
+		{"\x1b[31mERROR\x1b[0m failed", "ERROR failed"},
+		{"\x1b[1;32m[INFO]\x1b[m ready", "[INFO] ready"},
+		{"\x1b[2K\x1b[1Gprogress 50%", "progress 50%"},
+		{"\x1b]8;;https://example.com\x07link\x1b]8;;\x07", "link"},
+		{"\x1b]0;title\x1b\\text", "text"},
+		{"\x1bMup", "up"},
+	}
+	for _, tt := range tests {
+		if got := stripANSI(tt.in); got != tt.want {
+			t.Errorf("stripANSI(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseApplicationLogEntry_StripANSI(t *testing.T) {
+	source := map[string]interface{}{"log": "\x1b[31mERROR\x1b[0m connection refused"}
+
+	entry := newTestClient("http://localhost").parseApplicationLogEntry(source)
+	if entry.Log != source["log"] || entry.RawLog != "" {
+		t.Errorf("expected the line to be kept by default, got %+v", entry)
+	}
+
+	client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{StripANSI: true}, testLogger())
+	entry = client.parseApplicationLogEntry(source)
+	if entry.Log != "ERROR connection refused" || entry.RawLog != source["log"] {
+		t.Errorf("expected the stripped line with the raw one, got %+v", entry)
+	}
+	if entry.LogLevel != "ERROR" {
+		t.Errorf("expected the level to be detected in the stripped line, got %q", entry.LogLevel)
+	}
+
+	entry = client.parseApplicationLogEntry(map[string]interface{}{"log": "plain"})
+	if entry.Log != "plain" || entry.RawLog != "" {
+		t.Errorf("expected no raw line when nothing was stripped, got %+v", entry)
+	}
+}
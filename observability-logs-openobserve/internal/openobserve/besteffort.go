@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// DefaultBestEffortTimeout is the OpenObserve query timeout of best-effort component log
+// queries when ClientOptions.BestEffortTimeout is not set.
+const DefaultBestEffortTimeout = 5 * time.Second
+
+// bestEffortGrace is how long a best-effort query may take beyond its OpenObserve timeout
+// before the adapter stops waiting for it, leaving OpenObserve time to return the partial
+// result it collected. It is a variable so that tests can shorten it.
+var bestEffortGrace = 2 * time.Second
+
+// queryTimeoutSeconds returns the OpenObserve query timeout of params in whole seconds,
+// rounded up, or zero for no timeout.
+func (p ComponentLogsParams) queryTimeoutSeconds() int {
+	if p.queryTimeout <= 0 {
+		return 0
+	}
+	return int(math.Ceil(p.queryTimeout.Seconds()))
+}
+
+// withBestEffortDeadline bounds ctx by the timeout of a best-effort query, so that the query
+// gives up waiting for OpenObserve shortly after OpenObserve's own timeout.
+func withBestEffortDeadline(ctx context.Context, params ComponentLogsParams) (context.Context, context.CancelFunc) {
+	if !params.BestEffort || params.queryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, params.queryTimeout+bestEffortGrace)
+}
+
+// bestEffortTimedOut reports whether err is the deadline of a best-effort query (see
+// withBestEffortDeadline) rather than a failure or a cancellation by the caller, whose own
+// context is parent.
+func bestEffortTimedOut(parent context.Context, params ComponentLogsParams, err error) bool {
+	return params.BestEffort && errors.Is(err, context.DeadlineExceeded) && parent.Err() == nil
+}
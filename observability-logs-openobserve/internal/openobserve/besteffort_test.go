@@ -0,0 +1,115 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetComponentLogs_BestEffortPartial(t *testing.T) {
+	var timeouts []interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := isCountQuery(r)
+		body, _ := io.ReadAll(r.Body)
+		var query map[string]interface{}
+		json.Unmarshal(body, &query)
+		timeouts = append(timeouts, query["timeout"])
+		w.Header().Set("Content-Type", "application/json")
+		if count {
+			w.Write([]byte(`{"took":1,"hits":[{"total":1200}],"is_partial":true}`))
+			return
+		}
+		w.Write([]byte(`{"took":3000,"hits":[{"_timestamp":1735689600000000,"log":"slow"}],"is_partial":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{BestEffortTimeout: 1500 * time.Millisecond}, testLogger())
+	params := ComponentLogsParams{
+		Namespace:  "test-ns",
+		StartTime:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		BestEffort: true,
+	}
+	result, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Partial || len(result.Logs) != 1 || result.TotalCount != 1200 {
+		t.Errorf("expected a partial result, got %+v", result)
+	}
+	// The timeout is rounded up to whole seconds.
+	if len(timeouts) != 2 || timeouts[0] != float64(2) || timeouts[1] != float64(2) {
+		t.Errorf("expected a query timeout of 2s on both queries, got %v", timeouts)
+	}
+}
+
+func TestGetComponentLogs_BestEffortTimeout(t *testing.T) {
+	defer func(grace time.Duration) { bestEffortGrace = grace }(bestEffortGrace)
+	bestEffortGrace = 0
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{BestEffortTimeout: 50 * time.Millisecond}, testLogger())
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	params.BestEffort = true
+	result, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("expected a partial result instead of an error, got %v", err)
+	}
+	if !result.Partial || len(result.Logs) != 0 || result.TotalCount != 0 {
+		t.Errorf("expected an empty partial result, got %+v", result)
+	}
+
+	// A strict query fails when its caller gives up.
+	params.BestEffort = false
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetComponentLogs(ctx, params); err == nil {
+		t.Error("expected an error for a strict query, got nil")
+	}
+}
+
+func TestGenerateComponentLogsQuery_NoTimeoutByDefault(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	for name, generate := range map[string]func(ComponentLogsParams, string, *slog.Logger) ([]byte, error){
+		"logs":  generateComponentLogsQuery,
+		"count": generateComponentLogsCountQuery,
+	} {
+		raw, err := generate(params, "default", testLogger())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		var query map[string]interface{}
+		json.Unmarshal(raw, &query)
+		if timeout, ok := query["timeout"]; ok && timeout != float64(0) {
+			t.Errorf("%s: expected no query timeout, got %v", name, timeout)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultScanBudgetWindow is the rolling window over which the data scanned by the queries of
+// an organization is counted against its scan budget.
+const DefaultScanBudgetWindow = time.Hour
+
+// ScanBudgetDefault is the ScanBudgets key setting the budget of the organizations not listed.
+const ScanBudgetDefault = "*"
+
+// ScanBudgetError reports that the queries of an organization scanned its whole budget within
+// the rolling window. Queries are accepted again once enough scans have left the window.
+type ScanBudgetError struct {
+	Org      string
+	UsedMB   float64
+	BudgetMB float64
+	Window   time.Duration
+	ResetAt  time.Time
+}
+
+func (e *ScanBudgetError) Error() string {
+	return fmt.Sprintf("organization %q scanned %.1f MB of its %.1f MB budget in the last %s; queries are accepted again at %s",
+		e.Org, e.UsedMB, e.BudgetMB, e.Window, e.ResetAt.UTC().Format(time.RFC3339))
+}
+
+// ParseScanBudgets parses a comma-separated list of org=MB pairs into the scan budgets of
+// organizations (e.g. "default=10240,team-a=2048"). The "*" organization sets the budget of
+// the organizations not listed.
+func ParseScanBudgets(value string) (map[string]float64, error) {
+	budgets := make(map[string]float64)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		org, size, ok := strings.Cut(pair, "=")
+		org, size = strings.TrimSpace(org), strings.TrimSpace(size)
+		if !ok || org == "" {
+			return nil, fmt.Errorf("invalid scan budget %q: expected org=MB", pair)
+		}
+		budget, err := strconv.ParseFloat(size, 64)
+		if err != nil || budget <= 0 {
+			return nil, fmt.Errorf("invalid scan budget for %s: must be a positive number of MB, got %q", org, size)
+		}
+		if _, dup := budgets[org]; dup {
+			return nil, fmt.Errorf("scan budget of %s is set more than once", org)
+		}
+		budgets[org] = budget
+	}
+	return budgets, nil
+}
+
+// scanRecord is the data scanned by one query, in MB, as reported by OpenObserve.
+type scanRecord struct {
+	at     time.Time
+	sizeMB float64
+}
+
+// scanBudgets counts the data scanned by the queries of each organization within a rolling
+// window. It is shared by the clients of every organization (see Client.ForOrg).
+type scanBudgets struct {
+	budgets map[string]float64
+	window  time.Duration
+
+	mu    sync.Mutex
+	scans map[string][]scanRecord
+}
+
+// newScanBudgets returns the scan budgets for budgets, in MB by organization, or nil if there
+// are none, which disables accounting.
+func newScanBudgets(budgets map[string]float64, window time.Duration) *scanBudgets {
+	if len(budgets) == 0 {
+		return nil
+	}
+	if window <= 0 {
+		window = DefaultScanBudgetWindow
+	}
+	return &scanBudgets{budgets: budgets, window: window, scans: make(map[string][]scanRecord)}
+}
+
+// budget returns the budget of org in MB, or false if its queries are not limited.
+func (b *scanBudgets) budget(org string) (float64, bool) {
+	if budget, ok := b.budgets[org]; ok {
+		return budget, true
+	}
+	budget, ok := b.budgets[ScanBudgetDefault]
+	return budget, ok
+}
+
+// prune drops the scans of org that left the window by now and returns the others, oldest
+// first. The caller must hold b.mu.
+func (b *scanBudgets) prune(org string, now time.Time) []scanRecord {
+	scans := b.scans[org]
+	i := 0
+	for i < len(scans) && !scans[i].at.After(now.Add(-b.window)) {
+		i++
+	}
+	scans = scans[i:]
+	if len(scans) == 0 {
+		delete(b.scans, org)
+	} else {
+		b.scans[org] = scans
+	}
+	return scans
+}
+
+// record counts a query of org that scanned sizeMB at now.
+func (b *scanBudgets) record(org string, sizeMB float64, now time.Time) {
+	if sizeMB <= 0 {
+		return
+	}
+	if _, ok := b.budget(org); !ok {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.prune(org, now)
+	b.scans[org] = append(b.scans[org], scanRecord{at: now, sizeMB: sizeMB})
+}
+
+// check returns a *ScanBudgetError if the queries of org scanned its whole budget within the
+// window ending at now.
+func (b *scanBudgets) check(org string, now time.Time) error {
+	budget, ok := b.budget(org)
+	if !ok {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	scans := b.prune(org, now)
+	used := 0.0
+	for _, scan := range scans {
+		used += scan.sizeMB
+	}
+	if used < budget {
+		return nil
+	}
+	// The budget frees up once the oldest scans, enough to go below it, leave the window.
+	resetAt, remaining := now, used
+	for _, scan := range scans {
+		remaining -= scan.sizeMB
+		resetAt = scan.at.Add(b.window)
+		if remaining < budget {
+			break
+		}
+	}
+	return &ScanBudgetError{Org: org, UsedMB: used, BudgetMB: budget, Window: b.window, ResetAt: resetAt}
+}
+
+// CheckScanBudget returns a *ScanBudgetError if the queries of the client's organization
+// scanned its whole budget (see ClientOptions.ScanBudgets) within the rolling window. Queries
+// are counted once they complete, so a query admitted below the budget may exceed it.
+func (c *Client) CheckScanBudget() error {
+	if c.scanBudgets == nil {
+		return nil
+	}
+	return c.scanBudgets.check(c.org, time.Now())
+}
+
+// recordScan counts the data scanned by a completed query against the organization's budget.
+func (c *Client) recordScan(resp *OpenObserveResponse) {
+	if c.scanBudgets == nil {
+		return
+	}
+	c.scanBudgets.record(c.org, resp.ScanSize, time.Now())
+	c.logger.Debug("Counted query scan against the organization's budget",
+		slog.String("org", c.org),
+		slog.Float64("scanSizeMb", resp.ScanSize),
+	)
+}
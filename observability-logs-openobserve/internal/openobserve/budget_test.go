@@ -0,0 +1,110 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseScanBudgets(t *testing.T) {
+	budgets, err := ParseScanBudgets(" default=10240, team-a=2048.5,*=100 ")
+	if err != nil {
+		t.Fatalf("ParseScanBudgets() error = %v", err)
+	}
+	if len(budgets) != 3 || budgets["default"] != 10240 || budgets["team-a"] != 2048.5 || budgets[ScanBudgetDefault] != 100 {
+		t.Errorf("unexpected budgets %v", budgets)
+	}
+	if budgets, err := ParseScanBudgets(""); err != nil || len(budgets) != 0 {
+		t.Errorf("expected no budgets, got %v, %v", budgets, err)
+	}
+
+	for _, value := range []string{"default", "=10", "default=0", "default=-1", "default=lots", "a=1,a=2"} {
+		if _, err := ParseScanBudgets(value); err == nil {
+			t.Errorf("ParseScanBudgets(%q): expected an error", value)
+		}
+	}
+}
+
+func TestScanBudgets(t *testing.T) {
+	budgets := newScanBudgets(map[string]float64{"team-a": 100, ScanBudgetDefault: 50}, time.Hour)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	budgets.record("team-a", 40, start)
+	budgets.record("team-a", 40, start.Add(10*time.Minute))
+	if err := budgets.check("team-a", start.Add(20*time.Minute)); err != nil {
+		t.Fatalf("expected team-a to be under its budget, got %v", err)
+	}
+	budgets.record("team-a", 30, start.Add(20*time.Minute))
+
+	err := budgets.check("team-a", start.Add(30*time.Minute))
+	var exhausted *ScanBudgetError
+	if !errors.As(err, &exhausted) {
+		t.Fatalf("expected a ScanBudgetError, got %v", err)
+	}
+	if exhausted.UsedMB != 110 || exhausted.BudgetMB != 100 {
+		t.Errorf("unexpected usage %+v", exhausted)
+	}
+	// Dropping the first scan brings the usage to 70 MB, under the budget.
+	if want := start.Add(time.Hour); !exhausted.ResetAt.Equal(want) {
+		t.Errorf("expected the budget to reset at %v, got %v", want, exhausted.ResetAt)
+	}
+	if err := budgets.check("team-a", start.Add(time.Hour+time.Second)); err != nil {
+		t.Errorf("expected the budget to be available after the reset, got %v", err)
+	}
+
+	// Other organizations get the default budget.
+	budgets.record("team-b", 60, start)
+	if err := budgets.check("team-b", start.Add(time.Minute)); err == nil {
+		t.Error("expected team-b to be over the default budget")
+	}
+
+	unlimited := newScanBudgets(map[string]float64{"team-a": 100}, time.Hour)
+	unlimited.record("team-b", 1000, start)
+	if err := unlimited.check("team-b", start); err != nil {
+		t.Errorf("expected an organization without a budget to be unlimited, got %v", err)
+	}
+}
+
+func TestClient_ScanBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"hits": []interface{}{}, "total": 0, "scan_size": 30})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{ScanBudgets: map[string]float64{"default": 100}}, testLogger())
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+		Limit:     10,
+	}
+	// Each call runs a search and a count query, scanning 60 MB.
+	for i := 0; i < 2; i++ {
+		if err := client.CheckScanBudget(); err != nil {
+			t.Fatalf("query %d: expected the budget to be available, got %v", i, err)
+		}
+		if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+			t.Fatalf("GetComponentLogs() error = %v", err)
+		}
+	}
+	var exhausted *ScanBudgetError
+	if err := client.CheckScanBudget(); !errors.As(err, &exhausted) || exhausted.Org != "default" || exhausted.UsedMB != 120 {
+		t.Errorf("expected the budget to be exhausted, got %v", err)
+	}
+
+	// Organizations searched by the same client share the accounting.
+	if err := client.ForOrg("other").CheckScanBudget(); err != nil {
+		t.Errorf("expected an organization without a budget to be unlimited, got %v", err)
+	}
+	if err := client.ForOrg("default").CheckScanBudget(); err == nil {
+		t.Error("expected a client for the same organization to see the exhausted budget")
+	}
+}
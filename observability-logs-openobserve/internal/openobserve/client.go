@@ -7,29 +7,40 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// ErrAlertAlreadyExists is returned by CreateAlert when OpenObserve already has an alert
+// with the requested name.
+var ErrAlertAlreadyExists = errors.New("alert already exists")
+
+// isAlreadyExistsResponse reports whether an OpenObserve error response indicates that the
+// resource being created already exists.
+func isAlreadyExistsResponse(statusCode int, body []byte) bool {
+	return statusCode == http.StatusConflict || strings.Contains(strings.ToLower(string(body)), "already exists")
+}
+
 // extractLogLevel extracts log level from log content using common patterns.
 func extractLogLevel(log string) string {
 	upper := strings.ToUpper(log)
 
-	levels := []string{"ERROR", "FATAL", "SEVERE", "WARN", "WARNING", "INFO", "DEBUG"}
-	for _, level := range levels {
-		if strings.Contains(upper, level) {
-			if level == "WARNING" {
-				return "WARN"
-			}
-			return level
+	for _, l := range messageLevels {
+		if strings.Contains(upper, l.keyword) {
+			return l.level
 		}
 	}
 
-	return "INFO"
+	return defaultLogLevel
 }
 
 // ComponentLogsParams holds parameters for component log queries.
@@ -44,6 +55,119 @@ type ComponentLogsParams struct {
 	LogLevels     []string  `json:"logLevels"`
 	Limit         int       `json:"limit"`
 	SortOrder     string    `json:"sortOrder"`
+	// SortField orders the returned logs by one of the sort fields (see SortFieldLevel),
+	// in SortOrder and by timestamp among equal values. Which logs are returned is still
+	// decided by their timestamps, so cursors and indexes are unaffected. Empty orders them
+	// by timestamp.
+	SortField string `json:"sortField,omitempty"`
+	// ExcludePhrases drops the logs containing any of the given phrases, e.g. known-noisy
+	// errors, from the logs matching the other filters, including SearchPhrase.
+	ExcludePhrases []string `json:"excludePhrases,omitempty"`
+	// CaseSensitive matches SearchPhrase and ExcludePhrases with their exact case. By default
+	// they are matched case-insensitively.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
+	// AtTimestamp, when set, pins the query to logs at this timestamp (in microseconds),
+	// replacing StartTime and EndTime with a small window around it.
+	AtTimestamp int64 `json:"atTimestamp,omitempty"`
+	// AtTimestamps, when set, fetches the logs at each of these timestamps (in microseconds)
+	// in a single query, e.g. the lines behind a set of alert triggers. Each timestamp is
+	// matched within the same small window as AtTimestamp, and the logs are returned oldest
+	// first. At most MaxAtTimestamps may be listed.
+	AtTimestamps []int64 `json:"atTimestamps,omitempty"`
+	// MinLevel selects every log level at or above it on the adapter's severity scale. It is
+	// expanded into LogLevels by the HTTP handlers before the query is built.
+	MinLevel string `json:"minLevel,omitempty"`
+	// PodID restricts the query to a single pod instance (the pod UID), e.g. to separate the
+	// logs written before and after a restart.
+	PodID string `json:"podId,omitempty"`
+	// Sample returns a random sample of the matching logs spread across the time window
+	// instead of the newest (or oldest) ones. SampleRate, when between 0 and 1, additionally
+	// keeps only that share of the matching logs before sampling, which bounds the cost of
+	// the random ordering on very large windows; setting it implies Sample.
+	Sample     bool    `json:"sample,omitempty"`
+	SampleRate float64 `json:"sampleRate,omitempty"`
+	// Stream restricts the query to logs written to stdout or stderr.
+	Stream string `json:"stream,omitempty"`
+	// Before and After page through the logs in either sort order: Before takes the
+	// beforeCursor of a previous result to continue with older logs, After takes its
+	// afterCursor to continue with newer ones, e.g. to read a build log from the top or to
+	// fetch the logs written since. At most one may be set.
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+	// Offset skips that many matching logs in the requested order, e.g. to jump to a page by
+	// number. It cannot be combined with Before, After, AtTimestamp, AtTimestamps or
+	// sampling; cursors are cheaper for reading on page after page.
+	Offset int `json:"offset,omitempty"`
+	// Explain collects the scan details OpenObserve reports for the queries into the
+	// result's Debug, for investigating slow queries.
+	Explain bool `json:"explain,omitempty"`
+	// IncludeFacets adds the counts of the matching logs per component, log level and
+	// Kubernetes namespace to the result's Facets. The facet queries run concurrently with
+	// the log query.
+	IncludeFacets bool `json:"includeFacets,omitempty"`
+	// LogStream queries another OpenObserve stream than the configured one, referenced as
+	// "stream" or "folder/stream". It must be among ClientOptions.AllowedStreams, and
+	// fallback streams are not tried.
+	LogStream string `json:"logStream,omitempty"`
+	// MinIngestionLag, a duration such as "30s", selects the logs ingested more than that
+	// after their event time, e.g. to find where a log pipeline falls behind. It requires
+	// ClientOptions.EventTimeField.
+	MinIngestionLag string `json:"minIngestionLag,omitempty"`
+	// LabelSelectors restricts the query to the logs of pods carrying all of the given
+	// Kubernetes labels, keyed by label key (e.g. "app.kubernetes.io/name").
+	LabelSelectors map[string]string `json:"labelSelectors,omitempty"`
+	// Version restricts the query to the logs of pods of one deployment version or track,
+	// e.g. the canary of a progressive rollout, read from ClientOptions.VersionField.
+	Version string `json:"version,omitempty"`
+	// CorrelationID restricts the query to the logs carrying this request correlation ID,
+	// read from ClientOptions.CorrelationField. A request crosses projects and environments,
+	// so the project and environment filters do not apply to it.
+	CorrelationID string `json:"correlationId,omitempty"`
+	// Expressions computes additional fields for each returned log, keyed by field name, from
+	// a safe expression such as substr(log, 1, 8) or cast(latency_ms, 'int') (see
+	// ValidateExpressions). The values are returned in ComponentLogsEntry.Computed.
+	Expressions map[string]string `json:"expressions,omitempty"`
+	// BestEffort trades completeness for speed: the query is given a short timeout (see
+	// ClientOptions.BestEffortTimeout) and whatever OpenObserve found by then is returned,
+	// flagged as ComponentLogsResult.Partial, instead of an error. By default queries run
+	// to completion.
+	BestEffort bool `json:"bestEffort,omitempty"`
+
+	// timestampField is the stream field holding the log timestamp, set by the Client from
+	// its configuration. Empty selects DefaultTimestampField.
+	timestampField string
+	// streamField is the stream field holding the container output stream, set by the Client
+	// from its configuration. Empty selects DefaultStreamField.
+	streamField string
+	// eventTimeField is the stream field holding the event time of a log, set by the Client
+	// from its configuration. Empty means the stream has none.
+	eventTimeField string
+	// versionField is the stream field holding the deployment version of a log, set by the
+	// Client from its configuration. Empty selects DefaultVersionField.
+	versionField string
+	// correlationField is the stream field holding the request correlation ID of a log, set
+	// by the Client from its configuration. Empty selects DefaultCorrelationField.
+	correlationField string
+	// messageField is the stream field holding the log message, set by the Client from its
+	// configuration. Empty selects DefaultMessageField.
+	messageField string
+	// levelField is the stream field holding the log level, set by the Client from its
+	// configuration. Empty selects DefaultLevelField.
+	levelField string
+	// levelFromMessage and levelPattern derive the log level from the message instead, for
+	// streams without a level field (see ClientOptions.LevelFromMessage).
+	levelFromMessage bool
+	levelPattern     string
+	// atTimestampEps is the half-width of the window matched around each of AtTimestamps,
+	// set by the Client from its configuration.
+	atTimestampEps time.Duration
+	// queryTimeout is the OpenObserve query timeout, set by the Client for best-effort
+	// queries. Zero means no timeout.
+	queryTimeout time.Duration
+	// cursor is the decoded Before or After cursor.
+	cursor *logCursor
+	// defaultWindow is set when the time range was defaulted (see Client.resolveTimeWindow).
+	defaultWindow bool
 }
 
 // WorkflowLogsParams holds parameters for workflow log queries.
@@ -56,6 +180,9 @@ type WorkflowLogsParams struct {
 	LogLevels       []string  `json:"logLevels"`
 	Limit           int       `json:"limit"`
 	SortOrder       string    `json:"sortOrder"`
+	// CaseSensitive matches SearchPhrase with its exact case. By default it is matched
+	// case-insensitively.
+	CaseSensitive bool `json:"caseSensitive,omitempty"`
 }
 
 // LogAlertParams holds parameters for creating log alerts.
@@ -71,6 +198,51 @@ type LogAlertParams struct {
 	Window         string  `json:"window"`
 	Interval       string  `json:"interval"`
 	Enabled        *bool   `json:"enabled"`
+	// Schedule optionally mutes the alert during recurring daily windows.
+	Schedule *AlertSchedule `json:"schedule,omitempty"`
+
+	// messageField is the stream field the search pattern is matched against, set by the
+	// Client from its configuration. Empty selects DefaultMessageField.
+	messageField string
+}
+
+// Validate checks the alert parameters that OpenObserve would otherwise accept but turn
+// into a broken alert. The error names the offending request field.
+func (p LogAlertParams) Validate() error {
+	if strings.TrimSpace(p.SearchPattern) == "" {
+		return fmt.Errorf("source.query must not be empty")
+	}
+	if p.ThresholdValue < 0 {
+		return fmt.Errorf("condition.threshold must not be negative, got %v", p.ThresholdValue)
+	}
+	if _, err := mapOperator(p.Operator); err != nil {
+		return fmt.Errorf("condition.operator is invalid: %w", err)
+	}
+	if minutes, err := parseDurationMinutes(p.Window); err != nil || minutes <= 0 {
+		return fmt.Errorf("condition.window must be a positive duration in minutes or hours (e.g. 5m, 1h), got %q", p.Window)
+	}
+	minutes, err := parseDurationMinutes(p.Interval)
+	if err != nil || minutes <= 0 {
+		return fmt.Errorf("condition.interval must be a positive duration in minutes or hours (e.g. 1m, 1h), got %q", p.Interval)
+	}
+	if p.Schedule != nil {
+		return p.Schedule.validate(minutes)
+	}
+	return nil
+}
+
+// ValidateWindow checks that the alert evaluation window does not exceed maxWindow, so
+// pathological alert definitions cannot make OpenObserve evaluate expensive windows.
+// It assumes Validate has already succeeded.
+func (p LogAlertParams) ValidateWindow(maxWindow time.Duration) error {
+	minutes, err := parseDurationMinutes(p.Window)
+	if err != nil {
+		return fmt.Errorf("condition.window is invalid: %w", err)
+	}
+	if window := time.Duration(minutes) * time.Minute; window > maxWindow {
+		return fmt.Errorf("condition.window must not exceed %s, got %q", maxWindow, p.Window)
+	}
+	return nil
 }
 
 // ComponentLogsEntry represents a parsed log entry.
@@ -86,8 +258,28 @@ type ComponentLogsEntry struct {
 	ProjectName     string    `json:"projectName"`
 	Namespace       string    `json:"namespace"`
 	PodName         string    `json:"podName"`
+	PodID           string    `json:"podId"`
 	PodNamespace    string    `json:"podNamespace"`
 	ContainerName   string    `json:"containerName"`
+	// Stream is the container output stream the log was written to (stdout or stderr).
+	Stream string `json:"stream"`
+	// RawLog is the log line as stored, set when ANSI escape codes were stripped from Log
+	// (see ClientOptions.StripANSI).
+	RawLog string `json:"rawLog,omitempty"`
+	// SeverityClass is the display class of LogLevel (e.g. critical, warning or normal), set
+	// when ClientOptions.SeverityClasses is configured.
+	SeverityClass string `json:"severityClass,omitempty"`
+	// Org is the OpenObserve organization of the log, set by searches across organizations.
+	Org string `json:"org,omitempty"`
+	// Computed holds the fields computed by ComponentLogsParams.Expressions.
+	Computed map[string]interface{} `json:"computed,omitempty"`
+	// Index is the 1-based position of the log in the whole result of the query, in the
+	// requested order, across the pages read with cursors, e.g. to cite "line 4201" of the
+	// logs of a window. It is 0 for sampled logs and logs that cannot be numbered.
+	Index int `json:"index,omitempty"`
+	// Link opens the log in the OpenObserve UI, set by searches when ClientOptions.UIURL is
+	// configured.
+	Link string `json:"link,omitempty"`
 }
 
 // ComponentLogsResult represents the result of a component log query.
@@ -95,6 +287,131 @@ type ComponentLogsResult struct {
 	Logs       []ComponentLogsEntry `json:"logs"`
 	TotalCount int                  `json:"totalCount"`
 	Took       int                  `json:"took"`
+	// NearLimit is set when the query returned close to its limit, so the result is likely truncated.
+	NearLimit bool `json:"nearLimit"`
+	// Stream is the OpenObserve stream that produced the logs. It is only reported when
+	// fallback streams are configured.
+	Stream string `json:"stream,omitempty"`
+	// BeforeCursor and AfterCursor continue a result towards older and newer logs
+	// respectively (see ComponentLogsParams.Before and After).
+	BeforeCursor string `json:"beforeCursor,omitempty"`
+	AfterCursor  string `json:"afterCursor,omitempty"`
+	// Debug holds the scan details of the queries when ComponentLogsParams.Explain is set.
+	Debug *ComponentLogsDebug `json:"debug,omitempty"`
+	// Facets summarizes the matching logs when ComponentLogsParams.IncludeFacets is set.
+	Facets *LogFacets `json:"facets,omitempty"`
+	// Window is the time range searched when the query gave none (see
+	// ClientOptions.DefaultWindow).
+	Window *TimeWindow `json:"window,omitempty"`
+	// Partial is set when OpenObserve did not search the whole time range before its query
+	// timeout, e.g. for a best-effort query (see ComponentLogsParams.BestEffort), so Logs and
+	// TotalCount may be incomplete.
+	Partial bool `json:"partial,omitempty"`
+	// Offset echoes ComponentLogsParams.Offset. NextOffset is the offset of the next page,
+	// set when more logs match than were returned up to this page.
+	Offset     int `json:"offset,omitempty"`
+	NextOffset int `json:"nextOffset,omitempty"`
+}
+
+// ComponentLogVolume holds the number of matching log lines produced by a single component.
+type ComponentLogVolume struct {
+	ComponentUID  string `json:"componentUid"`
+	ComponentName string `json:"componentName"`
+	Count         int    `json:"count"`
+}
+
+// ComponentLogVolumeResult represents the result of a per-component log volume query.
+type ComponentLogVolumeResult struct {
+	Components []ComponentLogVolume `json:"components"`
+	Took       int                  `json:"took"`
+}
+
+// ComponentPercentiles holds the approximate percentiles of a numeric log field for a single
+// component, keyed by percentile name (p50, p95, p99, ...).
+type ComponentPercentiles struct {
+	ComponentUID  string             `json:"componentUid"`
+	ComponentName string             `json:"componentName"`
+	Count         int                `json:"count"`
+	Percentiles   map[string]float64 `json:"percentiles"`
+}
+
+// ComponentPercentilesResult represents the result of a per-component percentiles query.
+type ComponentPercentilesResult struct {
+	Field      string                 `json:"field"`
+	Components []ComponentPercentiles `json:"components"`
+	Took       int                    `json:"took"`
+}
+
+// DistinctLogMessage is a unique log message with the number of times it occurred.
+type DistinctLogMessage struct {
+	Log      string    `json:"log"`
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"lastSeen"`
+}
+
+// DistinctLogMessagesResult represents the result of a distinct log messages query.
+type DistinctLogMessagesResult struct {
+	Messages []DistinctLogMessage `json:"messages"`
+	Took     int                  `json:"took"`
+}
+
+// ComponentLogsCountResult represents the result of a component log count query.
+type ComponentLogsCountResult struct {
+	Count int `json:"count"`
+	Took  int `json:"took"`
+	// Window is the time range counted when the query gave none (see
+	// ClientOptions.DefaultWindow).
+	Window *TimeWindow `json:"window,omitempty"`
+}
+
+// LogHistogramBucket holds the number of matching logs in the time bucket starting at Start.
+type LogHistogramBucket struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+// LogHistogramResult represents the result of a log histogram query.
+type LogHistogramResult struct {
+	Buckets []LogHistogramBucket `json:"buckets"`
+	Took    int                  `json:"took"`
+}
+
+// LogSampleBucket holds the number of matching logs in the time bucket starting at Start
+// together with a few of its newest logs.
+type LogSampleBucket struct {
+	Start   time.Time            `json:"start"`
+	Count   int                  `json:"count"`
+	Samples []ComponentLogsEntry `json:"samples"`
+}
+
+// LogBucketSamplesResult represents the result of a bucketed log sample query.
+type LogBucketSamplesResult struct {
+	Buckets  []LogSampleBucket `json:"buckets"`
+	Interval string            `json:"interval"`
+	Took     int               `json:"took"`
+}
+
+// ComponentLogsWithHistogramResult holds a page of component logs together with the log
+// counts per time bucket for the same filters.
+type ComponentLogsWithHistogramResult struct {
+	Logs      *ComponentLogsResult `json:"logs"`
+	Histogram *LogHistogramResult  `json:"histogram"`
+}
+
+// ComponentPod is a pod that produced logs in the queried time window.
+type ComponentPod struct {
+	PodID    string    `json:"podId"`
+	PodName  string    `json:"podName"`
+	Count    int       `json:"count"`
+	LastSeen time.Time `json:"lastSeen"`
+	// Line is the newest (or oldest) log of the pod, when requested.
+	Line *ComponentLogsEntry `json:"line,omitempty"`
+}
+
+// ComponentPodsResult represents the result of a component pods query.
+type ComponentPodsResult struct {
+	Pods []ComponentPod `json:"pods"`
+	Took int            `json:"took"`
 }
 
 // WorkflowLogsEntry represents a parsed workflow log entry.
@@ -109,37 +426,384 @@ type WorkflowLogsResult struct {
 	Logs       []WorkflowLogsEntry `json:"logs"`
 	TotalCount int                 `json:"totalCount"`
 	Took       int                 `json:"took"`
+	// NearLimit is set when the query returned close to its limit, so the result is likely truncated.
+	NearLimit bool `json:"nearLimit"`
 }
 
 type OpenObserveResponse struct {
 	Took  int                      `json:"took"`
 	Hits  []map[string]interface{} `json:"hits"`
 	Total int                      `json:"total"`
+
+	// Scan details, reported for debugging (see QueryDebug). OpenObserve versions differ in
+	// which of them they return.
+	TookDetail  map[string]interface{} `json:"took_detail,omitempty"`
+	TraceID     string                 `json:"trace_id,omitempty"`
+	ScanSize    float64                `json:"scan_size,omitempty"`
+	ScanRecords int64                  `json:"scan_records,omitempty"`
+	CachedRatio float64                `json:"cached_ratio,omitempty"`
+	IsPartial   bool                   `json:"is_partial,omitempty"`
+
+	// responseBytes is the size of the raw response body, recorded for capacity planning.
+	responseBytes int
+}
+
+// UnmarshalJSON decodes a search response. Some OpenObserve versions encode took and total
+// as strings, so both are accepted as numbers or numeric strings.
+func (r *OpenObserveResponse) UnmarshalJSON(data []byte) error {
+	type response OpenObserveResponse
+	aux := struct {
+		*response
+		Took  lenientInt `json:"took"`
+		Total lenientInt `json:"total"`
+	}{response: (*response)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Took = int(aux.Took)
+	r.Total = int(aux.Total)
+	return nil
+}
+
+// lenientInt is an integer encoded as a JSON number or as a string holding one. Null and
+// the empty string decode as zero.
+type lenientInt int
+
+func (n *lenientInt) UnmarshalJSON(data []byte) error {
+	value := strings.TrimSpace(string(data))
+	if value == "null" {
+		*n = 0
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = strings.TrimSpace(unquoted)
+		if value == "" {
+			*n = 0
+			return nil
+		}
+	}
+	v, ok := parseCount(value)
+	if !ok {
+		return fmt.Errorf("invalid integer %s", data)
+	}
+	*n = lenientInt(v)
+	return nil
+}
+
+// parseCount parses an integer count, also accepting integral floats such as 12.0 or 1e3.
+func parseCount(value string) (int, bool) {
+	if v, err := strconv.Atoi(value); err == nil {
+		return v, true
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f != float64(int64(f)) {
+		return 0, false
+	}
+	return int(f), true
+}
+
+// DefaultNearLimitRatio is the share of the query limit at or above which a result is
+// reported as near the limit.
+const DefaultNearLimitRatio = 0.9
+
+// DefaultTimestampField is the stream field holding the log timestamp in OpenObserve's
+// default schema.
+const DefaultTimestampField = "_timestamp"
+
+// DefaultStreamField is the stream field holding the container output stream (stdout or
+// stderr) as set by common log shippers.
+const DefaultStreamField = "stream"
+
+// DefaultVersionField is the stream field holding the value of the pod's "version" label,
+// the label conventionally used to tell the tracks of a canary rollout apart.
+const DefaultVersionField = "kubernetes_labels_version"
+
+// DefaultCorrelationField is the stream field holding the request correlation ID that
+// services log with each line, e.g. from an X-Correlation-Id header.
+const DefaultCorrelationField = "correlation_id"
+
+// DefaultMessageField is the stream field holding the log message in OpenObserve's default
+// Kubernetes log schema.
+const DefaultMessageField = "log"
+
+// DefaultSortOrder is the order of the logs returned by log queries that give none, when
+// ClientOptions.DefaultSortOrder is not set: newest first.
+const DefaultSortOrder = "desc"
+
+// DefaultAtTimestampEpsilon is the half-width of the window queried around ComponentLogsParams.AtTimestamp.
+const DefaultAtTimestampEpsilon = time.Millisecond
+
+// ClientOptions holds the optional settings of a Client. Zero values select the defaults.
+type ClientOptions struct {
+	// NearLimitRatio is the share of the effective query limit (0 < ratio <= 1) at or above
+	// which a log query result is logged and flagged as likely truncated.
+	NearLimitRatio float64
+	// AtTimestampEpsilon is the half-width of the window queried around an exact timestamp.
+	AtTimestampEpsilon time.Duration
+	// FallbackStreams are tried in order, after the primary stream, by component log queries
+	// that return no results (e.g. a combined or archive stream). Empty disables fallback.
+	FallbackStreams []string
+	// TimestampField is the stream field holding the log timestamp, in microseconds since the
+	// epoch, used to filter, sort and parse component logs. Empty selects DefaultTimestampField.
+	TimestampField string
+	// StreamField is the stream field holding the container output stream (stdout or stderr)
+	// of component logs. Empty selects DefaultStreamField.
+	StreamField string
+	// EventTimeField is the stream field holding the time a log was written, in microseconds
+	// since the epoch, when the stream records it besides the ingestion time (_timestamp).
+	// It enables ComponentLogsParams.MinIngestionLag. Empty disables the filter.
+	EventTimeField string
+	// VersionField is the stream field holding the deployment version or track of component
+	// logs, filtered by ComponentLogsParams.Version. Empty selects DefaultVersionField.
+	VersionField string
+	// CorrelationField is the stream field holding the request correlation ID of component
+	// logs, filtered by ComponentLogsParams.CorrelationID. Empty selects DefaultCorrelationField.
+	CorrelationField string
+	// MessageField is the stream field holding the log message, matched by the search phrases
+	// of component log queries and by the search patterns of alerts. Empty selects
+	// DefaultMessageField.
+	MessageField string
+	// LevelField is the stream field holding the log level, filtered by
+	// ComponentLogsParams.LogLevels and aggregated by the level facets and histograms. Empty
+	// selects DefaultLevelField.
+	LevelField string
+	// LevelFromMessage derives the log level from the log message instead, for streams
+	// without a level field, on which level filters would otherwise fail. The level is the
+	// first of ERROR, FATAL, SEVERE, WARN, INFO and DEBUG that the message contains, or INFO.
+	LevelFromMessage bool
+	// LevelPattern, with LevelFromMessage, derives the level from the first capturing group
+	// of the pattern's first match in the message instead, upper-cased, or INFO if it does
+	// not match (see ValidLevelPattern). It is also run by OpenObserve, so it must only use
+	// syntax common to Go and Rust regular expressions.
+	LevelPattern *regexp.Regexp
+	// SeverityClasses maps log levels to the display class returned with each component log
+	// as ComponentLogsEntry.SeverityClass, keyed by upper-case level. The "*" key, if any,
+	// classifies the levels not listed. Empty leaves entries unclassified.
+	SeverityClasses map[string]string
+	// MaxComponentIDs caps the number of ComponentIDs a component log query may list; longer
+	// lists are rejected with a ComponentIDsError. Defaults to DefaultMaxComponentIDs.
+	MaxComponentIDs int
+	// DefaultWindow is the time window queried, up to now, by component log queries that give
+	// no time range. Defaults to DefaultQueryWindow.
+	DefaultWindow time.Duration
+	// DefaultSortOrder is the order, "asc" or "desc", of the logs returned by component and
+	// workflow log queries that give no SortOrder. Defaults to DefaultSortOrder.
+	DefaultSortOrder string
+	// SchemaCacheTTL is how long the stream schemas returned by GetStreamSchema are cached.
+	// Defaults to DefaultSchemaCacheTTL.
+	SchemaCacheTTL time.Duration
+	// BestEffortTimeout is the OpenObserve query timeout of best-effort component log
+	// queries (see ComponentLogsParams.BestEffort). Defaults to DefaultBestEffortTimeout.
+	BestEffortTimeout time.Duration
+	// ScanBudgets caps the data, in MB as reported by OpenObserve, that the queries of each
+	// organization may scan within ScanBudgetWindow, keyed by organization. The "*" key, if
+	// any, sets the budget of the organizations not listed. Empty disables the budgets.
+	ScanBudgets map[string]float64
+	// ScanBudgetWindow is the rolling window of ScanBudgets. Defaults to
+	// DefaultScanBudgetWindow.
+	ScanBudgetWindow time.Duration
+	// QueryDedupWindow is how long the result of a component log query is shared with
+	// identical queries issued after it completed. Identical queries issued while it is
+	// running always share its result; zero limits sharing to those.
+	QueryDedupWindow time.Duration
+	// MaxScanBytes, when positive, makes component log queries first ask OpenObserve for an
+	// estimate of the data they would scan and reject those estimated to scan more with a
+	// QueryTooExpensiveError instead of running them.
+	MaxScanBytes int64
+	// KeepAliveInterval is how often Client.KeepAlive recycles idle connections and checks
+	// the health of OpenObserve. Zero disables the keep-alive.
+	KeepAliveInterval time.Duration
+	// AllowedStreams lists the stream references, "stream" or "folder/stream", that component
+	// log queries may select with ComponentLogsParams.LogStream besides the configured stream.
+	AllowedStreams []StreamRef
+	// StripANSI removes ANSI escape codes, such as the colors of CLI output, from the log
+	// lines of component logs. The line as stored is kept in ComponentLogsEntry.RawLog.
+	StripANSI bool
+	// Headers are sent with every request to OpenObserve, e.g. the tenant or API key headers
+	// required by a gateway in front of it. See ParseHeaders.
+	Headers http.Header
+	// TokenSource, when set, authenticates requests with session tokens obtained from it
+	// instead of basic auth. Tokens are cached until shortly before they expire and refreshed
+	// by a single call however many requests need one.
+	TokenSource TokenSource
+	// QueryRetry is the retry budget of log queries. They are latency-sensitive, so it is
+	// usually small or zero.
+	QueryRetry RetryPolicy
+	// AlertRetry is the retry budget of alert operations, which matter more to eventually
+	// succeed (e.g. during GitOps reconciliation) than to be fast.
+	AlertRetry RetryPolicy
+	// EmptyResultRetry reruns a component log query that returns no logs for a window ending
+	// within the last minute, as logs written just before may not be searchable yet. Retries
+	// wait Backoff, doubled for each further retry, and add that latency to every empty
+	// result of a recent window. The zero value does not retry.
+	EmptyResultRetry RetryPolicy
+	// UIURL is the base URL of the OpenObserve UI, e.g. https://openobserve.example.com, which
+	// may differ from the API URL the client queries. When set, the logs returned by
+	// GetComponentLogs carry a link to them in the UI. Empty disables links.
+	UIURL string
 }
 
 type Client struct {
-	baseURL      string
-	org          string
-	stream       string
-	eventsStream string
-	user         string
-	token        string
-	httpClient   *http.Client
-	logger       *slog.Logger
+	baseURL        string
+	org            string
+	stream         string
+	eventsStream   string
+	user           string
+	token          string
+	nearLimitRatio float64
+	atTimestampEps time.Duration
+	fallbacks      []string
+	timestampField string
+	streamField    string
+	eventTimeField string
+	versionField   string
+	flights        *queryFlightGroup
+	maxScanBytes   int64
+	keepAlive      time.Duration
+	allowedStreams map[string]bool
+	stripANSI      bool
+	statuses       *statusCounter
+	queryRetry     RetryPolicy
+	alertRetry     RetryPolicy
+	emptyRetry     RetryPolicy
+	uiURL          string
+	httpClient     *http.Client
+	logger         *slog.Logger
+
+	// correlationField is the stream field filtered by ComponentLogsParams.CorrelationID.
+	correlationField string
+	// messageField is the stream field matched by search phrases and alert patterns.
+	messageField string
+	// levelField, levelFromMessage and levelPattern select where the log level is read
+	// (see ClientOptions.LevelField).
+	levelField       string
+	levelFromMessage bool
+	levelPattern     *regexp.Regexp
+	// severityClasses maps log levels to their display class (see ClientOptions.SeverityClasses).
+	severityClasses map[string]string
+	// maxComponentIDs caps the ComponentIDs of a query (see ClientOptions.MaxComponentIDs).
+	maxComponentIDs int
+	// defaultWindow is the time window of queries that give none (see ClientOptions.DefaultWindow).
+	defaultWindow time.Duration
+	// defaultSortOrder is the sort order of queries that give none (see ClientOptions.DefaultSortOrder).
+	defaultSortOrder string
+	// bestEffortTimeout is the query timeout of best-effort queries (see ClientOptions.BestEffortTimeout).
+	bestEffortTimeout time.Duration
+	// schemas caches the stream schemas (see ClientOptions.SchemaCacheTTL).
+	schemas *schemaCache
+	// scanBudgets counts the data scanned per organization (see ClientOptions.ScanBudgets).
+	scanBudgets *scanBudgets
 }
 
 func NewClient(baseURL, org, stream, eventsStream, user, token string, logger *slog.Logger) *Client {
+	return NewClientWithOptions(baseURL, org, stream, eventsStream, user, token, ClientOptions{}, logger)
+}
+
+// NewClientWithOptions constructs a Client with the given optional settings.
+func NewClientWithOptions(baseURL, org, stream, eventsStream, user, token string, opts ClientOptions, logger *slog.Logger) *Client {
+	nearLimitRatio := opts.NearLimitRatio
+	if nearLimitRatio <= 0 || nearLimitRatio > 1 {
+		nearLimitRatio = DefaultNearLimitRatio
+	}
+	atTimestampEps := opts.AtTimestampEpsilon
+	if atTimestampEps <= 0 {
+		atTimestampEps = DefaultAtTimestampEpsilon
+	}
+	timestampField := opts.TimestampField
+	if timestampField == "" {
+		timestampField = DefaultTimestampField
+	}
+	streamField := opts.StreamField
+	if streamField == "" {
+		streamField = DefaultStreamField
+	}
+	versionField := opts.VersionField
+	if versionField == "" {
+		versionField = DefaultVersionField
+	}
+	correlationField := opts.CorrelationField
+	if correlationField == "" {
+		correlationField = DefaultCorrelationField
+	}
+	messageField := opts.MessageField
+	if messageField == "" {
+		messageField = DefaultMessageField
+	}
+	levelField := opts.LevelField
+	if levelField == "" {
+		levelField = DefaultLevelField
+	}
+	maxComponentIDs := opts.MaxComponentIDs
+	if maxComponentIDs <= 0 {
+		maxComponentIDs = DefaultMaxComponentIDs
+	}
+	defaultWindow := opts.DefaultWindow
+	if defaultWindow <= 0 {
+		defaultWindow = DefaultQueryWindow
+	}
+	defaultSortOrder := strings.ToLower(opts.DefaultSortOrder)
+	if defaultSortOrder == "" {
+		defaultSortOrder = DefaultSortOrder
+	}
+	bestEffortTimeout := opts.BestEffortTimeout
+	if bestEffortTimeout <= 0 {
+		bestEffortTimeout = DefaultBestEffortTimeout
+	}
+	allowedStreams := make(map[string]bool, len(opts.AllowedStreams))
+	for _, ref := range opts.AllowedStreams {
+		allowedStreams[ref.String()] = true
+	}
+	transport := http.DefaultTransport
+	if len(opts.Headers) > 0 {
+		transport = &headerTransport{base: transport, headers: opts.Headers.Clone()}
+	}
+	if opts.TokenSource != nil {
+		transport = &tokenTransport{base: transport, tokens: newTokenCache(opts.TokenSource)}
+	}
+	statuses := newStatusCounter()
+	httpClient := &http.Client{
+		Transport: &statusTransport{base: transport, counter: statuses},
+		Timeout:   30 * time.Second,
+	}
 	return &Client{
-		baseURL:      strings.TrimSuffix(baseURL, "/"),
-		org:          org,
-		stream:       stream,
-		eventsStream: eventsStream,
-		user:         user,
-		token:        token,
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
-		logger: logger,
+		baseURL:        strings.TrimSuffix(baseURL, "/"),
+		org:            org,
+		stream:         stream,
+		eventsStream:   eventsStream,
+		user:           user,
+		token:          token,
+		nearLimitRatio: nearLimitRatio,
+		atTimestampEps: atTimestampEps,
+		fallbacks:      opts.FallbackStreams,
+		timestampField: timestampField,
+		streamField:    streamField,
+		eventTimeField: opts.EventTimeField,
+		versionField:   versionField,
+		flights:        &queryFlightGroup{window: opts.QueryDedupWindow},
+		maxScanBytes:   opts.MaxScanBytes,
+		keepAlive:      opts.KeepAliveInterval,
+		allowedStreams: allowedStreams,
+		stripANSI:      opts.StripANSI,
+		statuses:       statuses,
+		queryRetry:     opts.QueryRetry,
+		alertRetry:     opts.AlertRetry,
+		emptyRetry:     opts.EmptyResultRetry,
+		uiURL:          opts.UIURL,
+		httpClient:     httpClient,
+		logger:         logger,
+
+		correlationField:  correlationField,
+		messageField:      messageField,
+		levelField:        levelField,
+		levelFromMessage:  opts.LevelFromMessage,
+		levelPattern:      opts.LevelPattern,
+		severityClasses:   opts.SeverityClasses,
+		maxComponentIDs:   maxComponentIDs,
+		defaultWindow:     defaultWindow,
+		defaultSortOrder:  defaultSortOrder,
+		bestEffortTimeout: bestEffortTimeout,
+		schemas:           newSchemaCache(opts.SchemaCacheTTL),
+		scanBudgets:       newScanBudgets(opts.ScanBudgets, opts.ScanBudgetWindow),
 	}
 }
 
@@ -156,7 +820,7 @@ func (c *Client) executeSearchQuery(ctx context.Context, queryJSON []byte) (*Ope
 	req.Header.Set("Content-Type", "application/json")
 	req.SetBasicAuth(c.user, c.token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.queryRetry)
 	if err != nil {
 		c.logger.Error("Failed to execute search request against OpenObserve", slog.Any("error", err))
 		return nil, fmt.Errorf("failed to execute request: %w", err)
@@ -182,6 +846,8 @@ func (c *Client) executeSearchQuery(ctx context.Context, queryJSON []byte) (*Ope
 		c.logger.Error("Failed to unmarshal response from OpenObserve", slog.Any("error", err))
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
+	openObserveResp.responseBytes = len(body)
+	c.recordScan(&openObserveResp)
 
 	return &openObserveResp, nil
 }
@@ -190,59 +856,654 @@ func (c *Client) executeSearchQuery(ctx context.Context, queryJSON []byte) (*Ope
 // The response is expected to have hits[0].total as the count value.
 func extractTotalCount(resp *OpenObserveResponse) int {
 	if len(resp.Hits) > 0 {
-		if total, ok := resp.Hits[0]["total"]; ok {
-			if v, ok := total.(float64); ok {
-				return int(v)
-			}
-		}
+		return countField(resp.Hits[0], "total")
 	}
 	return 0
 }
 
+// GetComponentLogs queries the component logs matching params. When fallback streams are
+// configured and the primary stream returns no logs, they are queried in order until one
+// returns results; the result then records which stream produced it. Identical concurrent
+// queries are sent to OpenObserve once and share the result (see ClientOptions.QueryDedupWindow).
 func (c *Client) GetComponentLogs(ctx context.Context, params ComponentLogsParams) (*ComponentLogsResult, error) {
-	queryJSON, err := generateComponentLogsQuery(params, c.stream, c.logger)
+	params = c.resolveAtTimestamp(params)
+	params = c.withFieldNames(params)
+	params, err := applyCursor(params)
+	if err != nil {
+		return nil, err
+	}
+	if params.BestEffort {
+		params.queryTimeout = c.bestEffortTimeout
+	}
+	result, shared, err := c.flights.do(ctx, componentLogsFlightKey(params), func(ctx context.Context) (*ComponentLogsResult, error) {
+		return c.retryEmpty(ctx, params, func(ctx context.Context) (*ComponentLogsResult, error) {
+			return c.getComponentLogs(ctx, params)
+		})
+	})
+	if shared {
+		c.logger.Debug("Shared the result of an identical component log query",
+			slog.String("namespace", params.Namespace),
+		)
+	}
+	return result, err
+}
+
+// getComponentLogs runs a component log query against the primary stream and, if it returns
+// no logs, against each fallback stream in turn. A query selecting its LogStream only runs
+// against that stream.
+func (c *Client) getComponentLogs(ctx context.Context, params ComponentLogsParams) (*ComponentLogsResult, error) {
+	if params.LogStream != "" || len(c.fallbacks) == 0 {
+		stream, err := c.logStream(params)
+		if err != nil {
+			return nil, err
+		}
+		return c.getComponentLogsFromStream(ctx, params, stream)
+	}
+
+	streams := append([]string{c.stream}, c.fallbacks...)
+	var result *ComponentLogsResult
+	for i, stream := range streams {
+		var err error
+		result, err = c.getComponentLogsFromStream(ctx, params, stream)
+		if err != nil {
+			return nil, err
+		}
+		result.Stream = stream
+		// A partial result without logs does not show that the stream has none.
+		if len(result.Logs) > 0 || result.Partial {
+			break
+		}
+		if i < len(streams)-1 {
+			c.logger.Debug("No component logs found, trying fallback stream",
+				slog.String("namespace", params.Namespace),
+				slog.String("stream", stream),
+				slog.String("fallbackStream", streams[i+1]),
+			)
+		}
+	}
+	return result, nil
+}
+
+// getComponentLogsFromStream runs a component log query and its count query against stream.
+// A best-effort query returns what OpenObserve found before its timeout, or no logs if
+// OpenObserve does not answer in time at all, flagged as partial rather than failing.
+func (c *Client) getComponentLogsFromStream(ctx context.Context, params ComponentLogsParams, stream string) (*ComponentLogsResult, error) {
+	parent := ctx
+	ctx, cancelQuery := withBestEffortDeadline(ctx, params)
+	defer cancelQuery()
+
+	queryJSON, err := generateComponentLogsQuery(params, stream, c.logger)
 	if err != nil {
 		c.logger.Error("Failed to marshal query", slog.Any("error", err))
 		return nil, fmt.Errorf("failed to marshal query: %w", err)
 	}
+	if err := c.checkScanLimit(ctx, queryJSON, stream); err != nil {
+		return nil, err
+	}
+
+	var facetc chan componentLogFacetsResult
+	if params.IncludeFacets {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		facetc = make(chan componentLogFacetsResult, 1)
+		go func() {
+			facets, debug, err := c.getComponentLogFacets(ctx, params, stream)
+			facetc <- componentLogFacetsResult{facets: facets, debug: debug, err: err}
+		}()
+	}
 
 	// Execute the search query
 	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	partial := false
+	if bestEffortTimedOut(parent, params, err) {
+		openObserveResp, err, partial = &OpenObserveResponse{}, nil, true
+	}
 	if err != nil {
 		return nil, err
 	}
+	partial = partial || openObserveResp.IsPartial
 
 	// Convert to LogEntry format
 	logs := make([]ComponentLogsEntry, 0, len(openObserveResp.Hits))
 	for _, hit := range openObserveResp.Hits {
-		// Extract timestamp
-		timestamp := int64(0)
-		if ts, ok := hit["_timestamp"].(float64); ok {
-			timestamp = int64(ts)
-		}
-		entry := c.parseApplicationLogEntry(timestamp, hit)
+		entry := c.parseApplicationLogEntry(hit)
+		entry.Computed = computedValues(hit, params.Expressions)
+		entry.Link = c.logLink(stream, entry)
 		logs = append(logs, entry)
 	}
+	// The final order must not depend on how the logs were fetched (e.g. sampled logs come
+	// back in random order), so sort them in the requested order.
+	sortLogEntries(logs, params.SortOrder)
+	if !params.sampled() {
+		indexLogEntries(logs, params)
+	}
+
+	var beforeCursor, afterCursor string
+	if !params.sampled() && !params.pinned() {
+		beforeCursor, afterCursor = pageCursors(logs, params)
+	}
+	// The cursors follow the timestamps, so the logs are only reordered once they are known.
+	if params.SortField != "" {
+		sortLogEntriesBy(logs, params.SortField, params.SortOrder)
+	}
 
 	// Execute a separate count query to get the true total number of matching logs
-	countQueryJSON, err := generateComponentLogsCountQuery(params, c.stream, c.logger)
+	countQueryJSON, err := generateComponentLogsCountQuery(params, stream, c.logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate component logs count query: %w", err)
 	}
 	countResp, err := c.executeSearchQuery(ctx, countQueryJSON)
+	if bestEffortTimedOut(parent, params, err) {
+		// Without a count, the logs returned are the best known total.
+		countResp, err, partial = &OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(len(logs))}}}, nil, true
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute component logs count query: %w", err)
 	}
+	partial = partial || countResp.IsPartial
+
+	c.logger.Info("Component logs query completed",
+		slog.String("namespace", params.Namespace),
+		slog.String("stream", stream),
+		slog.Int("hits", len(openObserveResp.Hits)),
+		slog.Int("responseBytes", openObserveResp.responseBytes),
+		slog.Int("limit", logsLimit(params.Limit)),
+		slog.Int("took", openObserveResp.Took),
+	)
+
+	result := &ComponentLogsResult{
+		Logs:         logs,
+		TotalCount:   extractTotalCount(countResp),
+		Took:         openObserveResp.Took,
+		NearLimit:    c.checkNearLimit("component logs", params.Namespace, len(logs), params.Limit),
+		BeforeCursor: beforeCursor,
+		AfterCursor:  afterCursor,
+		Window:       params.appliedWindow(),
+		Partial:      partial,
+		Offset:       params.Offset,
+	}
+	if next := params.Offset + len(logs); len(logs) > 0 && result.TotalCount > next {
+		result.NextOffset = next
+	}
+	if params.Explain {
+		result.Debug = &ComponentLogsDebug{Queries: []QueryDebug{
+			newQueryDebug("logs", stream, queryJSON, openObserveResp),
+			newQueryDebug("count", stream, countQueryJSON, countResp),
+		}}
+	}
+	if facetc != nil {
+		f := <-facetc
+		if f.err != nil {
+			return nil, fmt.Errorf("failed to compute component log facets: %w", f.err)
+		}
+		result.Facets = f.facets
+		if result.Debug != nil {
+			result.Debug.Queries = append(result.Debug.Queries, f.debug...)
+		}
+	}
+	return result, nil
+}
 
-	return &ComponentLogsResult{
-		Logs:       logs,
-		TotalCount: extractTotalCount(countResp),
+// CountComponentLogs returns the number of component logs matching params without fetching
+// them, e.g. to warn about a large result before running the full query.
+func (c *Client) CountComponentLogs(ctx context.Context, params ComponentLogsParams) (*ComponentLogsCountResult, error) {
+	params = c.resolveAtTimestamp(params)
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateComponentLogsCountQuery(params, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component logs count query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ComponentLogsCountResult{
+		Count:  extractTotalCount(openObserveResp),
+		Took:   openObserveResp.Took,
+		Window: params.appliedWindow(),
+	}, nil
+}
+
+// withFieldNames sets the configured names of the stream fields that component log queries
+// refer to. As every component log query goes through it, it also applies the default time
+// window (see resolveTimeWindow) and the default sort order.
+func (c *Client) withFieldNames(params ComponentLogsParams) ComponentLogsParams {
+	params = c.resolveTimeWindow(params)
+	if params.SortOrder == "" {
+		params.SortOrder = c.defaultSortOrder
+	}
+	params.timestampField = c.timestampField
+	params.streamField = c.streamField
+	params.eventTimeField = c.eventTimeField
+	params.versionField = c.versionField
+	params.correlationField = c.correlationField
+	params.messageField = c.messageField
+	params.levelField = c.levelField
+	params.levelFromMessage = c.levelFromMessage
+	if c.levelFromMessage && c.levelPattern != nil {
+		params.levelPattern = c.levelPattern.String()
+	}
+	return params
+}
+
+// withMessageField sets the configured message field that the search pattern of an alert is
+// matched against.
+func (c *Client) withMessageField(params LogAlertParams) LogAlertParams {
+	params.messageField = c.messageField
+	return params
+}
+
+// sortLogEntries sorts log entries by timestamp, oldest first when sortOrder is "asc" and
+// newest first otherwise. Entries sharing a timestamp keep their relative order.
+func sortLogEntries(logs []ComponentLogsEntry, sortOrder string) {
+	asc := strings.EqualFold(sortOrder, "asc")
+	sort.SliceStable(logs, func(i, j int) bool {
+		if asc {
+			return logs[i].Timestamp.Before(logs[j].Timestamp)
+		}
+		return logs[j].Timestamp.Before(logs[i].Timestamp)
+	})
+}
+
+// resolveAtTimestamp replaces the time range of a query pinned to an exact timestamp with a
+// window of ±epsilon around it, so that entries sharing (or within rounding of) that instant
+// are all returned, oldest first. A query pinned to several timestamps spans from the first
+// to the last of them, and only matches the window around each (see atTimestampsCondition).
+func (c *Client) resolveAtTimestamp(params ComponentLogsParams) ComponentLogsParams {
+	if len(params.AtTimestamps) > 0 {
+		first, last := slices.Min(params.AtTimestamps), slices.Max(params.AtTimestamps)
+		params.StartTime = time.UnixMicro(first).Add(-c.atTimestampEps)
+		params.EndTime = time.UnixMicro(last).Add(c.atTimestampEps)
+		params.atTimestampEps = c.atTimestampEps
+		params.SortOrder = "ASC"
+		return params
+	}
+	if params.AtTimestamp == 0 {
+		return params
+	}
+	at := time.UnixMicro(params.AtTimestamp)
+	params.StartTime = at.Add(-c.atTimestampEps)
+	params.EndTime = at.Add(c.atTimestampEps)
+	params.SortOrder = "ASC"
+	return params
+}
+
+// checkNearLimit reports whether a log query returned at least the configured share of its
+// effective limit. Such results are likely truncated, so a warning is logged to make the
+// truncation visible to operators.
+func (c *Client) checkNearLimit(query, namespace string, returned, limit int) bool {
+	limit = logsLimit(limit)
+	if float64(returned) < c.nearLimitRatio*float64(limit) {
+		return false
+	}
+	c.logger.Warn("Log query returned close to its limit, results are likely truncated; narrow the time window",
+		slog.String("query", query),
+		slog.String("namespace", namespace),
+		slog.Int("returned", returned),
+		slog.Int("limit", limit),
+	)
+	return true
+}
+
+// GetComponentLogVolume counts the matching component logs per component in a single
+// grouped query, returning the components sorted by descending log count.
+func (c *Client) GetComponentLogVolume(ctx context.Context, params ComponentLogsParams) (*ComponentLogVolumeResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateComponentLogVolumeQuery(params, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component log volume query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]ComponentLogVolume, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		components = append(components, parseComponentLogVolumeHit(hit))
+	}
+
+	// OpenObserve already orders by count, but sort again so that ties are returned
+	// in a stable order regardless of how the backend merges partitions.
+	sort.SliceStable(components, func(i, j int) bool {
+		if components[i].Count != components[j].Count {
+			return components[i].Count > components[j].Count
+		}
+		return components[i].ComponentName < components[j].ComponentName
+	})
+
+	return &ComponentLogVolumeResult{
+		Components: components,
+		Took:       openObserveResp.Took,
+	}, nil
+}
+
+// GetComponentPercentiles computes approximate percentiles of a numeric log field (such as a
+// latency embedded in structured logs) per component over the time window. The field must
+// be validated against an allowlist by the caller.
+func (c *Client) GetComponentPercentiles(ctx context.Context, params ComponentLogsParams, field string, percentiles []float64) (*ComponentPercentilesResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateComponentPercentilesQuery(params, field, percentiles, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component percentiles query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]ComponentPercentiles, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		components = append(components, parseComponentPercentilesHit(hit, percentiles))
+	}
+
+	return &ComponentPercentilesResult{
+		Field:      field,
+		Components: components,
 		Took:       openObserveResp.Took,
 	}, nil
 }
 
+// GetDistinctLogMessages returns the unique component log messages in the time window with
+// their frequency and last occurrence, most frequent first.
+func (c *Client) GetDistinctLogMessages(ctx context.Context, params ComponentLogsParams) (*DistinctLogMessagesResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateDistinctLogMessagesQuery(params, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate distinct log messages query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]DistinctLogMessage, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		messages = append(messages, parseDistinctLogMessageHit(hit))
+	}
+
+	return &DistinctLogMessagesResult{
+		Messages: messages,
+		Took:     openObserveResp.Took,
+	}, nil
+}
+
+// GetComponentLogHistogram counts the component logs matching params per time bucket of the
+// given interval, oldest bucket first. A zero interval lets OpenObserve choose it.
+func (c *Client) GetComponentLogHistogram(ctx context.Context, params ComponentLogsParams, interval time.Duration) (*LogHistogramResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateComponentLogHistogramQuery(params, interval, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component log histogram query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]LogHistogramBucket, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		buckets = append(buckets, parseHistogramBucketHit(hit))
+	}
+
+	return &LogHistogramResult{
+		Buckets: buckets,
+		Took:    openObserveResp.Took,
+	}, nil
+}
+
+// GetComponentLogsWithHistogram runs the component log query and the histogram query for the
+// same params concurrently, so that a log list and its volume histogram are served in one
+// round trip. If either query fails, the other is canceled.
+func (c *Client) GetComponentLogsWithHistogram(ctx context.Context, params ComponentLogsParams, interval time.Duration) (*ComponentLogsWithHistogramResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var result ComponentLogsWithHistogramResult
+	errc := make(chan error, 2)
+	go func() {
+		var err error
+		result.Logs, err = c.GetComponentLogs(ctx, params)
+		errc <- err
+	}()
+	go func() {
+		var err error
+		result.Histogram, err = c.GetComponentLogHistogram(ctx, params, interval)
+		errc <- err
+	}()
+
+	// Report the first failure rather than the cancellation it causes in the other query.
+	var firstErr error
+	for i := 0; i < 2; i++ {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return &result, nil
+}
+
+// DefaultBucketSamples is the number of sample logs returned per time bucket when the caller
+// does not choose one; MaxBucketSamples caps it.
+const (
+	DefaultBucketSamples = 3
+	MaxBucketSamples     = 10
+)
+
+// defaultSampleBuckets is the number of buckets the window is split into when a bucketed
+// sample query does not set an interval.
+const defaultSampleBuckets = 60
+
+// GetComponentLogBucketSamples counts the component logs matching params per time bucket of
+// the given interval, oldest bucket first, and returns the newest samples logs of each
+// bucket with its count, e.g. for a scannable overview of a long window. A zero interval
+// splits the window into about 60 buckets. The count and sample queries run concurrently.
+func (c *Client) GetComponentLogBucketSamples(ctx context.Context, params ComponentLogsParams, interval time.Duration, samples int) (*LogBucketSamplesResult, error) {
+	if samples <= 0 {
+		samples = DefaultBucketSamples
+	}
+	// The window may be the default one, so it is resolved before it is split.
+	params = c.withFieldNames(params)
+	if interval <= 0 {
+		interval = params.EndTime.Sub(params.StartTime) / defaultSampleBuckets
+		if interval%time.Second != 0 || interval == 0 {
+			interval = interval.Truncate(time.Second) + time.Second
+		}
+	}
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var histogram *LogHistogramResult
+	var sampleResp *OpenObserveResponse
+	errc := make(chan error, 2)
+	go func() {
+		var err error
+		histogram, err = c.GetComponentLogHistogram(ctx, params, interval)
+		errc <- err
+	}()
+	go func() {
+		queryJSON, err := generateComponentLogBucketSamplesQuery(params, interval, samples, stream, c.logger)
+		if err != nil {
+			errc <- fmt.Errorf("failed to generate component log bucket samples query: %w", err)
+			return
+		}
+		sampleResp, err = c.executeSearchQuery(ctx, queryJSON)
+		errc <- err
+	}()
+
+	// Report the first failure rather than the cancellation it causes in the other query.
+	var firstErr error
+	for i := 0; i < 2; i++ {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	samplesByBucket := make(map[int64][]ComponentLogsEntry)
+	for _, hit := range sampleResp.Hits {
+		start := parseHistogramBucket(hit["bucket"]).UnixMicro()
+		samplesByBucket[start] = append(samplesByBucket[start], c.parseApplicationLogEntry(hit))
+	}
+
+	buckets := make([]LogSampleBucket, 0, len(histogram.Buckets))
+	for _, b := range histogram.Buckets {
+		bucketSamples := samplesByBucket[b.Start.UnixMicro()]
+		if bucketSamples == nil {
+			bucketSamples = []ComponentLogsEntry{}
+		}
+		sortLogEntries(bucketSamples, "desc")
+		buckets = append(buckets, LogSampleBucket{Start: b.Start, Count: b.Count, Samples: bucketSamples})
+	}
+	return &LogBucketSamplesResult{
+		Buckets:  buckets,
+		Interval: interval.String(),
+		Took:     max(histogram.Took, sampleResp.Took),
+	}, nil
+}
+
+// parseHistogramBucket parses the start of a histogram bucket. OpenObserve returns it as a
+// UTC timestamp string without a zone; microseconds since the epoch are also accepted.
+func parseHistogramBucket(v interface{}) time.Time {
+	switch b := v.(type) {
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, b); err == nil {
+			return t
+		}
+		if t, err := time.ParseInLocation("2006-01-02T15:04:05", b, time.UTC); err == nil {
+			return t
+		}
+	case float64:
+		return time.UnixMicro(int64(b)).UTC()
+	}
+	return time.Time{}
+}
+
+// GetComponentPods returns the pods that produced logs matching params in the time window,
+// with their last-seen time, most recently active first.
+func (c *Client) GetComponentPods(ctx context.Context, params ComponentLogsParams) (*ComponentPodsResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateComponentPodsQuery(params, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate component pods query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]ComponentPod, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		pods = append(pods, parseComponentPodHit(hit))
+	}
+
+	return &ComponentPodsResult{
+		Pods: pods,
+		Took: openObserveResp.Took,
+	}, nil
+}
+
+// GetComponentPodLines returns the pods like GetComponentPods, each with its newest log in the
+// time window, or its oldest one if earliest is set, as a compact liveness overview.
+func (c *Client) GetComponentPodLines(ctx context.Context, params ComponentLogsParams, earliest bool) (*ComponentPodsResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var pods *ComponentPodsResult
+	var linesResp *OpenObserveResponse
+	errc := make(chan error, 2)
+	go func() {
+		var err error
+		pods, err = c.GetComponentPods(ctx, params)
+		errc <- err
+	}()
+	go func() {
+		queryJSON, err := generateComponentPodLinesQuery(params, earliest, stream, c.logger)
+		if err != nil {
+			errc <- fmt.Errorf("failed to generate component pod lines query: %w", err)
+			return
+		}
+		linesResp, err = c.executeSearchQuery(ctx, queryJSON)
+		errc <- err
+	}()
+
+	// Report the first failure rather than the cancellation it causes in the other query.
+	var firstErr error
+	for i := 0; i < 2; i++ {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	lines := make(map[string]*ComponentLogsEntry, len(linesResp.Hits))
+	for _, hit := range linesResp.Hits {
+		entry := c.parseApplicationLogEntry(hit)
+		lines[entry.PodID] = &entry
+	}
+	for i := range pods.Pods {
+		pods.Pods[i].Line = lines[pods.Pods[i].PodID]
+	}
+	return pods, nil
+}
+
 // GetWorkflowLogs queries OpenObserve for workflow logs filtered by workflow run name.
 func (c *Client) GetWorkflowLogs(ctx context.Context, params WorkflowLogsParams) (*WorkflowLogsResult, error) {
+	if params.SortOrder == "" {
+		params.SortOrder = c.defaultSortOrder
+	}
 	queryJSON, err := generateWorkflowLogsQuery(params, c.stream, c.logger)
 	if err != nil {
 		c.logger.Error("Failed to marshal query", slog.Any("error", err))
@@ -278,6 +1539,7 @@ func (c *Client) GetWorkflowLogs(ctx context.Context, params WorkflowLogsParams)
 		Logs:       logs,
 		TotalCount: extractTotalCount(countResp),
 		Took:       openObserveResp.Took,
+		NearLimit:  c.checkNearLimit("workflow logs", params.Namespace, len(logs), params.Limit),
 	}, nil
 }
 
@@ -367,7 +1629,7 @@ func parseWorkflowLogEntry(timestamp int64, source map[string]interface{}) Workf
 // CreateAlert creates an alert in OpenObserve and returns the backend alert ID.
 func (c *Client) CreateAlert(ctx context.Context, params LogAlertParams) (string, error) {
 	// Generate alert configuration JSON
-	alertJSON, err := generateAlertConfig(params, c.stream, c.logger)
+	alertJSON, err := generateAlertConfig(c.withMessageField(params), c.stream, c.logger)
 	if err != nil {
 		c.logger.Error("Failed to generate alert config", slog.Any("error", err))
 		return "", fmt.Errorf("failed to generate alert config: %w", err)
@@ -388,7 +1650,7 @@ func (c *Client) CreateAlert(ctx context.Context, params LogAlertParams) (string
 	req.SetBasicAuth(c.user, c.token)
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.alertRetry)
 	if err != nil {
 		c.logger.Error("Failed to execute alert creation request", slog.Any("error", err))
 		return "", fmt.Errorf("failed to execute request: %w", err)
@@ -407,6 +1669,9 @@ func (c *Client) CreateAlert(ctx context.Context, params LogAlertParams) (string
 		c.logger.Error("OpenObserve returned error",
 			slog.Int("statusCode", resp.StatusCode),
 			slog.String("body", string(body)))
+		if isAlreadyExistsResponse(resp.StatusCode, body) {
+			return "", fmt.Errorf("%w: %s", ErrAlertAlreadyExists, string(body))
+		}
 		return "", fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
 	}
 
@@ -444,7 +1709,7 @@ func (c *Client) DeleteAlert(ctx context.Context, alertName string) (string, err
 	req.SetBasicAuth(c.user, c.token)
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.alertRetry)
 	if err != nil {
 		c.logger.Error("Failed to execute alert deletion request", slog.Any("error", err))
 		return "", fmt.Errorf("failed to execute request: %w", err)
@@ -469,44 +1734,104 @@ func (c *Client) DeleteAlert(ctx context.Context, alertName string) (string, err
 	return alertID, nil
 }
 
-// getAlertIDByName looks up an alert's ID by its name using the v2 list alerts API.
-func (c *Client) getAlertIDByName(ctx context.Context, name string) (string, error) {
+// AlertSummary is an entry of the OpenObserve alert list.
+type AlertSummary struct {
+	ID      string `json:"-"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// AlertPage is a page of the alert list, ordered by name.
+type AlertPage struct {
+	Alerts []AlertSummary `json:"alerts"`
+	Total  int            `json:"total"`
+	// Page is the one-based number of the page and PageSize the maximum number of alerts on
+	// it. NextPage is the number of the following page, or zero on the last page.
+	Page     int `json:"page"`
+	PageSize int `json:"pageSize"`
+	NextPage int `json:"nextPage,omitempty"`
+}
+
+// listAlerts returns every alert of the organization using the v2 list alerts API.
+func (c *Client) listAlerts(ctx context.Context) ([]AlertSummary, error) {
 	url := fmt.Sprintf("%s/api/v2/%s/alerts", c.baseURL, c.org)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.SetBasicAuth(c.user, c.token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.alertRetry)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
 	}
 
 	var result struct {
 		List []struct {
 			AlertID string `json:"alert_id"`
 			Name    string `json:"name"`
+			Enabled bool   `json:"enabled"`
 		} `json:"list"`
 	}
 	if err := json.Unmarshal(body, &result); err != nil {
-		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	alerts := make([]AlertSummary, 0, len(result.List))
 	for _, alert := range result.List {
+		alerts = append(alerts, AlertSummary{ID: alert.AlertID, Name: alert.Name, Enabled: alert.Enabled})
+	}
+	return alerts, nil
+}
+
+// ListAlerts returns the given one-based page of the organization's alerts, ordered by name,
+// with at most pageSize alerts. OpenObserve's list API does not report the total number of
+// alerts, so the list is fetched in full and paginated here; alert lists are small enough
+// for this even in large organizations.
+func (c *Client) ListAlerts(ctx context.Context, page, pageSize int) (*AlertPage, error) {
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("page and page size must be positive")
+	}
+	alerts, err := c.listAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(alerts, func(i, j int) bool { return alerts[i].Name < alerts[j].Name })
+
+	result := &AlertPage{Alerts: []AlertSummary{}, Total: len(alerts), Page: page, PageSize: pageSize}
+	start := (page - 1) * pageSize
+	if start >= len(alerts) {
+		return result, nil
+	}
+	end := min(start+pageSize, len(alerts))
+	result.Alerts = alerts[start:end]
+	if end < len(alerts) {
+		result.NextPage = page + 1
+	}
+	return result, nil
+}
+
+// getAlertIDByName looks up an alert's ID by its name using the v2 list alerts API.
+func (c *Client) getAlertIDByName(ctx context.Context, name string) (string, error) {
+	alerts, err := c.listAlerts(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, alert := range alerts {
 		if alert.Name == name {
-			return alert.AlertID, nil
+			return alert.ID, nil
 		}
 	}
 
@@ -542,7 +1867,7 @@ func (c *Client) UpdateAlert(ctx context.Context, alertName string, params LogAl
 	params.Name = &alertName
 
 	// Generate alert configuration JSON
-	alertJSON, err := generateAlertConfig(params, c.stream, c.logger)
+	alertJSON, err := generateAlertConfig(c.withMessageField(params), c.stream, c.logger)
 	if err != nil {
 		c.logger.Error("Failed to generate alert config", slog.Any("error", err))
 		return "", fmt.Errorf("failed to generate alert config: %w", err)
@@ -563,7 +1888,7 @@ func (c *Client) UpdateAlert(ctx context.Context, alertName string, params LogAl
 	req.SetBasicAuth(c.user, c.token)
 
 	// Execute request
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.alertRetry)
 	if err != nil {
 		c.logger.Error("Failed to execute alert update request", slog.Any("error", err))
 		return "", fmt.Errorf("failed to execute request: %w", err)
@@ -606,7 +1931,7 @@ func (c *Client) GetAlert(ctx context.Context, alertName string) (*AlertDetail,
 	}
 	req.SetBasicAuth(c.user, c.token)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doWithRetry(req, c.alertRetry)
 	if err != nil {
 		c.logger.Error("Failed to execute get alert request", slog.Any("error", err))
 		return nil, fmt.Errorf("failed to execute request: %w", err)
@@ -682,50 +2007,116 @@ func (c *Client) GetAlert(ctx context.Context, alertName string) (*AlertDetail,
 	return detail, nil
 }
 
-// parseApplicationLogEntry parses an application log from OpenObserve response
-func (c *Client) parseApplicationLogEntry(timestamp int64, source map[string]interface{}) ComponentLogsEntry {
-	entry := ComponentLogsEntry{
-		Timestamp: time.UnixMicro(timestamp),
+// scalarString returns the string representation of a scalar JSON value. Numbers are
+// formatted without exponent or trailing zeros, so a numeric id such as 12345 is kept as
+// "12345". It reports false for null, objects and arrays.
+func scalarString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case json.Number:
+		return v.String(), true
+	default:
+		return "", false
 	}
+}
 
-	// Parse fields with type assertions
-	if log, ok := source["log"].(string); ok {
-		entry.Log = log
-	}
-	if logLevel, ok := source["logLevel"].(string); ok && strings.TrimSpace(logLevel) != "" {
-		entry.LogLevel = strings.TrimSpace(logLevel)
-	} else {
-		entry.LogLevel = extractLogLevel(entry.Log)
-	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_component_uid"].(string); ok {
-		entry.ComponentUID = v
-	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_component"].(string); ok {
-		entry.ComponentName = v
-	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_environment_uid"].(string); ok {
-		entry.EnvironmentUID = v
-	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_environment"].(string); ok {
-		entry.EnvironmentName = v
+// labelString returns the string representation of a label value: scalars as scalarString
+// does, and objects and arrays, which some shippers nest under a label, as compact JSON so
+// that no label data is lost. It reports false for null.
+func labelString(v interface{}) (string, bool) {
+	if s, ok := scalarString(v); ok {
+		return s, true
+	}
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b), true
+		}
 	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_project_uid"].(string); ok {
-		entry.ProjectUID = v
+	return "", false
+}
+
+// timestampMicros returns a timestamp field value in microseconds since the epoch. Numbers
+// (or numeric strings) are taken as microseconds; other strings are parsed as RFC3339.
+func timestampMicros(v interface{}) int64 {
+	switch v := v.(type) {
+	case float64:
+		return int64(v)
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+	case string:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t.UnixMicro()
+		}
 	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_project"].(string); ok {
-		entry.ProjectName = v
+	return 0
+}
+
+// SeverityClassDefault is the SeverityClasses key classifying the levels not listed.
+const SeverityClassDefault = "*"
+
+// severityClass returns the display class of a log level, or "" if severity classes are
+// not configured or the level has none.
+func (c *Client) severityClass(level string) string {
+	if len(c.severityClasses) == 0 {
+		return ""
 	}
-	if v, ok := source["kubernetes_labels_openchoreo_dev_namespace"].(string); ok {
-		entry.Namespace = v
+	if class, ok := c.severityClasses[strings.ToUpper(level)]; ok {
+		return class
 	}
-	if v, ok := source["kubernetes_pod_name"].(string); ok {
-		entry.PodName = v
+	return c.severityClasses[SeverityClassDefault]
+}
+
+// parseApplicationLogEntry parses an application log from OpenObserve response
+func (c *Client) parseApplicationLogEntry(source map[string]interface{}) ComponentLogsEntry {
+	entry := ComponentLogsEntry{
+		Timestamp: time.UnixMicro(timestampMicros(source[c.timestampField])),
 	}
-	if v, ok := source["kubernetes_namespace_name"].(string); ok {
-		entry.PodNamespace = v
+
+	// Shippers do not agree on field types (a pod id or label may arrive as a number or a
+	// bool), so scalar values are coerced to strings rather than dropped, and labels nested
+	// as objects or arrays are kept as JSON.
+	if log, ok := scalarString(source["log"]); ok {
+		entry.Log = log
+		if c.stripANSI {
+			if stripped := stripANSI(log); stripped != log {
+				entry.Log, entry.RawLog = stripped, log
+			}
+		}
 	}
-	if v, ok := source["kubernetes_container_name"].(string); ok {
-		entry.ContainerName = v
+	entry.LogLevel = c.entryLogLevel(source, entry.Log)
+	entry.SeverityClass = c.severityClass(entry.LogLevel)
+	fields := []struct {
+		key    string
+		target *string
+	}{
+		{"kubernetes_labels_openchoreo_dev_component_uid", &entry.ComponentUID},
+		{"kubernetes_labels_openchoreo_dev_component", &entry.ComponentName},
+		{"kubernetes_labels_openchoreo_dev_environment_uid", &entry.EnvironmentUID},
+		{"kubernetes_labels_openchoreo_dev_environment", &entry.EnvironmentName},
+		{"kubernetes_labels_openchoreo_dev_project_uid", &entry.ProjectUID},
+		{"kubernetes_labels_openchoreo_dev_project", &entry.ProjectName},
+		{"kubernetes_labels_openchoreo_dev_namespace", &entry.Namespace},
+		{"kubernetes_pod_name", &entry.PodName},
+		{"kubernetes_pod_id", &entry.PodID},
+		{"kubernetes_namespace_name", &entry.PodNamespace},
+		{"kubernetes_container_name", &entry.ContainerName},
+		{c.streamField, &entry.Stream},
+	}
+	for _, f := range fields {
+		if v, ok := labelString(source[f.key]); ok {
+			*f.target = v
+		}
 	}
 
 	return entry
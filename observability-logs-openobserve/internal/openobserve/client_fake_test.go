@@ -0,0 +1,295 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testAlertParams returns valid parameters for an alert named name.
+func testAlertParams(name string) LogAlertParams {
+	enabled := true
+	return LogAlertParams{
+		Name:           &name,
+		Namespace:      "test-ns",
+		SearchPattern:  "panic",
+		Operator:       "gt",
+		ThresholdValue: 5,
+		Window:         "5m",
+		Interval:       "1m",
+		Enabled:        &enabled,
+	}
+}
+
+func TestClient_FakeServer_GetComponentLogs(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		params   ComponentLogsParams
+		password string
+		failure  *fakeFailure
+		wantErr  string
+		// wantRequests is the number of search requests expected.
+		wantRequests int
+	}{
+		{name: "success", params: params, wantRequests: 2},
+		{name: "missing namespace", params: ComponentLogsParams{}, wantErr: "namespace is required"},
+		{name: "wrong credentials", params: params, password: "wrong", wantErr: "status 401", wantRequests: 1},
+		{name: "server error", params: params, failure: &fakeFailure{http.StatusInternalServerError, "boom"}, wantErr: "status 500: boom", wantRequests: 1},
+		{name: "malformed response", params: params, failure: &fakeFailure{http.StatusOK, fakeMalformedBody}, wantErr: "failed to unmarshal response", wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOpenObserve(t)
+			server.hits = []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "started", "logLevel": "INFO", "kubernetes_pod_name": "api-1"},
+			}
+			server.total = 1
+			if tt.failure != nil {
+				server.fail(fakeAPISearch, tt.failure.status, tt.failure.body)
+			}
+			client := newTestClient(server.URL)
+			if tt.password != "" {
+				client = NewClient(server.URL, "default", "default", "k8s_events", "admin", tt.password, testLogger())
+			}
+
+			result, err := client.GetComponentLogs(context.Background(), tt.params)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if result.TotalCount != 1 || len(result.Logs) != 1 || result.Logs[0].Log != "started" ||
+				result.Logs[0].PodName != "api-1" {
+				t.Errorf("unexpected result: %+v", result)
+			}
+			if got := len(server.received()); got != tt.wantRequests {
+				t.Errorf("expected %d requests, got %d", tt.wantRequests, got)
+			}
+		})
+	}
+}
+
+func TestClient_FakeServer_CountComponentLogs(t *testing.T) {
+	server := newFakeOpenObserve(t)
+	server.total = 4200
+
+	client := newTestClient(server.URL)
+	result, err := client.CountComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Count != 4200 {
+		t.Errorf("expected count 4200, got %d", result.Count)
+	}
+	if requests := server.received(); len(requests) != 1 || !strings.Contains(requests[0].sql, "count(*)") {
+		t.Errorf("expected a single count query, got %+v", requests)
+	}
+}
+
+func TestClient_FakeServer_CreateAlert(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		params   LogAlertParams
+		failure  *fakeFailure
+		wantErr  string
+		// wantAlreadyExists expects the error to wrap ErrAlertAlreadyExists.
+		wantAlreadyExists bool
+		wantRequests      int
+	}{
+		{name: "success", params: testAlertParams("new-alert"), wantRequests: 1},
+		{name: "already exists", existing: "new-alert", params: testAlertParams("new-alert"), wantAlreadyExists: true, wantRequests: 1},
+		{name: "invalid config", params: LogAlertParams{}, wantErr: "failed to generate alert config"},
+		{name: "server error", params: testAlertParams("new-alert"), failure: &fakeFailure{http.StatusInternalServerError, "boom"}, wantErr: "status 500: boom", wantRequests: 1},
+		{name: "missing id", params: testAlertParams("new-alert"), failure: &fakeFailure{http.StatusOK, "{}"}, wantErr: "missing id", wantRequests: 1},
+		{name: "malformed response", params: testAlertParams("new-alert"), failure: &fakeFailure{http.StatusCreated, fakeMalformedBody}, wantErr: "missing id", wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOpenObserve(t)
+			if tt.existing != "" {
+				server.addAlert(tt.existing)
+			}
+			if tt.failure != nil {
+				server.fail(fakeAPICreateAlert, tt.failure.status, tt.failure.body)
+			}
+			client := newTestClient(server.URL)
+
+			id, err := client.CreateAlert(context.Background(), tt.params)
+			switch {
+			case tt.wantAlreadyExists:
+				if !errors.Is(err, ErrAlertAlreadyExists) {
+					t.Errorf("expected ErrAlertAlreadyExists, got %v", err)
+				}
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case server.alerts[id] != *tt.params.Name:
+				t.Errorf("expected alert %q to be stored as %q, got %v", *tt.params.Name, id, server.alerts)
+			}
+			if got := len(server.received()); got != tt.wantRequests {
+				t.Errorf("expected %d requests, got %d", tt.wantRequests, got)
+			}
+		})
+	}
+}
+
+func TestClient_FakeServer_DeleteAlert(t *testing.T) {
+	tests := []struct {
+		name     string
+		alert    string
+		failures map[string]fakeFailure
+		wantErr  string
+		// wantDeleted expects the alert to be removed from the server.
+		wantDeleted bool
+	}{
+		{name: "success", alert: "my-alert", wantDeleted: true},
+		{name: "unknown alert", alert: "other-alert", wantErr: `alert "other-alert" not found`},
+		{
+			name:     "list failed",
+			alert:    "my-alert",
+			failures: map[string]fakeFailure{fakeAPIListAlerts: {http.StatusForbidden, "forbidden"}},
+			wantErr:  "status 403: forbidden",
+		},
+		{
+			name:     "malformed list",
+			alert:    "my-alert",
+			failures: map[string]fakeFailure{fakeAPIListAlerts: {http.StatusOK, fakeMalformedBody}},
+			wantErr:  "failed to unmarshal response",
+		},
+		{
+			name:     "delete failed",
+			alert:    "my-alert",
+			failures: map[string]fakeFailure{fakeAPIDeleteAlert: {http.StatusInternalServerError, "boom"}},
+			wantErr:  "status 500: boom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOpenObserve(t)
+			id := server.addAlert("my-alert")
+			for api, failure := range tt.failures {
+				server.fail(api, failure.status, failure.body)
+			}
+			client := newTestClient(server.URL)
+
+			gotID, err := client.DeleteAlert(context.Background(), tt.alert)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if gotID != id {
+				t.Errorf("expected alert ID %q, got %q", id, gotID)
+			}
+			if _, ok := server.alerts[id]; ok == tt.wantDeleted {
+				t.Errorf("expected deleted=%v, got alerts %v", tt.wantDeleted, server.alerts)
+			}
+		})
+	}
+}
+
+func TestClient_FakeServer_GetAlertIDByName(t *testing.T) {
+	tests := []struct {
+		name    string
+		alert   string
+		failure *fakeFailure
+		wantID  string
+		wantErr string
+	}{
+		{name: "found", alert: "second", wantID: "alert-2"},
+		{name: "not found", alert: "third", wantErr: `alert "third" not found`},
+		{name: "server error", alert: "second", failure: &fakeFailure{http.StatusBadGateway, "bad gateway"}, wantErr: "status 502"},
+		{name: "malformed response", alert: "second", failure: &fakeFailure{http.StatusOK, fakeMalformedBody}, wantErr: "failed to unmarshal response"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOpenObserve(t)
+			server.addAlert("first")
+			server.addAlert("second")
+			if tt.failure != nil {
+				server.fail(fakeAPIListAlerts, tt.failure.status, tt.failure.body)
+			}
+			client := newTestClient(server.URL)
+
+			id, err := client.getAlertIDByName(context.Background(), tt.alert)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != tt.wantID {
+				t.Errorf("expected ID %q, got %q", tt.wantID, id)
+			}
+		})
+	}
+}
+
+func TestClient_FakeServer_QueryGenerators(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	// Each query method must send the SQL of its generator to the search API.
+	tests := []struct {
+		name    string
+		run     func(c *Client) error
+		wantSQL string
+	}{
+		{"volume", func(c *Client) error { _, err := c.GetComponentLogVolume(context.Background(), params); return err }, "GROUP BY kubernetes_labels_openchoreo_dev_component_uid"},
+		{"distinct", func(c *Client) error { _, err := c.GetDistinctLogMessages(context.Background(), params); return err }, "GROUP BY log"},
+		{"pods", func(c *Client) error { _, err := c.GetComponentPods(context.Background(), params); return err }, "GROUP BY kubernetes_pod_id"},
+		{"pod lines", func(c *Client) error {
+			_, err := c.GetComponentPodLines(context.Background(), params, false)
+			return err
+		}, "kubernetes_pod_id"},
+		{"histogram", func(c *Client) error {
+			_, err := c.GetComponentLogHistogram(context.Background(), params, time.Hour)
+			return err
+		}, "histogram(_timestamp, '3600 second')"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeOpenObserve(t)
+			if err := tt.run(newTestClient(server.URL)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			requests := server.received()
+			if len(requests) == 0 || !strings.Contains(requests[0].sql, tt.wantSQL) {
+				t.Errorf("expected SQL containing %q, got %+v", tt.wantSQL, requests)
+			}
+
+			server.fail(fakeAPISearch, http.StatusInternalServerError, "boom")
+			if err := tt.run(newTestClient(server.URL)); err == nil || !strings.Contains(err.Error(), "status 500") {
+				t.Errorf("expected a status 500 error, got %v", err)
+			}
+		})
+	}
+}
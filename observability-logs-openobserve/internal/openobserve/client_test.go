@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -102,6 +105,7 @@ func TestGetComponentLogs(t *testing.T) {
 					"kubernetes_labels_openchoreo_dev_component":     "my-comp",
 					"kubernetes_labels_openchoreo_dev_namespace":     "test-ns",
 					"kubernetes_pod_name":                            "pod-1",
+					"kubernetes_pod_id":                              "pod-uid-1",
 					"kubernetes_namespace_name":                      "k8s-ns",
 					"kubernetes_container_name":                      "main",
 				},
@@ -119,6 +123,7 @@ func TestGetComponentLogs(t *testing.T) {
 	client := newTestClient(server.URL)
 	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
 		Namespace: "test-ns",
+		SortOrder: "asc",
 		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
 	})
@@ -149,6 +154,9 @@ func TestGetComponentLogs(t *testing.T) {
 	if log0.PodName != "pod-1" {
 		t.Errorf("unexpected podName: %q", log0.PodName)
 	}
+	if log0.PodID != "pod-uid-1" {
+		t.Errorf("unexpected podId: %q", log0.PodID)
+	}
 
 	// Second log has no explicit logLevel, should be extracted from content
 	log1 := result.Logs[1]
@@ -157,6 +165,63 @@ func TestGetComponentLogs(t *testing.T) {
 	}
 }
 
+func TestGetComponentLogs_MixedFieldTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"total":1,"hits":[{"total":1}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":5,"total":1,"hits":[{
+			"_timestamp": 1735732800000000,
+			"log": "started",
+			"logLevel": 30,
+			"kubernetes_labels_openchoreo_dev_component_uid": 1234567890123,
+			"kubernetes_labels_openchoreo_dev_component": true,
+			"kubernetes_labels_openchoreo_dev_environment_uid": 1.5,
+			"kubernetes_labels_openchoreo_dev_project_uid": null,
+			"kubernetes_labels_openchoreo_dev_project": {"name": "shop", "tier": 1},
+			"kubernetes_labels_openchoreo_dev_namespace": ["a", 2, false],
+			"kubernetes_pod_id": 42,
+			"kubernetes_pod_name": "pod-1"
+		}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 1 {
+		t.Fatalf("expected 1 log, got %d", len(result.Logs))
+	}
+
+	entry := result.Logs[0]
+	checks := []struct {
+		field, got, want string
+	}{
+		{"logLevel", entry.LogLevel, "30"},
+		{"componentUid", entry.ComponentUID, "1234567890123"},
+		{"componentName", entry.ComponentName, "true"},
+		{"environmentUid", entry.EnvironmentUID, "1.5"},
+		{"projectUid", entry.ProjectUID, ""},
+		{"projectName", entry.ProjectName, `{"name":"shop","tier":1}`},
+		{"namespace", entry.Namespace, `["a",2,false]`},
+		{"podId", entry.PodID, "42"},
+		{"podName", entry.PodName, "pod-1"},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("expected %s %q, got %q", c.field, c.want, c.got)
+		}
+	}
+}
+
 func TestGetComponentLogs_ServerError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -265,6 +330,42 @@ func TestCreateAlert(t *testing.T) {
 	}
 }
 
+func TestCreateAlert_MessageField(t *testing.T) {
+	var sql string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			QueryCondition struct {
+				SQL string `json:"sql"`
+			} `json:"query_condition"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sql = body.QueryCondition.SQL
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "alert-123"})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", ClientOptions{MessageField: "message"}, testLogger())
+	enabled := true
+	if _, err := client.CreateAlert(context.Background(), LogAlertParams{
+		SearchPattern:  "timeout",
+		Operator:       "gt",
+		ThresholdValue: 5,
+		Window:         "5m",
+		Interval:       "1m",
+		Enabled:        &enabled,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(sql, "str_match(message, 'timeout')") {
+		t.Errorf("expected the alert to match the message field, got %s", sql)
+	}
+	if got := ExtractSearchPattern(sql); got != "timeout" {
+		t.Errorf("expected the pattern to be read back, got %q", got)
+	}
+}
+
 func TestCreateAlert_ServerError(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusBadRequest)
@@ -729,3 +830,982 @@ func TestParseWorkflowLogEntry(t *testing.T) {
 		t.Error("_timestamp should not be in metadata")
 	}
 }
+
+func TestGetComponentLogVolume(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "GROUP BY") {
+			t.Errorf("expected grouped query, got %s", body)
+		}
+		resp := OpenObserveResponse{
+			Took: 7,
+			Hits: []map[string]interface{}{
+				{"component_uid": "comp-2", "component_name": "beta", "total": float64(10)},
+				{"component_uid": "comp-1", "component_name": "alpha", "total": float64(10)},
+				{"component_uid": "comp-3", "component_name": "gamma", "total": float64(25)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentLogVolume(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Took != 7 {
+		t.Errorf("expected took 7, got %d", result.Took)
+	}
+	want := []string{"gamma", "alpha", "beta"}
+	if len(result.Components) != len(want) {
+		t.Fatalf("expected %d components, got %d", len(want), len(result.Components))
+	}
+	for i, name := range want {
+		if result.Components[i].ComponentName != name {
+			t.Errorf("component %d: expected %q, got %q", i, name, result.Components[i].ComponentName)
+		}
+	}
+	if result.Components[0].ComponentUID != "comp-3" || result.Components[0].Count != 25 {
+		t.Errorf("unexpected first component: %+v", result.Components[0])
+	}
+}
+
+func TestGetComponentLogVolume_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.GetComponentLogVolume(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+	})
+	if err == nil {
+		t.Fatal("expected error for server error response")
+	}
+}
+
+func TestGetComponentLogs_NearLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		hits  int
+		limit int
+		ratio float64
+		want  bool
+	}{
+		{"below default ratio", 8, 10, 0, false},
+		{"at default ratio", 9, 10, 0, true},
+		{"full page", 10, 10, 0, true},
+		{"default limit applies when unset", 90, 0, 0, true},
+		{"custom ratio", 6, 10, 0.5, true},
+		{"below custom ratio", 4, 10, 0.5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp := OpenObserveResponse{}
+				if isCountQuery(r) {
+					resp.Hits = []map[string]interface{}{{"total": float64(1000)}}
+				} else {
+					for i := 0; i < tt.hits; i++ {
+						resp.Hits = append(resp.Hits, map[string]interface{}{"_timestamp": float64(i), "log": "line"})
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			c := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", ClientOptions{NearLimitRatio: tt.ratio}, testLogger())
+			result, err := c.GetComponentLogs(context.Background(), ComponentLogsParams{
+				Namespace: "ns",
+				StartTime: time.Now().Add(-time.Hour),
+				EndTime:   time.Now(),
+				Limit:     tt.limit,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.NearLimit != tt.want {
+				t.Errorf("expected NearLimit %v, got %v", tt.want, result.NearLimit)
+			}
+		})
+	}
+}
+
+func TestGetComponentLogs_AtTimestamp(t *testing.T) {
+	at := time.Date(2025, 1, 1, 12, 0, 0, 123456000, time.UTC).UnixMicro()
+
+	var queries []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		queries = append(queries, body["query"].(map[string]interface{}))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	c := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", ClientOptions{AtTimestampEpsilon: 5 * time.Microsecond}, testLogger())
+	_, err := c.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace:   "ns",
+		StartTime:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:     time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		SortOrder:   "DESC",
+		AtTimestamp: at,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("expected logs and count queries, got %d", len(queries))
+	}
+	for _, q := range queries {
+		if int64(q["start_time"].(float64)) != at-5 || int64(q["end_time"].(float64)) != at+5 {
+			t.Errorf("expected window [%d, %d], got [%v, %v]", at-5, at+5, q["start_time"], q["end_time"])
+		}
+	}
+	if sql := queries[0]["sql"].(string); !strings.Contains(sql, "ORDER BY _timestamp ASC") {
+		t.Errorf("expected ascending order for a pinned timestamp, got %q", sql)
+	}
+}
+
+func TestLogAlertParams_Validate(t *testing.T) {
+	valid := func() LogAlertParams {
+		return LogAlertParams{
+			SearchPattern:  "error",
+			Operator:       "gt",
+			ThresholdValue: 5,
+			Window:         "5m",
+			Interval:       "1m",
+		}
+	}
+
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("expected valid params, got %v", err)
+	}
+
+	zeroThreshold := valid()
+	zeroThreshold.ThresholdValue = 0
+	if err := zeroThreshold.Validate(); err != nil {
+		t.Errorf("expected zero threshold to be valid, got %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		mutate    func(*LogAlertParams)
+		wantField string
+	}{
+		{"empty search pattern", func(p *LogAlertParams) { p.SearchPattern = "  " }, "source.query"},
+		{"negative threshold", func(p *LogAlertParams) { p.ThresholdValue = -1 }, "condition.threshold"},
+		{"unknown operator", func(p *LogAlertParams) { p.Operator = "between" }, "condition.operator"},
+		{"negative window", func(p *LogAlertParams) { p.Window = "-5m" }, "condition.window"},
+		{"zero window", func(p *LogAlertParams) { p.Window = "0m" }, "condition.window"},
+		{"malformed window", func(p *LogAlertParams) { p.Window = "5s" }, "condition.window"},
+		{"zero interval", func(p *LogAlertParams) { p.Interval = "0h" }, "condition.interval"},
+		{"empty interval", func(p *LogAlertParams) { p.Interval = "" }, "condition.interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := valid()
+			tt.mutate(&params)
+			err := params.Validate()
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.HasPrefix(err.Error(), tt.wantField) {
+				t.Errorf("expected error about %s, got %q", tt.wantField, err.Error())
+			}
+		})
+	}
+}
+
+func TestGetDistinctLogMessages(t *testing.T) {
+	lastSeen := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Took: 4,
+			Hits: []map[string]interface{}{
+				{"log": "connection refused", "total": float64(12), "last_seen": float64(lastSeen.UnixMicro())},
+				{"log": "timeout", "total": float64(3), "last_seen": float64(lastSeen.Add(-time.Minute).UnixMicro())},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetDistinctLogMessages(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Took != 4 || len(result.Messages) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Messages[0]
+	if first.Log != "connection refused" || first.Count != 12 || !first.LastSeen.Equal(lastSeen) {
+		t.Errorf("unexpected first message: %+v", first)
+	}
+}
+
+func TestGetDistinctLogMessages_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if _, err := client.GetDistinctLogMessages(context.Background(), ComponentLogsParams{Namespace: "test-ns"}); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestGetComponentPercentiles(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Took: 6,
+			Hits: []map[string]interface{}{
+				{"component_uid": "c-1", "component_name": "api", "total": float64(40), "p50": float64(12), "p95": float64(87.5)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentPercentiles(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, "latency_ms", []float64{0.5, 0.95})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Field != "latency_ms" || result.Took != 6 || len(result.Components) != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	component := result.Components[0]
+	if component.ComponentUID != "c-1" || component.ComponentName != "api" || component.Count != 40 {
+		t.Errorf("unexpected component: %+v", component)
+	}
+	if component.Percentiles["p50"] != 12 || component.Percentiles["p95"] != 87.5 {
+		t.Errorf("unexpected percentiles: %+v", component.Percentiles)
+	}
+}
+
+func TestCreateAlert_AlreadyExists(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"conflict status", http.StatusConflict, `{"message":"conflict"}`},
+		{"already exists message", http.StatusBadRequest, `{"message":"Alert already exists"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := newTestClient(server.URL)
+			enabled := true
+			name := "test-alert"
+			_, err := client.CreateAlert(context.Background(), LogAlertParams{
+				Name:     &name,
+				Operator: "gt",
+				Window:   "5m",
+				Interval: "1m",
+				Enabled:  &enabled,
+			})
+			if !errors.Is(err, ErrAlertAlreadyExists) {
+				t.Fatalf("expected ErrAlertAlreadyExists, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetComponentPods(t *testing.T) {
+	lastSeen := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Took: 2,
+			Hits: []map[string]interface{}{
+				{"pod_id": "uid-2", "pod_name": "api-7d9f-b", "total": float64(20), "last_seen": float64(lastSeen.UnixMicro())},
+				{"pod_id": "uid-1", "pod_name": "api-7d9f-a", "total": float64(5), "last_seen": float64(lastSeen.Add(-time.Hour).UnixMicro())},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentPods(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Took != 2 || len(result.Pods) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Pods[0]
+	if first.PodID != "uid-2" || first.PodName != "api-7d9f-b" || first.Count != 20 || !first.LastSeen.Equal(lastSeen) {
+		t.Errorf("unexpected first pod: %+v", first)
+	}
+}
+
+func TestLogAlertParams_ValidateWindow(t *testing.T) {
+	tests := []struct {
+		window  string
+		wantErr bool
+	}{
+		{"30m", false},
+		{"24h", false},
+		{"1441m", true},
+		{"48h", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			err := LogAlertParams{Window: tt.window}.ValidateWindow(24 * time.Hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateWindow(%q) error = %v, wantErr %v", tt.window, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "condition.window") {
+				t.Errorf("expected error to name condition.window, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetComponentLogs_LogsResultSize(t *testing.T) {
+	var hitsBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(2)}}})
+			return
+		}
+		w.Write(hitsBody)
+	}))
+	defer server.Close()
+
+	var err error
+	hitsBody, err = json.Marshal(OpenObserveResponse{
+		Took: 3,
+		Hits: []map[string]interface{}{
+			{"_timestamp": float64(1735732800000000), "log": "first"},
+			{"_timestamp": float64(1735732801000000), "log": "second"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal response: %v", err)
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	client := NewClient(server.URL, "default", "default", "k8s_events", "admin", "token", logger)
+	if _, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		Limit:     50,
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var record map[string]interface{}
+	for _, line := range bytes.Split(bytes.TrimSpace(logs.Bytes()), []byte("\n")) {
+		if err := json.Unmarshal(line, &record); err == nil && record["msg"] == "Component logs query completed" {
+			break
+		}
+		record = nil
+	}
+	if record == nil {
+		t.Fatalf("expected a query completed log record, got: %s", logs.String())
+	}
+	if record["hits"] != float64(2) || record["limit"] != float64(50) || record["responseBytes"] != float64(len(hitsBody)) {
+		t.Errorf("unexpected log record: %v", record)
+	}
+}
+
+func TestGetComponentLogs_FallbackStreams(t *testing.T) {
+	// Only the "archive" stream has logs; the primary and the first fallback are empty.
+	var logQueries int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := isCountQuery(r)
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		archive := strings.Contains(body.Query.SQL, `FROM "archive"`)
+
+		var resp OpenObserveResponse
+		switch {
+		case count && archive:
+			resp.Hits = []map[string]interface{}{{"total": float64(1)}}
+		case count:
+			resp.Hits = []map[string]interface{}{{"total": float64(0)}}
+		default:
+			logQueries++
+			if archive {
+				resp.Hits = []map[string]interface{}{{"_timestamp": float64(1735732800000000), "log": "archived"}}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{FallbackStreams: []string{"combined", "archive", "unused"}}, testLogger())
+	result, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stream != "archive" || len(result.Logs) != 1 || result.TotalCount != 1 {
+		t.Errorf("expected the archive stream result, got %+v", result)
+	}
+	if logQueries != 3 {
+		t.Errorf("expected 3 streams to be queried before archive returned logs, got %d", logQueries)
+	}
+
+	// Without fallback streams only the primary stream is queried and no stream is reported.
+	logQueries = 0
+	result, err = newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Stream != "" || len(result.Logs) != 0 || logQueries != 1 {
+		t.Errorf("expected a single empty primary stream query, got %+v (%d queries)", result, logQueries)
+	}
+}
+
+func TestGetComponentLogHistogram(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Took: 3,
+			Hits: []map[string]interface{}{
+				{"bucket": "2025-01-01T00:00:00", "total": float64(4)},
+				{"bucket": "2025-01-01T00:05:00", "total": float64(9)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentLogHistogram(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 1, 0, 10, 0, 0, time.UTC),
+	}, 5*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Took != 3 || len(result.Buckets) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	second := result.Buckets[1]
+	if !second.Start.Equal(time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)) || second.Count != 9 {
+		t.Errorf("unexpected bucket: %+v", second)
+	}
+}
+
+func TestGetComponentLogs_CustomTimestampField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"total":1,"hits":[{"total":2}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":2,"total":2,"hits":[
+			{"_timestamp": 1, "event_time": 1735732800000000, "log": "numeric"},
+			{"_timestamp": 1, "event_time": "2025-01-01T12:01:00Z", "log": "rfc3339"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{TimestampField: "event_time"}, testLogger())
+	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		SortOrder: "asc",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 2 {
+		t.Fatalf("expected 2 logs, got %d", len(result.Logs))
+	}
+
+	want := []time.Time{
+		time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 12, 1, 0, 0, time.UTC),
+	}
+	for i, entry := range result.Logs {
+		if !entry.Timestamp.Equal(want[i]) {
+			t.Errorf("log %d: expected timestamp %s, got %s", i, want[i], entry.Timestamp)
+		}
+	}
+}
+
+func TestGetComponentLogs_DefaultSortOrder(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		queries = append(queries, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{DefaultSortOrder: "ASC"}, testLogger())
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(strings.Join(queries, "\n"), "ORDER BY _timestamp ASC") {
+		t.Errorf("expected the configured default order, got %v", queries)
+	}
+
+	// The request's own order wins.
+	queries = nil
+	params.SortOrder = "desc"
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if joined := strings.Join(queries, "\n"); !strings.Contains(joined, "ORDER BY _timestamp DESC") || strings.Contains(joined, "ORDER BY _timestamp ASC") {
+		t.Errorf("expected the requested order, got %v", queries)
+	}
+}
+
+func TestGetComponentLogsWithHistogram(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), "histogram("):
+			w.Write([]byte(`{"took":2,"hits":[{"bucket":"2025-01-01T12:00:00","total":1}]}`))
+		case strings.Contains(string(body), "count(*)"):
+			w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+		default:
+			w.Write([]byte(`{"took":3,"hits":[{"_timestamp":1735732800000000,"log":"hello"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	result, err := client.GetComponentLogsWithHistogram(context.Background(), params, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs.Logs) != 1 || result.Logs.Logs[0].Log != "hello" || result.Logs.TotalCount != 1 {
+		t.Errorf("unexpected logs: %+v", result.Logs)
+	}
+	if len(result.Histogram.Buckets) != 1 || result.Histogram.Buckets[0].Count != 1 {
+		t.Errorf("unexpected histogram: %+v", result.Histogram)
+	}
+}
+
+func TestGetComponentLogsWithHistogram_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "histogram(") {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("histogram failed"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.GetComponentLogsWithHistogram(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, 0)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "500") {
+		t.Errorf("expected the histogram failure to be reported, got: %v", err)
+	}
+}
+
+func TestGetComponentLogs_SampleSortedByTimestamp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"hits":[{"total":1000}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":2,"hits":[
+			{"_timestamp":1735732860000000,"log":"second"},
+			{"_timestamp":1735732920000000,"log":"third"},
+			{"_timestamp":1735732800000000,"log":"first"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		Sample:    true,
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, entry := range result.Logs {
+		got = append(got, entry.Log)
+	}
+	if strings.Join(got, ",") != "third,second,first" {
+		t.Errorf("expected sampled logs newest first, got %v", got)
+	}
+	if result.TotalCount != 1000 {
+		t.Errorf("expected the unsampled total 1000, got %d", result.TotalCount)
+	}
+}
+
+func TestSortLogEntries(t *testing.T) {
+	at := func(sec int) time.Time { return time.Date(2025, 1, 1, 0, 0, sec, 0, time.UTC) }
+	entries := func() []ComponentLogsEntry {
+		return []ComponentLogsEntry{
+			{Timestamp: at(2), Log: "b1"},
+			{Timestamp: at(1), Log: "a"},
+			{Timestamp: at(3), Log: "c"},
+			{Timestamp: at(2), Log: "b2"},
+		}
+	}
+
+	tests := []struct {
+		sortOrder string
+		want      string
+	}{
+		{"asc", "a,b1,b2,c"},
+		{"ASC", "a,b1,b2,c"},
+		{"desc", "c,b1,b2,a"},
+		{"", "c,b1,b2,a"},
+	}
+	for _, tt := range tests {
+		logs := entries()
+		sortLogEntries(logs, tt.sortOrder)
+		var got []string
+		for _, entry := range logs {
+			got = append(got, entry.Log)
+		}
+		if strings.Join(got, ",") != tt.want {
+			t.Errorf("sortLogEntries(%q) = %v, want %s", tt.sortOrder, got, tt.want)
+		}
+	}
+}
+
+func TestGetComponentLogs_StreamField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"hits":[{"total":2}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":2,"hits":[
+			{"_timestamp":1735732860000000,"log":"panic: boom","log_stream":"stderr","stream":"ignored"},
+			{"_timestamp":1735732800000000,"log":"listening"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{StreamField: "log_stream"}, testLogger())
+	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 2 {
+		t.Fatalf("expected 2 logs, got %d", len(result.Logs))
+	}
+	if result.Logs[0].Stream != "stderr" || result.Logs[1].Stream != "" {
+		t.Errorf("unexpected streams: %q, %q", result.Logs[0].Stream, result.Logs[1].Stream)
+	}
+}
+
+func TestGetComponentLogBucketSamples_DefaultInterval(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "'60 second'") {
+			t.Errorf("expected one-minute buckets for a one-hour window, got %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[]}`))
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).GetComponentLogBucketSamples(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC),
+	}, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Interval != "1m0s" || len(result.Buckets) != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestGetComponentLogBucketSamples_DefaultWindow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "'15 second'") {
+			t.Errorf("expected 15-second buckets for the default 15-minute window, got %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[]}`))
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).GetComponentLogBucketSamples(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+	}, 0, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Interval != "15s" {
+		t.Errorf("expected the default window to be split into 15-second buckets, got %q", result.Interval)
+	}
+}
+
+func TestGetComponentLogs_Explain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			// Older OpenObserve versions report no scan details.
+			w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":12,"trace_id":"abc123","scan_size":42.5,"scan_records":9000,"cached_ratio":0.25,
+			"is_partial":true,"took_detail":{"total":12,"file_list_took":3},
+			"hits":[{"_timestamp":1735732800000000,"log":"line"}]}`))
+	}))
+	defer server.Close()
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	result, err := newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Debug != nil {
+		t.Errorf("expected no debug details without explain, got %+v", result.Debug)
+	}
+
+	params.Explain = true
+	result, err = newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Debug == nil || len(result.Debug.Queries) != 2 {
+		t.Fatalf("expected debug details for both queries, got %+v", result.Debug)
+	}
+	logs, count := result.Debug.Queries[0], result.Debug.Queries[1]
+	if logs.Name != "logs" || logs.TraceID != "abc123" || logs.ScanSizeMB != 42.5 || logs.ScanRecords != 9000 ||
+		logs.CachedRatio != 0.25 || !logs.IsPartial || logs.TookDetail["file_list_took"] != float64(3) {
+		t.Errorf("unexpected logs query details: %+v", logs)
+	}
+	if !logs.StartTime.Equal(params.StartTime) || !logs.EndTime.Equal(params.EndTime) || !strings.HasPrefix(logs.SQL, "SELECT * FROM") {
+		t.Errorf("expected the scanned window and SQL, got %+v", logs)
+	}
+	if count.Name != "count" || count.Took != 1 || count.TraceID != "" || count.TookDetail != nil || !strings.Contains(count.SQL, "count(*)") {
+		t.Errorf("unexpected count query details: %+v", count)
+	}
+}
+
+func TestListAlerts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v2/default/alerts" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"list": []map[string]interface{}{
+				{"alert_id": "3", "name": "gamma", "enabled": true},
+				{"alert_id": "1", "name": "alpha", "enabled": false},
+				{"alert_id": "2", "name": "beta", "enabled": true},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	page, err := client.ListAlerts(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []AlertSummary{{ID: "1", Name: "alpha"}, {ID: "2", Name: "beta", Enabled: true}}
+	if !reflect.DeepEqual(page.Alerts, want) || page.Total != 3 || page.NextPage != 2 {
+		t.Errorf("unexpected first page: %+v", page)
+	}
+
+	page, err = client.ListAlerts(context.Background(), 2, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Alerts) != 1 || page.Alerts[0].Name != "gamma" || page.NextPage != 0 {
+		t.Errorf("unexpected last page: %+v", page)
+	}
+
+	page, err = client.ListAlerts(context.Background(), 5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page.Alerts == nil || len(page.Alerts) != 0 || page.Total != 3 {
+		t.Errorf("expected an empty page past the end, got %+v", page)
+	}
+
+	if _, err := client.ListAlerts(context.Background(), 0, 2); err == nil {
+		t.Error("expected error for page 0, got nil")
+	}
+}
+
+func TestOpenObserveResponse_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantTook  int
+		wantTotal int
+		wantErr   bool
+	}{
+		{"numbers", `{"took":12,"total":340,"hits":[{"log":"a"}]}`, 12, 340, false},
+		{"strings", `{"took":"12","total":"340","hits":[{"log":"a"}]}`, 12, 340, false},
+		{"integral floats", `{"took":12.0,"total":"3.4e2","hits":[{"log":"a"}]}`, 12, 340, false},
+		{"null and empty", `{"took":null,"total":"","hits":[{"log":"a"}]}`, 0, 0, false},
+		{"missing", `{"hits":[{"log":"a"}]}`, 0, 0, false},
+		{"not a number", `{"took":12,"total":"many","hits":[]}`, 0, 0, true},
+		{"fraction", `{"took":1.5,"total":340,"hits":[]}`, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp OpenObserveResponse
+			err := json.Unmarshal([]byte(tt.body), &resp)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", resp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Took != tt.wantTook || resp.Total != tt.wantTotal {
+				t.Errorf("expected took %d and total %d, got %d and %d", tt.wantTook, tt.wantTotal, resp.Took, resp.Total)
+			}
+			if len(resp.Hits) != 1 || resp.Hits[0]["log"] != "a" {
+				t.Errorf("expected the hits to be decoded, got %v", resp.Hits)
+			}
+		})
+	}
+}
+
+func TestCountComponentLogs_StringTotals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":"7","total":"1","hits":[{"total":"4200"}]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.CountComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Count != 4200 || result.Took != 7 {
+		t.Errorf("expected count 4200 and took 7, got %+v", result)
+	}
+}
+
+func TestLabelString(t *testing.T) {
+	tests := []struct {
+		value  interface{}
+		want   string
+		wantOK bool
+	}{
+		{"checkout", "checkout", true},
+		{float64(12345), "12345", true},
+		{true, "true", true},
+		{json.Number("7"), "7", true},
+		{map[string]interface{}{"b": float64(2), "a": "x"}, `{"a":"x","b":2}`, true},
+		{[]interface{}{"x", nil}, `["x",null]`, true},
+		{nil, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := labelString(tt.value)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("labelString(%v) = %q, %v, want %q, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
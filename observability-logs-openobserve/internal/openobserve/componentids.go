@@ -0,0 +1,31 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import "fmt"
+
+// DefaultMaxComponentIDs is the number of ComponentIDs a component log query may list when
+// ClientOptions.MaxComponentIDs is not set.
+const DefaultMaxComponentIDs = 100
+
+// ComponentIDsError is returned for a component log query listing more ComponentIDs than
+// allowed (see ClientOptions.MaxComponentIDs). Each ID adds a term to the component filter,
+// which OpenObserve struggles with in the thousands. The query is not run.
+type ComponentIDsError struct {
+	Count int
+	Max   int
+}
+
+func (e *ComponentIDsError) Error() string {
+	return fmt.Sprintf("componentIds lists %d IDs, at most %d are allowed", e.Count, e.Max)
+}
+
+// ValidateComponentIDs checks the ComponentIDs of a component log query. The error is a
+// *ComponentIDsError, suitable for returning to the caller.
+func (c *Client) ValidateComponentIDs(ids []string) error {
+	if len(ids) > c.maxComponentIDs {
+		return &ComponentIDsError{Count: len(ids), Max: c.maxComponentIDs}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// CorrelatedComponent is a component that logged a correlated request, with the number of
+// its logs carrying the correlation ID and the time of the first and last one.
+type CorrelatedComponent struct {
+	ComponentUID  string    `json:"componentUid"`
+	ComponentName string    `json:"componentName"`
+	Count         int       `json:"count"`
+	FirstSeen     time.Time `json:"firstSeen"`
+	LastSeen      time.Time `json:"lastSeen"`
+}
+
+// CorrelatedLogsResult reconstructs a request from the logs carrying its correlation ID:
+// the components that handled it, in the order they first logged it, and its logs across
+// all of them, oldest first.
+type CorrelatedLogsResult struct {
+	CorrelationID string                `json:"correlationId"`
+	Components    []CorrelatedComponent `json:"components"`
+	Logs          *ComponentLogsResult  `json:"logs"`
+}
+
+// generateCorrelatedComponentsQuery generates a query listing the components that wrote
+// logs carrying params.CorrelationID, in the order they first logged it.
+func generateCorrelatedComponentsQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+	if params.CorrelationID == "" {
+		return nil, fmt.Errorf("correlation ID is required for correlated log queries")
+	}
+
+	timestamp := params.timestampColumn()
+	sql := "SELECT kubernetes_labels_openchoreo_dev_component_uid AS component_uid, " +
+		"kubernetes_labels_openchoreo_dev_component AS component_name, count(*) AS total, " +
+		"min(" + timestamp + ") AS first_seen, max(" + timestamp + ") AS last_seen FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component" +
+		" ORDER BY first_seen ASC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated correlated components query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// GetCorrelatedLogs returns the logs carrying params.CorrelationID across every component
+// of the namespace, oldest first, with the components that wrote them. Project and
+// environment filters are ignored, since a request may cross both. The log and component
+// queries run concurrently; if either fails, the other is canceled.
+func (c *Client) GetCorrelatedLogs(ctx context.Context, params ComponentLogsParams) (*CorrelatedLogsResult, error) {
+	if params.CorrelationID == "" {
+		return nil, fmt.Errorf("correlation ID is required for correlated log queries")
+	}
+	params.SortOrder = "asc"
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := CorrelatedLogsResult{CorrelationID: params.CorrelationID}
+	errc := make(chan error, 2)
+	go func() {
+		var err error
+		result.Logs, err = c.GetComponentLogs(ctx, params)
+		errc <- err
+	}()
+	go func() {
+		var err error
+		result.Components, err = c.getCorrelatedComponents(ctx, params)
+		errc <- err
+	}()
+
+	// Report the first failure rather than the cancellation it causes in the other query.
+	var firstErr error
+	for i := 0; i < 2; i++ {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return &result, nil
+}
+
+// getCorrelatedComponents lists the components that wrote logs carrying
+// params.CorrelationID, in the order they first logged it.
+func (c *Client) getCorrelatedComponents(ctx context.Context, params ComponentLogsParams) ([]CorrelatedComponent, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateCorrelatedComponentsQuery(params, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate correlated components query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]CorrelatedComponent, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		component := CorrelatedComponent{
+			ComponentUID:  stringField(hit, "component_uid"),
+			ComponentName: stringField(hit, "component_name"),
+			FirstSeen:     time.UnixMicro(timestampMicros(hit["first_seen"])),
+			LastSeen:      time.UnixMicro(timestampMicros(hit["last_seen"])),
+			Count:         countField(hit, "total"),
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestComponentLogsConditions_CorrelationID(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:     "test-ns",
+		ProjectID:     "proj-1",
+		EnvironmentID: "env-1",
+		ComponentIDs:  []string{"comp-1"},
+		CorrelationID: "req-'42",
+	}
+	conditions := strings.Join(componentLogsConditions(params), " AND ")
+
+	if !strings.Contains(conditions, "correlation_id = 'req-''42'") {
+		t.Errorf("expected correlation filter, got: %s", conditions)
+	}
+	if strings.Contains(conditions, "project_uid") || strings.Contains(conditions, "environment_uid") {
+		t.Errorf("expected project and environment filters to be relaxed, got: %s", conditions)
+	}
+	if !strings.Contains(conditions, "component_uid = 'comp-1'") {
+		t.Errorf("expected the component filter to be kept, got: %s", conditions)
+	}
+
+	params.correlationField = "trace_id"
+	if conditions := strings.Join(componentLogsConditions(params), " AND "); !strings.Contains(conditions, "trace_id = 'req-''42'") {
+		t.Errorf("expected the configured correlation field, got: %s", conditions)
+	}
+}
+
+func TestGenerateCorrelatedComponentsQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:     "test-ns",
+		CorrelationID: "req-42",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	raw, err := generateCorrelatedComponentsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, raw)
+	for _, check := range []string{
+		"min(_timestamp) AS first_seen, max(_timestamp) AS last_seen",
+		"correlation_id = 'req-42'",
+		"GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component",
+		"ORDER BY first_seen ASC",
+	} {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+
+	if _, err := generateCorrelatedComponentsQuery(ComponentLogsParams{Namespace: "test-ns"}, "mystream", testLogger()); err == nil {
+		t.Error("expected an error without a correlation ID")
+	}
+}
+
+func TestGetCorrelatedLogs(t *testing.T) {
+	var mu sync.Mutex
+	var logSQL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sql := body.Query.SQL
+
+		resp := OpenObserveResponse{Took: 2}
+		switch {
+		case strings.Contains(sql, "first_seen"):
+			resp.Hits = []map[string]interface{}{
+				{"component_uid": "c-gw", "component_name": "gateway", "total": float64(2), "first_seen": float64(1735732800000000), "last_seen": float64(1735732800300000)},
+				{"component_uid": "c-api", "component_name": "api", "total": float64(1), "first_seen": float64(1735732800100000), "last_seen": float64(1735732800100000)},
+			}
+		case strings.HasPrefix(sql, "SELECT count(*)"):
+			resp.Hits = []map[string]interface{}{{"total": float64(3)}}
+		default:
+			mu.Lock()
+			logSQL = sql
+			mu.Unlock()
+			resp.Hits = []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "gateway received", "kubernetes_labels_openchoreo_dev_component": "gateway"},
+				{"_timestamp": float64(1735732800100000), "log": "api handled", "kubernetes_labels_openchoreo_dev_component": "api"},
+				{"_timestamp": float64(1735732800300000), "log": "gateway responded", "kubernetes_labels_openchoreo_dev_component": "gateway"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetCorrelatedLogs(context.Background(), ComponentLogsParams{
+		Namespace:     "test-ns",
+		EnvironmentID: "env-1",
+		CorrelationID: "req-42",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(logSQL, "ORDER BY _timestamp ASC") || strings.Contains(logSQL, "env-1") {
+		t.Errorf("expected a chronological query across environments, got: %s", logSQL)
+	}
+	if result.CorrelationID != "req-42" || len(result.Components) != 2 || result.Logs.TotalCount != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if gw := result.Components[0]; gw.ComponentName != "gateway" || gw.Count != 2 ||
+		gw.LastSeen.Sub(gw.FirstSeen) != 300*time.Millisecond {
+		t.Errorf("unexpected component: %+v", gw)
+	}
+	var logs []string
+	for _, entry := range result.Logs.Logs {
+		logs = append(logs, entry.Log)
+	}
+	if got := strings.Join(logs, ", "); got != "gateway received, api handled, gateway responded" {
+		t.Errorf("expected logs oldest first, got: %s", got)
+	}
+}
+
+func TestGetCorrelatedLogs_Errors(t *testing.T) {
+	server := newFakeOpenObserve(t)
+	client := newTestClient(server.URL)
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	if _, err := client.GetCorrelatedLogs(context.Background(), params); err == nil || len(server.received()) != 0 {
+		t.Errorf("expected an error without a correlation ID and no query, got %v", err)
+	}
+
+	params.CorrelationID = "req-42"
+	server.fail(fakeAPISearch, http.StatusInternalServerError, "boom")
+	if _, err := client.GetCorrelatedLogs(context.Background(), params); err == nil || !strings.Contains(err.Error(), "status 500") {
+		t.Errorf("expected a status 500 error, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// logCursor is a position in the component logs ordered by timestamp. Several logs may share
+// a timestamp, so skip counts the logs at timestamp that were already returned on that side
+// of the cursor.
+type logCursor struct {
+	timestamp int64 // microseconds since the epoch
+	skip      int
+	// index is the Index of the log at the cursor, or 0 if it was not numbered.
+	index int
+	// after is set for cursors paging towards newer logs.
+	after bool
+}
+
+// encodeLogCursor returns the opaque form of a cursor.
+func encodeLogCursor(c logCursor) string {
+	value := fmt.Sprintf("%d:%d", c.timestamp, c.skip)
+	if c.index > 0 {
+		value += ":" + strconv.Itoa(c.index)
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// decodeLogCursor parses a cursor returned by encodeLogCursor.
+func decodeLogCursor(value string) (logCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return logCursor{}, fmt.Errorf("malformed cursor")
+	}
+	// Cursors issued before entries were numbered have no index.
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 2 && len(parts) != 3 {
+		return logCursor{}, fmt.Errorf("malformed cursor")
+	}
+	var c logCursor
+	if c.timestamp, err = strconv.ParseInt(parts[0], 10, 64); err != nil || c.timestamp < 0 {
+		return logCursor{}, fmt.Errorf("malformed cursor")
+	}
+	if c.skip, err = strconv.Atoi(parts[1]); err != nil || c.skip < 0 {
+		return logCursor{}, fmt.Errorf("malformed cursor")
+	}
+	if len(parts) == 3 {
+		if c.index, err = strconv.Atoi(parts[2]); err != nil || c.index < 1 {
+			return logCursor{}, fmt.Errorf("malformed cursor")
+		}
+	}
+	return c, nil
+}
+
+// ValidateCursors checks the Before and After pagination cursors. The error is suitable for
+// returning to the caller.
+func (p ComponentLogsParams) ValidateCursors() error {
+	if p.Before == "" && p.After == "" {
+		return nil
+	}
+	if p.Before != "" && p.After != "" {
+		return fmt.Errorf("before and after cannot be combined")
+	}
+	if p.pinned() || p.sampled() {
+		return fmt.Errorf("before and after cannot be combined with atTimestamp, atTimestamps or sampling")
+	}
+	if p.Before != "" {
+		if _, err := decodeLogCursor(p.Before); err != nil {
+			return fmt.Errorf("invalid before cursor: %w", err)
+		}
+	}
+	if p.After != "" {
+		if _, err := decodeLogCursor(p.After); err != nil {
+			return fmt.Errorf("invalid after cursor: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateOffset checks the Offset of a query. The error is suitable for returning to the
+// caller.
+func (p ComponentLogsParams) ValidateOffset() error {
+	if p.Offset < 0 {
+		return fmt.Errorf("offset must not be negative, got %d", p.Offset)
+	}
+	if p.Offset > 0 && (p.Before != "" || p.After != "" || p.pinned() || p.sampled()) {
+		return fmt.Errorf("offset cannot be combined with before, after, atTimestamp, atTimestamps or sampling")
+	}
+	return nil
+}
+
+// applyCursor decodes the pagination cursor of params, if any, and narrows the time range to
+// the logs on the requested side of it.
+func applyCursor(params ComponentLogsParams) (ComponentLogsParams, error) {
+	if err := params.ValidateCursors(); err != nil {
+		return params, err
+	}
+	if err := params.ValidateOffset(); err != nil {
+		return params, err
+	}
+	switch {
+	case params.Before != "":
+		c, _ := decodeLogCursor(params.Before)
+		params.cursor = &c
+		// end_time is exclusive; logs at the cursor timestamp itself are still needed.
+		if end := time.UnixMicro(c.timestamp + 1); end.Before(params.EndTime) {
+			params.EndTime = end
+		}
+	case params.After != "":
+		c, _ := decodeLogCursor(params.After)
+		c.after = true
+		params.cursor = &c
+		if start := time.UnixMicro(c.timestamp); start.After(params.StartTime) {
+			params.StartTime = start
+		}
+	}
+	return params, nil
+}
+
+// pageCursors returns the cursors continuing a page of logs sorted in params.SortOrder:
+// before continues with older logs and after with newer ones, whichever way the page is
+// sorted. params is the query that produced the page.
+func pageCursors(logs []ComponentLogsEntry, params ComponentLogsParams) (before, after string) {
+	if len(logs) == 0 {
+		// Nothing newer yet: the caller keeps polling from the same position.
+		return "", params.After
+	}
+
+	newest, oldest := logs[0], logs[len(logs)-1]
+	if strings.EqualFold(params.SortOrder, "asc") {
+		newest, oldest = oldest, newest
+	}
+	beforeCursor := logCursor{timestamp: oldest.Timestamp.UnixMicro(), index: oldest.Index}
+	afterCursor := logCursor{timestamp: newest.Timestamp.UnixMicro(), index: newest.Index}
+	for _, entry := range logs {
+		ts := entry.Timestamp.UnixMicro()
+		if ts == beforeCursor.timestamp {
+			beforeCursor.skip++
+		}
+		if ts == afterCursor.timestamp {
+			afterCursor.skip++
+		}
+	}
+
+	// Logs at the cursor timestamp returned by earlier pages are still skipped.
+	if c := params.cursor; c != nil {
+		if !c.after && c.timestamp == beforeCursor.timestamp {
+			beforeCursor.skip += c.skip
+		}
+		if c.after && c.timestamp == afterCursor.timestamp {
+			afterCursor.skip += c.skip
+		}
+	}
+	return encodeLogCursor(beforeCursor), encodeLogCursor(afterCursor)
+}
+
+// indexLogEntries numbers logs, a page sorted in params.SortOrder, with their position in the
+// whole ordered result of the query (see ComponentLogsEntry.Index). A page read at an Offset
+// starts after it and a page read from a cursor continues the numbering the cursor carries.
+// A page that comes before the first numbered log in the requested order, e.g. logs written
+// since a newest-first result, is not numbered, as the earlier numbering no longer holds.
+func indexLogEntries(logs []ComponentLogsEntry, params ComponentLogsParams) {
+	first := params.Offset + 1
+	if c := params.cursor; c != nil {
+		if c.index == 0 {
+			return
+		}
+		if c.after == strings.EqualFold(params.SortOrder, "asc") {
+			first = c.index + 1
+		} else {
+			first = c.index - len(logs)
+		}
+		if first < 1 {
+			return
+		}
+	}
+	for i := range logs {
+		logs[i].Index = first + i
+	}
+}
@@ -0,0 +1,408 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogCursorRoundTrip(t *testing.T) {
+	c := logCursor{timestamp: 1735732800000000, skip: 3}
+	got, err := decodeLogCursor(encodeLogCursor(c))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != c {
+		t.Errorf("expected %+v, got %+v", c, got)
+	}
+	indexed := logCursor{timestamp: 1735732800000000, skip: 1, index: 4201}
+	if got, err := decodeLogCursor(encodeLogCursor(indexed)); err != nil || got != indexed {
+		t.Errorf("expected %+v, got %+v (%v)", indexed, got, err)
+	}
+
+	for _, value := range []string{"not base64!", "MTIz", "YWJjOjE", "LTE6MA", "MTozOjA", "MTozOjQ6NQ"} {
+		if _, err := decodeLogCursor(value); err == nil {
+			t.Errorf("expected error for cursor %q", value)
+		}
+	}
+}
+
+func TestValidateCursors(t *testing.T) {
+	valid := encodeLogCursor(logCursor{timestamp: 10, skip: 1})
+	tests := []struct {
+		name    string
+		params  ComponentLogsParams
+		wantErr bool
+	}{
+		{"no cursor", ComponentLogsParams{}, false},
+		{"before", ComponentLogsParams{Before: valid}, false},
+		{"after", ComponentLogsParams{After: valid, SortOrder: "desc"}, false},
+		{"both", ComponentLogsParams{Before: valid, After: valid}, true},
+		{"ascending", ComponentLogsParams{Before: valid, SortOrder: "ASC"}, false},
+		{"sampled", ComponentLogsParams{After: valid, Sample: true}, true},
+		{"at timestamp", ComponentLogsParams{Before: valid, AtTimestamp: 5}, true},
+		{"malformed", ComponentLogsParams{Before: "???"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.params.ValidateCursors(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateOffset(t *testing.T) {
+	valid := encodeLogCursor(logCursor{timestamp: 10, skip: 1})
+	tests := []struct {
+		name    string
+		params  ComponentLogsParams
+		wantErr bool
+	}{
+		{"no offset", ComponentLogsParams{Before: valid}, false},
+		{"offset", ComponentLogsParams{Offset: 200}, false},
+		{"negative", ComponentLogsParams{Offset: -1}, true},
+		{"with a cursor", ComponentLogsParams{Offset: 200, After: valid}, true},
+		{"sampled", ComponentLogsParams{Offset: 200, Sample: true}, true},
+		{"at timestamps", ComponentLogsParams{Offset: 200, AtTimestamps: []int64{5}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.params.ValidateOffset(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestGetComponentLogs_Offset(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+	server := newCursorTestServer(t, []int64{base + 1, base + 2, base + 3, base + 4, base + 5})
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.UnixMicro(base),
+		EndTime:   time.UnixMicro(base + 100),
+		Limit:     2,
+		Offset:    2,
+	}
+	result, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 2 || result.Logs[0].Log != "line-2" || result.Logs[0].Index != 3 || result.Logs[1].Index != 4 {
+		t.Fatalf("unexpected page at offset 2: %+v", result.Logs)
+	}
+	if result.Offset != 2 || result.NextOffset != 4 {
+		t.Errorf("expected offset 2 and next offset 4, got %d and %d", result.Offset, result.NextOffset)
+	}
+
+	params.Offset = 4
+	last, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(last.Logs) != 1 || last.NextOffset != 0 {
+		t.Errorf("expected one log and no next offset on the last page, got %d and %d", len(last.Logs), last.NextOffset)
+	}
+
+	params.Offset = -1
+	if _, err := client.GetComponentLogs(context.Background(), params); err == nil {
+		t.Error("expected error for a negative offset, got nil")
+	}
+}
+
+// newCursorTestServer serves component log queries over logs at the given timestamps
+// (microseconds), honouring the time range, the cursor conditions, the sort order and from.
+func newCursorTestServer(t *testing.T, timestamps []int64) *httptest.Server {
+	t.Helper()
+	condition := regexp.MustCompile(`_timestamp (<=|>=) (\d+)`)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL       string `json:"sql"`
+				StartTime int64  `json:"start_time"`
+				EndTime   int64  `json:"end_time"`
+				From      int    `json:"from"`
+				Size      int    `json:"size"`
+			} `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid query: %v", err)
+		}
+		q := body.Query
+
+		type row struct {
+			ts  int64
+			log string
+		}
+		var rows []row
+		for i, ts := range timestamps {
+			if ts < q.StartTime || ts >= q.EndTime {
+				continue
+			}
+			if m := condition.FindStringSubmatch(q.SQL); m != nil {
+				bound, _ := strconv.ParseInt(m[2], 10, 64)
+				if (m[1] == "<=" && ts > bound) || (m[1] == ">=" && ts < bound) {
+					continue
+				}
+			}
+			rows = append(rows, row{ts, fmt.Sprintf("line-%d", i)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(q.SQL, "SELECT count(*)") {
+			fmt.Fprintf(w, `{"took":1,"hits":[{"total":%d}]}`, len(rows))
+			return
+		}
+		asc := strings.HasSuffix(q.SQL, "ASC")
+		sort.SliceStable(rows, func(i, j int) bool {
+			if asc {
+				return rows[i].ts < rows[j].ts
+			}
+			return rows[i].ts > rows[j].ts
+		})
+		hits := []map[string]interface{}{}
+		for i := q.From; i < len(rows) && i < q.From+q.Size; i++ {
+			hits = append(hits, map[string]interface{}{"_timestamp": rows[i].ts, "log": rows[i].log})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"took": 1, "hits": hits})
+	}))
+}
+
+func TestGetComponentLogs_CursorPagination(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+	// line-1 to line-3 share a timestamp and are split across pages.
+	timestamps := []int64{base + 1, base + 2, base + 2, base + 2, base + 3, base + 4, base + 5}
+	server := newCursorTestServer(t, timestamps)
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.UnixMicro(base),
+		EndTime:   time.UnixMicro(base + 100),
+		Limit:     3,
+	}
+	logLines := func(result *ComponentLogsResult) string {
+		var lines []string
+		for _, entry := range result.Logs {
+			lines = append(lines, entry.Log)
+		}
+		return strings.Join(lines, ",")
+	}
+
+	first, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logLines(first); got != "line-6,line-5,line-4" {
+		t.Fatalf("unexpected first page: %s", got)
+	}
+
+	// Scroll back in time until no older logs remain.
+	var older []string
+	cursor := first.BeforeCursor
+	for cursor != "" {
+		params.Before = cursor
+		page, err := client.GetComponentLogs(context.Background(), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(page.Logs) > 0 {
+			older = append(older, logLines(page))
+		}
+		cursor = page.BeforeCursor
+		if len(older) > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+	}
+	if got := strings.Join(older, "|"); got != "line-1,line-2,line-3|line-0" {
+		t.Errorf("unexpected older pages: %s", got)
+	}
+
+	// Catch up on logs written after the first page.
+	timestamps = append(timestamps, base+5, base+6)
+	server.Close()
+	server = newCursorTestServer(t, timestamps)
+	defer server.Close()
+	client = newTestClient(server.URL)
+
+	params.Before = ""
+	params.After = first.AfterCursor
+	newer, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logLines(newer); got != "line-8,line-7" {
+		t.Errorf("unexpected newer logs: %s", got)
+	}
+
+	params.After = newer.AfterCursor
+	caughtUp, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(caughtUp.Logs) != 0 || caughtUp.AfterCursor != newer.AfterCursor {
+		t.Errorf("expected no newer logs and the same after cursor, got %s (%q)", logLines(caughtUp), caughtUp.AfterCursor)
+	}
+}
+
+func TestGetComponentLogs_CursorPaginationAscending(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+	// line-3 to line-5 share a timestamp and are split across pages.
+	timestamps := []int64{base + 1, base + 2, base + 3, base + 4, base + 4, base + 4, base + 5}
+	server := newCursorTestServer(t, timestamps)
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.UnixMicro(base),
+		EndTime:   time.UnixMicro(base + 100),
+		Limit:     4,
+		SortOrder: "asc",
+	}
+	logLines := func(result *ComponentLogsResult) string {
+		var lines []string
+		for _, entry := range result.Logs {
+			lines = append(lines, entry.Log)
+		}
+		return strings.Join(lines, ",")
+	}
+
+	first, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logLines(first); got != "line-0,line-1,line-2,line-3" {
+		t.Fatalf("unexpected first page: %s", got)
+	}
+
+	// Read forward until no newer logs remain.
+	var newer []string
+	params.After = first.AfterCursor
+	for {
+		page, err := client.GetComponentLogs(context.Background(), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(page.Logs) == 0 {
+			if page.AfterCursor != params.After {
+				t.Errorf("expected the same after cursor once caught up, got %q", page.AfterCursor)
+			}
+			break
+		}
+		newer = append(newer, logLines(page))
+		params.After = page.AfterCursor
+		if len(newer) > 5 {
+			t.Fatal("pagination did not terminate")
+		}
+	}
+	if got := strings.Join(newer, "|"); got != "line-4,line-5,line-6" {
+		t.Errorf("unexpected newer pages: %s", got)
+	}
+
+	// Page back from the last page: the logs just before the cursor come first, returned
+	// oldest first.
+	params.After = ""
+	params.Limit = 3
+	params.Before = encodeLogCursor(logCursor{timestamp: base + 5, skip: 1})
+	older, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logLines(older); got != "line-3,line-4,line-5" {
+		t.Errorf("unexpected older page: %s", got)
+	}
+	params.Before = older.BeforeCursor
+	older, err = client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := logLines(older); got != "line-0,line-1,line-2" {
+		t.Errorf("unexpected older page: %s", got)
+	}
+}
+
+func TestGetComponentLogs_Index(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixMicro()
+	timestamps := []int64{base + 1, base + 2, base + 2, base + 3, base + 4, base + 5, base + 6}
+	server := newCursorTestServer(t, timestamps)
+	defer server.Close()
+	client := newTestClient(server.URL)
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.UnixMicro(base),
+		EndTime:   time.UnixMicro(base + 100),
+		Limit:     3,
+	}
+	indexes := func(result *ComponentLogsResult) string {
+		var values []string
+		for _, entry := range result.Logs {
+			values = append(values, fmt.Sprintf("%s=%d", entry.Log, entry.Index))
+		}
+		return strings.Join(values, ",")
+	}
+
+	first, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := indexes(first); got != "line-6=1,line-5=2,line-4=3" {
+		t.Fatalf("unexpected first page: %s", got)
+	}
+
+	// Older pages continue the numbering.
+	params.Before = first.BeforeCursor
+	second, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := indexes(second); got != "line-3=4,line-1=5,line-2=6" && got != "line-3=4,line-2=5,line-1=6" {
+		t.Fatalf("unexpected second page: %s", got)
+	}
+
+	// Paging back towards the newest logs numbers them from the cursor down.
+	params.Before = ""
+	params.After = second.AfterCursor
+	params.Limit = 2
+	back, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := indexes(back); got != "line-5=2,line-4=3" {
+		t.Errorf("unexpected page before the cursor: %s", got)
+	}
+
+	// Logs newer than the first page come before the first numbered log.
+	params.After = first.AfterCursor
+	newer, err := client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(newer.Logs) != 0 {
+		t.Fatalf("expected no newer logs, got %s", indexes(newer))
+	}
+	timestamps = append(timestamps, base+7)
+	server.Close()
+	server = newCursorTestServer(t, timestamps)
+	defer server.Close()
+	client = newTestClient(server.URL)
+	if newer, err = client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := indexes(newer); got != "line-7=0" {
+		t.Errorf("expected the newer log not to be numbered, got %s", got)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ComponentLogsDebug describes how OpenObserve ran the queries of a component log result,
+// for investigating slow queries. It is only collected when ComponentLogsParams.Explain is set.
+type ComponentLogsDebug struct {
+	Queries []QueryDebug `json:"queries"`
+}
+
+// QueryDebug holds the scan details OpenObserve reported for one query. Fields OpenObserve
+// did not report are omitted.
+type QueryDebug struct {
+	// Name identifies the query: "logs" for the page of logs, "count" for the total count,
+	// "facet:<facet>" for the facet counts.
+	Name   string `json:"name"`
+	Stream string `json:"stream"`
+	SQL    string `json:"sql"`
+	// StartTime and EndTime bound the time partitions OpenObserve was asked to scan.
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Took      int       `json:"took"`
+	// TookDetail breaks the time taken down by phase (e.g. file listing, waiting in queue).
+	TookDetail  map[string]interface{} `json:"tookDetail,omitempty"`
+	TraceID     string                 `json:"traceId,omitempty"`
+	ScanSizeMB  float64                `json:"scanSizeMb,omitempty"`
+	ScanRecords int64                  `json:"scanRecords,omitempty"`
+	CachedRatio float64                `json:"cachedRatio,omitempty"`
+	// IsPartial is set when OpenObserve returned a partial result, e.g. after a timeout.
+	IsPartial bool `json:"isPartial,omitempty"`
+}
+
+// newQueryDebug collects the scan details of the query in queryJSON (as sent to the search
+// API) from its response.
+func newQueryDebug(name, stream string, queryJSON []byte, resp *OpenObserveResponse) QueryDebug {
+	var search struct {
+		Query struct {
+			SQL       string `json:"sql"`
+			StartTime int64  `json:"start_time"`
+			EndTime   int64  `json:"end_time"`
+		} `json:"query"`
+	}
+	// The query was generated by the adapter, so it always parses.
+	_ = json.Unmarshal(queryJSON, &search)
+
+	return QueryDebug{
+		Name:        name,
+		Stream:      stream,
+		SQL:         search.Query.SQL,
+		StartTime:   time.UnixMicro(search.Query.StartTime).UTC(),
+		EndTime:     time.UnixMicro(search.Query.EndTime).UTC(),
+		Took:        resp.Took,
+		TookDetail:  resp.TookDetail,
+		TraceID:     resp.TraceID,
+		ScanSizeMB:  resp.ScanSize,
+		ScanRecords: resp.ScanRecords,
+		CachedRatio: resp.CachedRatio,
+		IsPartial:   resp.IsPartial,
+	}
+}
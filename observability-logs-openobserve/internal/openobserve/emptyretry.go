@@ -0,0 +1,53 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// emptyRetryRecency is how close to now the end of a query's window must be for an empty
+// result to be retried (see ClientOptions.EmptyResultRetry). Logs of older windows have been
+// ingested, so retrying them would only add latency.
+const emptyRetryRecency = time.Minute
+
+// retriesEmpty reports whether an empty result of params may be caused by ingestion lag: its
+// window ends less than emptyRetryRecency ago, or is open-ended. Queries pinned to timestamps
+// and pages of older logs ask for logs known to exist.
+func (p ComponentLogsParams) retriesEmpty(now time.Time) bool {
+	if p.pinned() || p.Before != "" {
+		return false
+	}
+	return p.EndTime.IsZero() || now.Sub(p.EndTime) < emptyRetryRecency
+}
+
+// retryEmpty runs query and, while it returns no logs for a recent window, waits and runs it
+// again according to c.emptyRetry, so that logs written just before the query have time to
+// become searchable. The last result is returned; a partial result is never retried.
+func (c *Client) retryEmpty(ctx context.Context, params ComponentLogsParams, query func(context.Context) (*ComponentLogsResult, error)) (*ComponentLogsResult, error) {
+	backoff := c.emptyRetry.Backoff
+	for attempt := 0; ; attempt++ {
+		result, err := query(ctx)
+		if err != nil || len(result.Logs) > 0 || result.Partial ||
+			attempt >= c.emptyRetry.Retries || !params.retriesEmpty(time.Now()) {
+			return result, err
+		}
+
+		c.logger.Debug("Component log query returned no logs for a recent window, retrying",
+			slog.String("namespace", params.Namespace),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("backoff", backoff),
+		)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, nil
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
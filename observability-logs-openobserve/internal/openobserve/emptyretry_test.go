@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetComponentLogs_EmptyResultRetry(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		retries     int
+		start, end  time.Time
+		emptyUntil  int32
+		wantQueries int32
+		wantLogs    int
+	}{
+		{"disabled", 0, now.Add(-time.Minute), now, 1, 1, 0},
+		{"found on retry", 2, now.Add(-time.Minute), now, 1, 2, 1},
+		{"still empty", 2, now.Add(-time.Minute), now, 5, 3, 0},
+		{"old window", 2, now.Add(-2 * time.Hour), now.Add(-time.Hour), 1, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queries int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if isCountQuery(r) {
+					w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+					return
+				}
+				if atomic.AddInt32(&queries, 1) <= tt.emptyUntil {
+					w.Write([]byte(`{"took":1,"hits":[]}`))
+					return
+				}
+				w.Write([]byte(`{"took":1,"hits":[{"_timestamp":1735689600000000,"log":"just written"}]}`))
+			}))
+			defer server.Close()
+
+			client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", ClientOptions{
+				EmptyResultRetry: RetryPolicy{Retries: tt.retries, Backoff: time.Millisecond},
+			}, testLogger())
+			result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{
+				Namespace: "test-ns",
+				StartTime: tt.start,
+				EndTime:   tt.end,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(result.Logs) != tt.wantLogs {
+				t.Errorf("expected %d logs, got %d", tt.wantLogs, len(result.Logs))
+			}
+			if got := atomic.LoadInt32(&queries); got != tt.wantQueries {
+				t.Errorf("expected %d log queries, got %d", tt.wantQueries, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// QueryEstimate is OpenObserve's estimate of the data a query would scan, as reported by its
+// search partition API before the query runs.
+type QueryEstimate struct {
+	Files     int   `json:"files"`
+	Records   int64 `json:"records"`
+	ScanBytes int64 `json:"scanBytes"`
+}
+
+// QueryTooExpensiveError is returned for a component log query whose estimated scan exceeds
+// ClientOptions.MaxScanBytes. The query is not run.
+type QueryTooExpensiveError struct {
+	Estimate     QueryEstimate
+	MaxScanBytes int64
+}
+
+func (e *QueryTooExpensiveError) Error() string {
+	return fmt.Sprintf("query would scan an estimated %s (%d records in %d files), more than the limit of %s; narrow the time window or add filters",
+		formatBytes(e.Estimate.ScanBytes), e.Estimate.Records, e.Estimate.Files, formatBytes(e.MaxScanBytes))
+}
+
+// formatBytes formats a byte count with a binary unit, e.g. "1.5 GiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// searchPartitionResponse is the subset of OpenObserve's search partition response that
+// describes the data a query would scan.
+type searchPartitionResponse struct {
+	FileNum      int   `json:"file_num"`
+	Records      int64 `json:"records"`
+	OriginalSize int64 `json:"original_size"`
+}
+
+// estimateQuery asks OpenObserve how much data the search query in queryJSON (as sent to the
+// search API) would scan, without running it.
+func (c *Client) estimateQuery(ctx context.Context, queryJSON []byte) (*QueryEstimate, error) {
+	var search struct {
+		Query struct {
+			SQL       string `json:"sql"`
+			StartTime int64  `json:"start_time"`
+			EndTime   int64  `json:"end_time"`
+		} `json:"query"`
+	}
+	if err := json.Unmarshal(queryJSON, &search); err != nil {
+		return nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"sql":        search.Query.SQL,
+		"start_time": search.Query.StartTime,
+		"end_time":   search.Query.EndTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal estimate request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/api/%s/_search_partition?type=logs", c.baseURL, c.org)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.user, c.token)
+
+	resp, err := c.doWithRetry(req, c.queryRetry)
+	if err != nil {
+		c.logger.Error("Failed to execute search partition request against OpenObserve", slog.Any("error", err))
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		c.logger.Error("OpenObserve returned error",
+			slog.Int("statusCode", resp.StatusCode),
+			slog.String("body", string(respBody)))
+		return nil, fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var partition searchPartitionResponse
+	if err := json.Unmarshal(respBody, &partition); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &QueryEstimate{
+		Files:     partition.FileNum,
+		Records:   partition.Records,
+		ScanBytes: partition.OriginalSize,
+	}, nil
+}
+
+// checkScanLimit rejects the search query in queryJSON with a QueryTooExpensiveError if
+// OpenObserve estimates that it would scan more than the configured maximum.
+func (c *Client) checkScanLimit(ctx context.Context, queryJSON []byte, stream string) error {
+	if c.maxScanBytes <= 0 {
+		return nil
+	}
+	estimate, err := c.estimateQuery(ctx, queryJSON)
+	if err != nil {
+		return fmt.Errorf("failed to estimate query cost: %w", err)
+	}
+	if estimate.ScanBytes > c.maxScanBytes {
+		c.logger.Warn("Rejected component log query exceeding the scan limit",
+			slog.String("stream", stream),
+			slog.Int64("scanBytes", estimate.ScanBytes),
+			slog.Int64("maxScanBytes", c.maxScanBytes),
+		)
+		return &QueryTooExpensiveError{Estimate: *estimate, MaxScanBytes: c.maxScanBytes}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536 << 20, "1.5 GiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+// newScanLimitServer returns a mock OpenObserve whose search partition API estimates a scan of
+// scanBytes, and counts the search queries it receives.
+func newScanLimitServer(t *testing.T, scanBytes int64, searches *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/_search_partition") {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode estimate request: %v", err)
+			}
+			if sql, _ := body["sql"].(string); !strings.HasPrefix(sql, "SELECT * FROM") {
+				t.Errorf("expected the page query to be estimated, got %q", sql)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"file_num": 42, "records": 1000000, "original_size": scanBytes, "compressed_size": scanBytes / 10,
+			})
+			return
+		}
+		searches.Add(1)
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetComponentLogs_ScanLimit(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("over the limit", func(t *testing.T) {
+		var searches atomic.Int32
+		server := newScanLimitServer(t, 3<<30, &searches)
+		client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+			ClientOptions{MaxScanBytes: 1 << 30}, testLogger())
+
+		_, err := client.GetComponentLogs(context.Background(), params)
+		var tooExpensive *QueryTooExpensiveError
+		if !errors.As(err, &tooExpensive) {
+			t.Fatalf("expected a QueryTooExpensiveError, got %v", err)
+		}
+		if tooExpensive.Estimate.ScanBytes != 3<<30 || tooExpensive.Estimate.Files != 42 || tooExpensive.Estimate.Records != 1000000 {
+			t.Errorf("unexpected estimate: %+v", tooExpensive.Estimate)
+		}
+		if msg := err.Error(); !strings.Contains(msg, "3.0 GiB") || !strings.Contains(msg, "1.0 GiB") {
+			t.Errorf("expected the estimate and limit in the message, got %q", msg)
+		}
+		if n := searches.Load(); n != 0 {
+			t.Errorf("expected the query not to run, got %d searches", n)
+		}
+	})
+
+	t.Run("within the limit", func(t *testing.T) {
+		var searches atomic.Int32
+		server := newScanLimitServer(t, 1<<20, &searches)
+		client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+			ClientOptions{MaxScanBytes: 1 << 30}, testLogger())
+
+		if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := searches.Load(); n != 2 {
+			t.Errorf("expected the page and count queries to run, got %d searches", n)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var searches atomic.Int32
+		server := newScanLimitServer(t, 3<<30, &searches)
+		if _, err := newTestClient(server.URL).GetComponentLogs(context.Background(), params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := searches.Load(); n != 2 {
+			t.Errorf("expected the page and count queries to run, got %d searches", n)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// DefaultAlertEvidenceLimit is the number of log lines returned as the evidence of an alert
+// when no limit is given.
+const DefaultAlertEvidenceLimit = 100
+
+// AlertEvidenceResult holds the log lines an alert counted in a time window, newest first,
+// with the alert's condition to compare their number against.
+type AlertEvidenceResult struct {
+	Alert     string    `json:"alert"`
+	Pattern   string    `json:"pattern"`
+	Operator  string    `json:"operator"`
+	Threshold float64   `json:"threshold"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// TotalCount is the number of logs the alert counted in the window, which may exceed the
+	// number of Logs returned.
+	TotalCount int                  `json:"totalCount"`
+	Logs       []ComponentLogsEntry `json:"logs"`
+}
+
+// generateAlertEvidenceQuery generates a query returning the logs counted by an alert between
+// startTime and endTime, newest first, or their number when count is set.
+func generateAlertEvidenceQuery(params LogAlertParams, stream string, startTime, endTime time.Time, limit int, count bool, logger *slog.Logger) ([]byte, error) {
+	if params.SearchPattern == "" {
+		return nil, fmt.Errorf("search pattern is required for alert evidence queries")
+	}
+
+	sql := "SELECT * FROM " + quoteIdentifier(stream) + " WHERE " + alertConditions(params) + " ORDER BY _timestamp DESC"
+	size := limit
+	if count {
+		sql = "SELECT count(*) as total FROM " + quoteIdentifier(stream) + " WHERE " + alertConditions(params)
+		size = 1
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": startTime.UnixMicro(),
+			"end_time":   endTime.UnixMicro(),
+			"from":       0,
+			"size":       size,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated alert evidence query for %s:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// GetAlertEvidence returns the log lines an alert counted between startTime and endTime, up
+// to limit (DefaultAlertEvidenceLimit if not positive), by running the alert's own search.
+// A zero endTime selects now and a zero startTime the alert's evaluation window before
+// endTime, i.e. the logs behind its latest evaluation.
+func (c *Client) GetAlertEvidence(ctx context.Context, alertName string, startTime, endTime time.Time, limit int) (*AlertEvidenceResult, error) {
+	alert, err := c.GetAlert(ctx, alertName)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultAlertEvidenceLimit
+	}
+	if endTime.IsZero() {
+		endTime = time.Now()
+	}
+	if startTime.IsZero() {
+		window, err := time.ParseDuration(ToDurationString(alert.Period, alert.FrequencyType))
+		if err != nil || window <= 0 {
+			return nil, fmt.Errorf("alert %q has no evaluation window", alertName)
+		}
+		startTime = endTime.Add(-window)
+	}
+
+	params := c.withMessageField(LogAlertParams{
+		SearchPattern:  ExtractSearchPattern(alert.SQL),
+		EnvironmentUID: alert.EnvironmentUID,
+		ComponentUID:   alert.ComponentUID,
+	})
+	queryJSON, err := generateAlertEvidenceQuery(params, c.stream, startTime, endTime, limit, false, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate alert evidence query: %w", err)
+	}
+	countQueryJSON, err := generateAlertEvidenceQuery(params, c.stream, startTime, endTime, limit, true, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate alert evidence count query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+	countResp, err := c.executeSearchQuery(ctx, countQueryJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute alert evidence count query: %w", err)
+	}
+
+	result := &AlertEvidenceResult{
+		Alert:      alert.Name,
+		Pattern:    params.SearchPattern,
+		Operator:   alert.Operator,
+		Threshold:  alert.Threshold,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		TotalCount: extractTotalCount(countResp),
+		Logs:       make([]ComponentLogsEntry, 0, len(openObserveResp.Hits)),
+	}
+	for _, hit := range openObserveResp.Hits {
+		result.Logs = append(result.Logs, c.parseApplicationLogEntry(hit))
+	}
+	sortLogEntries(result.Logs, "desc")
+	return result, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newAlertEvidenceServer serves an alert named "panic-alert" counting the lines matching
+// "panic" of component comp-1, and records the SQL of the searches with their time window.
+func newAlertEvidenceServer(t *testing.T, searches *[]map[string]interface{}) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2/default/alerts", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"list": []map[string]string{{"alert_id": "a1", "name": "panic-alert"}}})
+	})
+	mux.HandleFunc("GET /api/v2/default/alerts/a1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": "panic-alert",
+			"query_condition": map[string]interface{}{
+				"sql": "SELECT _timestamp FROM \"default\" WHERE str_match(log, 'panic') AND kubernetes_labels_openchoreo_dev_environment_uid = 'env-1' AND kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'",
+			},
+			"trigger_condition":  map[string]interface{}{"operator": ">", "threshold": float64(5), "period": float64(10), "frequency": float64(1)},
+			"context_attributes": map[string]interface{}{"environmentUid": "env-1", "componentUid": "comp-1"},
+		})
+	})
+	mux.HandleFunc("POST /api/default/_search", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query map[string]interface{} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		*searches = append(*searches, body.Query)
+
+		resp := OpenObserveResponse{Hits: []map[string]interface{}{
+			{"_timestamp": float64(1735732800000000), "log": "panic: first"},
+			{"_timestamp": float64(1735732860000000), "log": "panic: second"},
+		}}
+		if strings.HasPrefix(body.Query["sql"].(string), "SELECT count(*)") {
+			resp.Hits = []map[string]interface{}{{"total": float64(7)}}
+		}
+		json.NewEncoder(w).Encode(resp)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetAlertEvidence(t *testing.T) {
+	var searches []map[string]interface{}
+	client := newTestClient(newAlertEvidenceServer(t, &searches).URL)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	result, err := client.GetAlertEvidence(context.Background(), "panic-alert", start, end, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(searches) != 2 {
+		t.Fatalf("expected a log and a count query, got %d", len(searches))
+	}
+	sql := searches[0]["sql"].(string)
+	for _, check := range []string{
+		"SELECT * FROM \"default\"",
+		"str_match(log, 'panic') AND kubernetes_labels_openchoreo_dev_environment_uid = 'env-1' AND kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'",
+		"ORDER BY _timestamp DESC",
+	} {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if searches[0]["size"] != float64(10) || searches[0]["start_time"] != float64(start.UnixMicro()) {
+		t.Errorf("unexpected query window: %v", searches[0])
+	}
+
+	if result.Alert != "panic-alert" || result.Pattern != "panic" || result.Threshold != 5 || result.TotalCount != 7 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if len(result.Logs) != 2 || result.Logs[0].Log != "panic: second" {
+		t.Errorf("expected the logs newest first, got %+v", result.Logs)
+	}
+}
+
+func TestGetAlertEvidence_DefaultWindow(t *testing.T) {
+	var searches []map[string]interface{}
+	client := newTestClient(newAlertEvidenceServer(t, &searches).URL)
+
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	result, err := client.GetAlertEvidence(context.Background(), "panic-alert", time.Time{}, end, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := end.Add(-10 * time.Minute); !result.StartTime.Equal(want) {
+		t.Errorf("expected the alert's 10m window, got start %s", result.StartTime)
+	}
+	if searches[0]["size"] != float64(DefaultAlertEvidenceLimit) {
+		t.Errorf("expected the default limit, got %v", searches[0]["size"])
+	}
+
+	if _, err := client.GetAlertEvidence(context.Background(), "other-alert", time.Time{}, end, 0); err == nil ||
+		!strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// exportPageSize is the number of logs fetched from OpenObserve per export request.
+const exportPageSize = 1000
+
+// ExportComponentLogs fetches every component log matching params, page by page, and calls
+// emit for each entry in the requested sort order. params.Limit caps the total number of
+// exported logs when it is positive. Export stops at the first error from OpenObserve or emit.
+func (c *Client) ExportComponentLogs(ctx context.Context, params ComponentLogsParams, emit func(ComponentLogsEntry) error) error {
+	maxLogs := params.Limit
+	exported := 0
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return err
+	}
+
+	for {
+		pageSize := exportPageSize
+		if maxLogs > 0 && maxLogs-exported < pageSize {
+			pageSize = maxLogs - exported
+		}
+		params.Limit = pageSize
+
+		queryJSON, err := generateComponentLogsPageQuery(params, stream, exported, c.logger)
+		if err != nil {
+			return fmt.Errorf("failed to generate component logs export query: %w", err)
+		}
+
+		resp, err := c.executeSearchQuery(ctx, queryJSON)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range resp.Hits {
+			entry := c.parseApplicationLogEntry(hit)
+			entry.Computed = computedValues(hit, params.Expressions)
+			if err := emit(entry); err != nil {
+				return err
+			}
+		}
+		exported += len(resp.Hits)
+
+		if len(resp.Hits) < pageSize || (maxLogs > 0 && exported >= maxLogs) {
+			return nil
+		}
+	}
+}
+
+// ExportManifest describes the query an export ran, so that an exported file documents which
+// logs it holds and can be reproduced.
+type ExportManifest struct {
+	// Query is the query of the export as requested.
+	Query ComponentLogsParams `json:"query"`
+	// Window is the time range exported, including a defaulted one (see
+	// ClientOptions.DefaultWindow).
+	Window TimeWindow `json:"window"`
+	// TotalCount is the number of logs matching the query when the export started; the export
+	// holds fewer if Query.Limit caps it.
+	TotalCount int       `json:"totalCount"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// ExportManifest counts the logs matching params and describes the export of them. Exporting
+// with the window of the manifest, rather than params, makes sure that the export covers the
+// counted logs when params select the default window up to now.
+func (c *Client) ExportManifest(ctx context.Context, params ComponentLogsParams) (*ExportManifest, error) {
+	resolved := c.withFieldNames(c.resolveAtTimestamp(params))
+	pinned := params
+	pinned.StartTime, pinned.EndTime = resolved.StartTime, resolved.EndTime
+	count, err := c.CountComponentLogs(ctx, pinned)
+	if err != nil {
+		return nil, err
+	}
+	return &ExportManifest{
+		Query:      params,
+		Window:     TimeWindow{StartTime: resolved.StartTime, EndTime: resolved.EndTime},
+		TotalCount: count.Count,
+		ExportedAt: time.Now().UTC(),
+	}, nil
+}
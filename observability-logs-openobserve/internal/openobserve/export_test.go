@@ -0,0 +1,142 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// pagedLogsServer serves total log hits, honouring the from/size of each query.
+func pagedLogsServer(t *testing.T, total int, requests *[][2]int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				From int `json:"from"`
+				Size int `json:"size"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		*requests = append(*requests, [2]int{body.Query.From, body.Query.Size})
+
+		resp := OpenObserveResponse{Hits: []map[string]interface{}{}}
+		for i := body.Query.From; i < total && i < body.Query.From+body.Query.Size; i++ {
+			resp.Hits = append(resp.Hits, map[string]interface{}{"_timestamp": float64(i), "log": "line"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExportComponentLogs(t *testing.T) {
+	var requests [][2]int
+	server := pagedLogsServer(t, exportPageSize+5, &requests)
+	c := newTestClient(server.URL)
+
+	count := 0
+	err := c.ExportComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+	}, func(ComponentLogsEntry) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != exportPageSize+5 {
+		t.Errorf("expected %d exported logs, got %d", exportPageSize+5, count)
+	}
+	if len(requests) != 2 || requests[1][0] != exportPageSize {
+		t.Errorf("expected two pages with the second starting at %d, got %v", exportPageSize, requests)
+	}
+}
+
+func TestExportComponentLogs_Limit(t *testing.T) {
+	var requests [][2]int
+	server := pagedLogsServer(t, 5000, &requests)
+	c := newTestClient(server.URL)
+
+	count := 0
+	err := c.ExportComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+		Limit:     1500,
+	}, func(ComponentLogsEntry) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count != 1500 {
+		t.Errorf("expected 1500 exported logs, got %d", count)
+	}
+	if len(requests) != 2 || requests[1][1] != 500 {
+		t.Errorf("expected the last page to be capped at 500, got %v", requests)
+	}
+}
+
+func TestExportComponentLogs_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	err := c.ExportComponentLogs(context.Background(), ComponentLogsParams{Namespace: "ns"}, func(ComponentLogsEntry) error {
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestExportManifest(t *testing.T) {
+	var window [2]int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				StartTime int64 `json:"start_time"`
+				EndTime   int64 `json:"end_time"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		window = [2]int64{body.Query.StartTime, body.Query.EndTime}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[{"total":42}]}`))
+	}))
+	defer server.Close()
+	c := newTestClient(server.URL)
+
+	params := ComponentLogsParams{Namespace: "ns", SearchPhrase: "timeout", Limit: 10}
+	manifest, err := c.ExportManifest(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manifest.TotalCount != 42 {
+		t.Errorf("expected a total count of 42, got %d", manifest.TotalCount)
+	}
+	if manifest.Query.SearchPhrase != "timeout" || manifest.Query.Limit != 10 || !manifest.Query.StartTime.IsZero() {
+		t.Errorf("expected the query as requested, got %+v", manifest.Query)
+	}
+	if got := manifest.Window.EndTime.Sub(manifest.Window.StartTime); got != DefaultQueryWindow {
+		t.Errorf("expected the default window, got %v", got)
+	}
+	if window != [2]int64{manifest.Window.StartTime.UnixMicro(), manifest.Window.EndTime.UnixMicro()} {
+		t.Errorf("expected the count to cover the manifest window, got %v", window)
+	}
+	if manifest.ExportedAt.IsZero() {
+		t.Error("expected the export time to be set")
+	}
+}
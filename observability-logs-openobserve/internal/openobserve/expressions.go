@@ -0,0 +1,319 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxExpressions is the number of computed fields a query may request.
+	maxExpressions = 10
+	// maxExpressionLength bounds the length of a single expression.
+	maxExpressionLength = 256
+	// maxExpressionDepth bounds how deeply function calls may be nested.
+	maxExpressionDepth = 5
+	// computedFieldPrefix prefixes the SQL aliases of computed fields, keeping them apart from
+	// the stream fields selected alongside them.
+	computedFieldPrefix = "computed_"
+)
+
+// expressionFunction describes a SQL function that expressions may call.
+type expressionFunction struct {
+	sqlName string
+	minArgs int
+	maxArgs int
+}
+
+// expressionFunctions are the OpenObserve SQL functions available to expressions. They are
+// pure, cheap per-row transformations; aggregates and functions reaching outside the row are
+// deliberately left out.
+var expressionFunctions = map[string]expressionFunction{
+	"lower":      {"lower", 1, 1},
+	"upper":      {"upper", 1, 1},
+	"trim":       {"trim", 1, 1},
+	"length":     {"length", 1, 1},
+	"substr":     {"substr", 2, 3},
+	"replace":    {"replace", 3, 3},
+	"split_part": {"split_part", 3, 3},
+	"strpos":     {"strpos", 2, 2},
+	"concat":     {"concat", 1, 8},
+	"coalesce":   {"coalesce", 1, 8},
+	"abs":        {"abs", 1, 1},
+	"round":      {"round", 1, 2},
+}
+
+// castTypes maps the types accepted by cast(value, 'type') to their SQL type.
+var castTypes = map[string]string{
+	"int":    "BIGINT",
+	"float":  "DOUBLE",
+	"string": "VARCHAR",
+	"bool":   "BOOLEAN",
+}
+
+// reservedExpressionWords are SQL keywords and literals that expressions may not use as
+// field names; quoting them would silently turn, say, NULL into a reference to a field.
+var reservedExpressionWords = map[string]bool{
+	"all": true, "and": true, "as": true, "between": true, "case": true, "distinct": true,
+	"else": true, "end": true, "exists": true, "false": true, "from": true, "in": true,
+	"interval": true, "is": true, "like": true, "not": true, "null": true, "or": true,
+	"select": true, "then": true, "true": true, "when": true, "where": true,
+}
+
+// componentLogFields are the stream fields of component logs that are read into
+// ComponentLogsEntry, besides the configured ones.
+var componentLogFields = []string{
+	"log",
+	"kubernetes_container_name",
+	"kubernetes_namespace_name",
+	"kubernetes_pod_id",
+	"kubernetes_pod_name",
+	"kubernetes_labels_openchoreo_dev_component",
+	"kubernetes_labels_openchoreo_dev_component_uid",
+	"kubernetes_labels_openchoreo_dev_environment",
+	"kubernetes_labels_openchoreo_dev_environment_uid",
+	"kubernetes_labels_openchoreo_dev_namespace",
+	"kubernetes_labels_openchoreo_dev_project",
+	"kubernetes_labels_openchoreo_dev_project_uid",
+}
+
+// expressionTokenPattern matches the tokens of an expression: identifiers, numbers,
+// single-quoted strings (in which a doubled quote stands for a quote) and punctuation.
+var expressionTokenPattern = regexp.MustCompile(`^\s*(?:([A-Za-z_][A-Za-z0-9_]*)|(-?[0-9]+(?:\.[0-9]+)?)|('(?:[^']|'')*')|([(),]))`)
+
+// ExpressionError is returned for a computed field whose name or expression is invalid.
+type ExpressionError struct {
+	Name   string
+	Reason string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("invalid expression %q: %s", e.Name, e.Reason)
+}
+
+// ExpressionFields returns the stream fields that expressions may reference: the fields read
+// into ComponentLogsEntry, the configured timestamp, stream, message, level and other fields,
+// the columns of labelKeys (see LabelSelectors) and fields.
+func (c *Client) ExpressionFields(labelKeys, fields []string) map[string]bool {
+	allowed := make(map[string]bool)
+	for _, field := range componentLogFields {
+		allowed[field] = true
+	}
+	for _, field := range []string{c.timestampField, c.streamField, c.eventTimeField, c.versionField, c.correlationField, c.messageField, c.levelField} {
+		if field != "" {
+			allowed[field] = true
+		}
+	}
+	for _, key := range labelKeys {
+		allowed[labelColumn(key)] = true
+	}
+	for _, field := range fields {
+		allowed[field] = true
+	}
+	return allowed
+}
+
+// ValidateExpressions checks the computed fields of a component log query (see
+// ComponentLogsParams.Expressions). Expressions may only reference the stream fields in
+// fields (see Client.ExpressionFields). The error is an *ExpressionError, suitable for
+// returning to the caller.
+func ValidateExpressions(expressions map[string]string, fields map[string]bool) error {
+	if len(expressions) > maxExpressions {
+		return fmt.Errorf("at most %d expressions may be requested", maxExpressions)
+	}
+	if fields == nil {
+		fields = map[string]bool{}
+	}
+	for name, expression := range expressions {
+		if _, err := compileExpression(name, expression, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileExpression translates an expression into SQL. Expressions are function calls on
+// stream fields and literals, e.g. substr(log, 1, 8) or cast(latency_ms, 'int'); only the
+// functions in expressionFunctions and cast are accepted and field names are quoted, so the
+// result is safe to interpolate into a query. Field names must be in fields, unless fields is
+// nil.
+func compileExpression(name, expression string, fields map[string]bool) (string, error) {
+	if !ValidFieldName(name) || len(name) > 64 {
+		return "", &ExpressionError{Name: name, Reason: "the name must be a plain field name"}
+	}
+	if len(expression) > maxExpressionLength {
+		return "", &ExpressionError{Name: name, Reason: fmt.Sprintf("longer than %d characters", maxExpressionLength)}
+	}
+	p := &expressionParser{input: expression, fields: fields}
+	sql, err := p.parse(0)
+	if err == nil && strings.TrimSpace(p.input) != "" {
+		err = fmt.Errorf("unexpected %q", strings.TrimSpace(p.input))
+	}
+	if err != nil {
+		return "", &ExpressionError{Name: name, Reason: err.Error()}
+	}
+	return sql, nil
+}
+
+// expressionParser is a recursive descent parser over the remaining input of an expression.
+type expressionParser struct {
+	input string
+	// fields are the field names the expression may reference, or nil for any.
+	fields map[string]bool
+}
+
+// next consumes the next token and returns it with its kind: "ident", "number", "string" or
+// the punctuation itself.
+func (p *expressionParser) next() (kind, token string, err error) {
+	if strings.TrimSpace(p.input) == "" {
+		return "", "", fmt.Errorf("unexpected end of expression")
+	}
+	m := expressionTokenPattern.FindStringSubmatch(p.input)
+	if m == nil {
+		return "", "", fmt.Errorf("unexpected %q", strings.TrimSpace(p.input))
+	}
+	p.input = p.input[len(m[0]):]
+	switch {
+	case m[1] != "":
+		return "ident", m[1], nil
+	case m[2] != "":
+		return "number", m[2], nil
+	case m[3] != "":
+		return "string", m[3], nil
+	default:
+		return m[4], m[4], nil
+	}
+}
+
+// peek reports whether the next token is the given punctuation.
+func (p *expressionParser) peek(punct string) bool {
+	return strings.HasPrefix(strings.TrimSpace(p.input), punct)
+}
+
+func (p *expressionParser) parse(depth int) (string, error) {
+	kind, token, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	switch kind {
+	case "number":
+		return token, nil
+	case "string":
+		value := strings.ReplaceAll(token[1:len(token)-1], "''", "'")
+		return "'" + escapeSQLString(value) + "'", nil
+	case "ident":
+		if !p.peek("(") {
+			return p.field(token)
+		}
+	default:
+		return "", fmt.Errorf("unexpected %q", token)
+	}
+
+	// A function call.
+	if depth >= maxExpressionDepth {
+		return "", fmt.Errorf("function calls are nested more than %d deep", maxExpressionDepth)
+	}
+	name := strings.ToLower(token)
+	fn, ok := expressionFunctions[name]
+	if !ok && name != "cast" {
+		return "", fmt.Errorf("unknown function %s; supported functions are %s", token, strings.Join(supportedExpressionFunctions(), ", "))
+	}
+	p.next() // "("
+	var args []string
+	if !p.peek(")") {
+		for {
+			arg, err := p.parse(depth + 1)
+			if err != nil {
+				return "", err
+			}
+			args = append(args, arg)
+			if !p.peek(",") {
+				break
+			}
+			p.next()
+		}
+	}
+	if _, closing, err := p.next(); err != nil || closing != ")" {
+		return "", fmt.Errorf("missing ) after the arguments of %s", name)
+	}
+
+	if name == "cast" {
+		if len(args) != 2 {
+			return "", fmt.Errorf("cast takes a value and a type")
+		}
+		sqlType, ok := castTypes[strings.Trim(args[1], "'")]
+		if !ok || !strings.HasPrefix(args[1], "'") {
+			return "", fmt.Errorf("cast type must be one of 'bool', 'float', 'int' or 'string'")
+		}
+		return "CAST(" + args[0] + " AS " + sqlType + ")", nil
+	}
+	if len(args) < fn.minArgs || len(args) > fn.maxArgs {
+		if fn.minArgs == fn.maxArgs {
+			return "", fmt.Errorf("%s takes %d arguments, got %d", name, fn.minArgs, len(args))
+		}
+		return "", fmt.Errorf("%s takes %d to %d arguments, got %d", name, fn.minArgs, fn.maxArgs, len(args))
+	}
+	return fn.sqlName + "(" + strings.Join(args, ", ") + ")", nil
+}
+
+// field returns the SQL of a reference to the stream field name.
+func (p *expressionParser) field(name string) (string, error) {
+	if !ValidFieldName(name) {
+		return "", fmt.Errorf("%q is not a plain field name", name)
+	}
+	if reservedExpressionWords[strings.ToLower(name)] {
+		return "", fmt.Errorf("%s is a reserved word, not a field", name)
+	}
+	if p.fields != nil && !p.fields[name] {
+		return "", fmt.Errorf("unknown field %s", name)
+	}
+	return quoteIdentifier(name), nil
+}
+
+// supportedExpressionFunctions returns the names of the functions expressions may call.
+func supportedExpressionFunctions() []string {
+	names := []string{"cast"}
+	for name := range expressionFunctions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// computedColumns returns the SQL select list entries of the computed fields, sorted by
+// name so that identical queries produce identical SQL. Invalid expressions are skipped;
+// callers are expected to validate them first with ValidateExpressions, which also checks
+// the fields they reference.
+func computedColumns(expressions map[string]string) []string {
+	names := make([]string, 0, len(expressions))
+	for name := range expressions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var columns []string
+	for _, name := range names {
+		sql, err := compileExpression(name, expressions[name], nil)
+		if err != nil {
+			continue
+		}
+		columns = append(columns, sql+" AS "+quoteIdentifier(computedFieldPrefix+name))
+	}
+	return columns
+}
+
+// computedValues returns the values of the computed fields in a query hit, or nil if the
+// query computed none.
+func computedValues(hit map[string]interface{}, expressions map[string]string) map[string]interface{} {
+	if len(expressions) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(expressions))
+	for name := range expressions {
+		values[name] = hit[computedFieldPrefix+name]
+	}
+	return values
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testExpressionFields are the fields the expressions of the tests may reference.
+var testExpressionFields = map[string]bool{"log": true, "latency_ms": true, "user_id": true}
+
+func TestCompileExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{"log", `"log"`},
+		{"substr(log, 1, 8)", `substr("log", 1, 8)`},
+		{"cast(latency_ms, 'int')", `CAST("latency_ms" AS BIGINT)`},
+		{"UPPER(split_part(log, ' ', 2))", `upper(split_part("log", ' ', 2))`},
+		{"replace(log, 'it''s', '-')", `replace("log", 'it''s', '-')`},
+		{"round(cast(latency_ms, 'float'), -2)", `round(CAST("latency_ms" AS DOUBLE), -2)`},
+		{"coalesce(user_id, 'anonymous')", `coalesce("user_id", 'anonymous')`},
+	}
+	for _, tt := range tests {
+		got, err := compileExpression("out", tt.expression, testExpressionFields)
+		if err != nil || got != tt.want {
+			t.Errorf("compileExpression(%q) = %q, %v; want %q", tt.expression, got, err, tt.want)
+		}
+	}
+}
+
+func TestCompileExpression_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"out", ""},
+		{"out", "log; DROP TABLE logs"},
+		{"out", "log) OR (1=1"},
+		{"out", "sleep(10)"},
+		{"out", "substr(log)"},
+		{"out", "substr(log, 1"},
+		{"out", "cast(log, 'blob')"},
+		{"out", "cast(log, int)"},
+		{"out", "'unterminated"},
+		{"out", "log || 'x'"},
+		{"out", strings.Repeat("lower(", 6) + "log" + strings.Repeat(")", 6)},
+		{"out", "lower(log, )"},
+		{"out", "password"},
+		{"out", "lower(secret_token)"},
+		{"out", "coalesce(log, NULL)"},
+		{"out", "concat(log, true)"},
+		{"bad-name", "log"},
+		{"out", strings.Repeat("a", maxExpressionLength+1)},
+	}
+	for _, tt := range tests {
+		_, err := compileExpression(tt.name, tt.expression, testExpressionFields)
+		var exprErr *ExpressionError
+		if !errors.As(err, &exprErr) {
+			t.Errorf("compileExpression(%q, %q): expected an ExpressionError, got %v", tt.name, tt.expression, err)
+		}
+	}
+}
+
+func TestGenerateComponentLogsQuery_Expressions(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		Expressions: map[string]string{
+			"user":    "split_part(log, ' ', 2)",
+			"latency": "cast(latency_ms, 'int')",
+		},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	want := `SELECT *, CAST("latency_ms" AS BIGINT) AS "computed_latency", split_part("log", ' ', 2) AS "computed_user" FROM "mystream"`
+	if !strings.HasPrefix(sql, want) {
+		t.Errorf("expected computed columns, got: %s", sql)
+	}
+
+	values := computedValues(map[string]interface{}{"computed_user": "alice", "computed_latency": 12.0}, params.Expressions)
+	if values["user"] != "alice" || values["latency"] != 12.0 {
+		t.Errorf("unexpected computed values: %v", values)
+	}
+}
+
+func TestExpressionFields(t *testing.T) {
+	client := NewClientWithOptions("http://localhost", "org", "stream", "events", "admin", "pass", ClientOptions{MessageField: "message"}, testLogger())
+	fields := client.ExpressionFields([]string{"app.kubernetes.io/name"}, []string{"latency_ms"})
+
+	expressions := []string{"log", "message", "_timestamp", "kubernetes_pod_name", "kubernetes_labels_app_kubernetes_io_name", "cast(latency_ms, 'int')"}
+	for _, expression := range expressions {
+		if err := ValidateExpressions(map[string]string{"out": expression}, fields); err != nil {
+			t.Errorf("expected %q to be accepted, got %v", expression, err)
+		}
+	}
+	for _, expression := range []string{"kubernetes_labels_team", "user_id"} {
+		if err := ValidateExpressions(map[string]string{"out": expression}, fields); err == nil {
+			t.Errorf("expected %q to be rejected", expression)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// LogFacetValue is the number of matching logs sharing one value of a facet.
+type LogFacetValue struct {
+	Value string `json:"value"`
+	// Name is the display name of the value, e.g. the component name of a component UID.
+	Name  string `json:"name,omitempty"`
+	Count int    `json:"count"`
+}
+
+// LogFacets summarizes the logs matching a query by component, log level and Kubernetes
+// namespace, so that faceted search UIs can offer refinements. Values are ordered from the
+// most to the least frequent.
+type LogFacets struct {
+	// Components are keyed by component UID, as accepted by the componentIds filter.
+	Components []LogFacetValue `json:"components"`
+	LogLevels  []LogFacetValue `json:"logLevels"`
+	// Namespaces are the Kubernetes namespaces of the pods that wrote the logs.
+	Namespaces []LogFacetValue `json:"namespaces"`
+}
+
+// componentLogFacet describes a facet as the stream field whose values are counted, with an
+// optional field holding their display names.
+type componentLogFacet struct {
+	name        string
+	valueColumn func(ComponentLogsParams) string
+	nameColumn  string
+}
+
+// componentLogFacets are the facets computed by ComponentLogsParams.IncludeFacets.
+var componentLogFacets = []componentLogFacet{
+	{"components", func(ComponentLogsParams) string { return "kubernetes_labels_openchoreo_dev_component_uid" }, "kubernetes_labels_openchoreo_dev_component"},
+	{"logLevels", ComponentLogsParams.levelColumn, ""},
+	{"namespaces", func(ComponentLogsParams) string { return "kubernetes_namespace_name" }, ""},
+}
+
+// componentLogFacetsResult carries the facets of a component log query back from the
+// goroutine computing them.
+type componentLogFacetsResult struct {
+	facets *LogFacets
+	debug  []QueryDebug
+	err    error
+}
+
+// generateComponentLogFacetQuery generates a query that counts matching component logs
+// grouped by the value of a facet, ordered from the most frequent value.
+func generateComponentLogFacetQuery(params ComponentLogsParams, facet componentLogFacet, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	columns := facet.valueColumn(params) + " AS value"
+	groupBy := facet.valueColumn(params)
+	if facet.nameColumn != "" {
+		columns += ", " + facet.nameColumn + " AS name"
+		groupBy += ", " + facet.nameColumn
+	}
+	sql := "SELECT " + columns + ", count(*) AS total FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY " + groupBy +
+		" ORDER BY total DESC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated %s facet query for %s component logs:\n", facet.name, stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// getComponentLogFacets runs the facet queries of a component log query against stream
+// concurrently. The scan details of the queries are returned when params.Explain is set.
+func (c *Client) getComponentLogFacets(ctx context.Context, params ComponentLogsParams, stream string) (*LogFacets, []QueryDebug, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	values := make([][]LogFacetValue, len(componentLogFacets))
+	debug := make([]QueryDebug, len(componentLogFacets))
+	errc := make(chan error, len(componentLogFacets))
+	for i, facet := range componentLogFacets {
+		go func() {
+			queryJSON, err := generateComponentLogFacetQuery(params, facet, stream, c.logger)
+			if err != nil {
+				errc <- fmt.Errorf("failed to generate %s facet query: %w", facet.name, err)
+				return
+			}
+			resp, err := c.executeSearchQuery(ctx, queryJSON)
+			if err != nil {
+				errc <- fmt.Errorf("failed to execute %s facet query: %w", facet.name, err)
+				return
+			}
+			values[i] = parseLogFacetValues(resp)
+			debug[i] = newQueryDebug("facet:"+facet.name, stream, queryJSON, resp)
+			errc <- nil
+		}()
+	}
+
+	// Report the first failure rather than the cancellations it causes in the other queries.
+	var firstErr error
+	for range componentLogFacets {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+
+	facets := &LogFacets{Components: values[0], LogLevels: values[1], Namespaces: values[2]}
+	if !params.Explain {
+		debug = nil
+	}
+	return facets, debug, nil
+}
+
+// parseLogFacetValues reads the value counts of a facet query. Logs without a value for the
+// facet field are not counted.
+func parseLogFacetValues(resp *OpenObserveResponse) []LogFacetValue {
+	values := make([]LogFacetValue, 0, len(resp.Hits))
+	for _, hit := range resp.Hits {
+		value, ok := scalarString(hit["value"])
+		if !ok || value == "" {
+			continue
+		}
+		values = append(values, LogFacetValue{Value: value, Name: stringField(hit, "name"), Count: countField(hit, "total")})
+	}
+	return values
+}
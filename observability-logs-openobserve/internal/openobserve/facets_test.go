@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateComponentLogFacetQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		LogLevels: []string{"ERROR"},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	raw, err := generateComponentLogFacetQuery(params, componentLogFacets[0], "default", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, raw)
+	for _, want := range []string{
+		"SELECT kubernetes_labels_openchoreo_dev_component_uid AS value, kubernetes_labels_openchoreo_dev_component AS name, count(*) AS total",
+		"logLevel = 'ERROR'",
+		"GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component",
+		"ORDER BY total DESC",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("expected SQL to contain %q, got %s", want, sql)
+		}
+	}
+
+	raw, err = generateComponentLogFacetQuery(params, componentLogFacets[1], "default", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, raw); !strings.HasPrefix(sql, "SELECT logLevel AS value, count(*) AS total") || !strings.Contains(sql, "GROUP BY logLevel ORDER BY") {
+		t.Errorf("unexpected log level facet SQL: %s", sql)
+	}
+
+	if _, err := generateComponentLogFacetQuery(ComponentLogsParams{}, componentLogFacets[0], "default", testLogger()); err == nil {
+		t.Error("expected error without namespace, got nil")
+	}
+}
+
+func TestGetComponentLogs_IncludeFacets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sql := body.Query.SQL
+
+		var hits []map[string]interface{}
+		switch {
+		case strings.HasPrefix(sql, "SELECT kubernetes_labels_openchoreo_dev_component_uid AS value"):
+			hits = []map[string]interface{}{
+				{"value": "c1", "name": "api", "total": float64(7)},
+				{"value": "c2", "name": "worker", "total": float64(3)},
+			}
+		case strings.HasPrefix(sql, "SELECT logLevel AS value"):
+			hits = []map[string]interface{}{
+				{"value": "INFO", "total": float64(9)},
+				{"value": nil, "total": float64(1)},
+			}
+		case strings.HasPrefix(sql, "SELECT kubernetes_namespace_name AS value"):
+			hits = []map[string]interface{}{{"value": "dp-ns", "total": float64(10)}}
+		case strings.Contains(sql, "count(*) as total"):
+			hits = []map[string]interface{}{{"total": float64(10)}}
+		default:
+			hits = []map[string]interface{}{{"_timestamp": float64(1735689600000000), "log": "hello"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: hits})
+	}))
+	defer server.Close()
+
+	params := ComponentLogsParams{
+		Namespace:     "ns",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		IncludeFacets: true,
+		Explain:       true,
+	}
+	result, err := newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 1 || result.TotalCount != 10 {
+		t.Errorf("unexpected logs: %d logs, total %d", len(result.Logs), result.TotalCount)
+	}
+
+	want := &LogFacets{
+		Components: []LogFacetValue{{Value: "c1", Name: "api", Count: 7}, {Value: "c2", Name: "worker", Count: 3}},
+		LogLevels:  []LogFacetValue{{Value: "INFO", Count: 9}},
+		Namespaces: []LogFacetValue{{Value: "dp-ns", Count: 10}},
+	}
+	if !reflect.DeepEqual(result.Facets, want) {
+		t.Errorf("expected facets %+v, got %+v", want, result.Facets)
+	}
+	if result.Debug == nil || len(result.Debug.Queries) != 5 || result.Debug.Queries[2].Name != "facet:components" {
+		t.Errorf("expected the facet queries in the debug details, got %+v", result.Debug)
+	}
+
+	params.IncludeFacets = false
+	result, err = newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Facets != nil {
+		t.Errorf("expected no facets unless requested, got %+v", result.Facets)
+	}
+}
+
+func TestGetComponentLogs_FacetError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if strings.HasPrefix(body.Query.SQL, "SELECT logLevel AS value") {
+			http.Error(w, "boom", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	params := ComponentLogsParams{
+		Namespace:     "ns",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		IncludeFacets: true,
+	}
+	if _, err := newTestClient(server.URL).GetComponentLogs(context.Background(), params); err == nil || !strings.Contains(err.Error(), "logLevels facet") {
+		t.Errorf("expected the facet query error, got %v", err)
+	}
+}
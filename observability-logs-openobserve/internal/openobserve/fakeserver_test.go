@@ -0,0 +1,183 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// APIs of the fake OpenObserve server, used to make one of them fail.
+const (
+	fakeAPISearch      = "search"
+	fakeAPIListAlerts  = "listAlerts"
+	fakeAPICreateAlert = "createAlert"
+	fakeAPIDeleteAlert = "deleteAlert"
+)
+
+// fakeMalformedBody is a response body the client cannot decode.
+const fakeMalformedBody = `{"hits": [`
+
+// fakeFailure is the response a failing API of the fake server sends.
+type fakeFailure struct {
+	status int
+	body   string
+}
+
+// fakeRequest is a request received by the fake server.
+type fakeRequest struct {
+	method string
+	path   string
+	// sql is the SQL of a search request.
+	sql string
+}
+
+// fakeOpenObserve is an in-memory OpenObserve serving the search and v2 alert APIs of the
+// "default" organization. It requires the basic auth credentials of newTestClient, records
+// every request and can be told to fail any API.
+type fakeOpenObserve struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// hits are returned by log searches and total by count queries.
+	hits  []map[string]interface{}
+	total int
+	// alerts holds the names of the alerts by ID.
+	alerts   map[string]string
+	nextID   int
+	failures map[string]fakeFailure
+	requests []fakeRequest
+}
+
+// newFakeOpenObserve starts a fake OpenObserve server that is closed with the test.
+func newFakeOpenObserve(t *testing.T) *fakeOpenObserve {
+	t.Helper()
+	f := &fakeOpenObserve{
+		alerts:   make(map[string]string),
+		failures: make(map[string]fakeFailure),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/default/_search", f.handle(fakeAPISearch, f.search))
+	mux.HandleFunc("GET /api/v2/default/alerts", f.handle(fakeAPIListAlerts, f.listAlerts))
+	mux.HandleFunc("POST /api/v2/default/alerts", f.handle(fakeAPICreateAlert, f.createAlert))
+	mux.HandleFunc("DELETE /api/v2/default/alerts/{id}", f.handle(fakeAPIDeleteAlert, f.deleteAlert))
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// fail makes api respond with status and body from now on.
+func (f *fakeOpenObserve) fail(api string, status int, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures[api] = fakeFailure{status: status, body: body}
+}
+
+// addAlert stores an alert and returns its ID.
+func (f *fakeOpenObserve) addAlert(name string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextID++
+	id := fmt.Sprintf("alert-%d", f.nextID)
+	f.alerts[id] = name
+	return id
+}
+
+// received returns the requests received so far.
+func (f *fakeOpenObserve) received() []fakeRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeRequest(nil), f.requests...)
+}
+
+// handle authenticates and records requests to api before serving them with serve, unless
+// api was made to fail.
+func (f *fakeOpenObserve) handle(api string, serve func(http.ResponseWriter, *http.Request, fakeRequest)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := fakeRequest{method: r.Method, path: r.URL.Path}
+		if api == fakeAPISearch {
+			var body struct {
+				Query struct {
+					SQL string `json:"sql"`
+				} `json:"query"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			req.sql = body.Query.SQL
+		}
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.requests = append(f.requests, req)
+
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":401,"message":"Unauthorized Access"}`))
+			return
+		}
+		if failure, ok := f.failures[api]; ok {
+			w.WriteHeader(failure.status)
+			w.Write([]byte(failure.body))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		serve(w, r, req)
+	}
+}
+
+func (f *fakeOpenObserve) search(w http.ResponseWriter, r *http.Request, req fakeRequest) {
+	resp := OpenObserveResponse{Took: 1, Hits: f.hits}
+	if strings.HasPrefix(req.sql, "SELECT count(*) as total FROM") {
+		resp.Hits = []map[string]interface{}{{"total": float64(f.total)}}
+	}
+	if resp.Hits == nil {
+		resp.Hits = []map[string]interface{}{}
+	}
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (f *fakeOpenObserve) listAlerts(w http.ResponseWriter, r *http.Request, req fakeRequest) {
+	list := make([]map[string]string, 0, len(f.alerts))
+	for id, name := range f.alerts {
+		list = append(list, map[string]string{"alert_id": id, "name": name})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"list": list})
+}
+
+func (f *fakeOpenObserve) createAlert(w http.ResponseWriter, r *http.Request, req fakeRequest) {
+	var alert struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&alert); err != nil || alert.Name == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":400,"message":"invalid alert"}`))
+		return
+	}
+	for _, name := range f.alerts {
+		if name == alert.Name {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"code":409,"message":"Alert already exists"}`))
+			return
+		}
+	}
+	f.nextID++
+	id := fmt.Sprintf("alert-%d", f.nextID)
+	f.alerts[id] = alert.Name
+	json.NewEncoder(w).Encode(map[string]string{"id": id})
+}
+
+func (f *fakeOpenObserve) deleteAlert(w http.ResponseWriter, r *http.Request, req fakeRequest) {
+	id := r.PathValue("id")
+	if _, ok := f.alerts[id]; !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":404,"message":"Alert not found"}`))
+		return
+	}
+	delete(f.alerts, id)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Functions a grouped aggregation can compute per group.
+const (
+	AggregateCount = "count"
+	AggregateSum   = "sum"
+	AggregateAvg   = "avg"
+)
+
+// MaxGroupByFields bounds the number of fields a grouped aggregation may group by.
+const MaxGroupByFields = 4
+
+// groupByColumns maps the fields a grouped aggregation may group by to the stream field
+// holding them. Only these fields can be grouped by, so that requests never reference
+// arbitrary columns.
+var groupByColumns = map[string]func(ComponentLogsParams) string{
+	"componentUid":   func(ComponentLogsParams) string { return "kubernetes_labels_openchoreo_dev_component_uid" },
+	"componentName":  func(ComponentLogsParams) string { return "kubernetes_labels_openchoreo_dev_component" },
+	"projectUid":     func(ComponentLogsParams) string { return "kubernetes_labels_openchoreo_dev_project_uid" },
+	"environmentUid": func(ComponentLogsParams) string { return "kubernetes_labels_openchoreo_dev_environment_uid" },
+	"namespace":      func(ComponentLogsParams) string { return "kubernetes_namespace_name" },
+	"podName":        func(ComponentLogsParams) string { return "kubernetes_pod_name" },
+	"podId":          func(ComponentLogsParams) string { return "kubernetes_pod_id" },
+	"containerName":  func(ComponentLogsParams) string { return "kubernetes_container_name" },
+	"logLevel":       ComponentLogsParams.levelColumn,
+	"stream":         ComponentLogsParams.streamColumn,
+	"version":        ComponentLogsParams.versionColumn,
+}
+
+// GroupByFields returns the fields a grouped aggregation may group by, sorted by name.
+func GroupByFields() []string {
+	fields := make([]string, 0, len(groupByColumns))
+	for field := range groupByColumns {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// ValidGroupByField reports whether a grouped aggregation may group by field.
+func ValidGroupByField(field string) bool {
+	_, ok := groupByColumns[field]
+	return ok
+}
+
+// GroupedAggregation describes a grouped aggregation: the matching logs are grouped by the
+// values of GroupBy and Function is computed per group. Sum and avg aggregate the numeric
+// log field Field; count counts the logs.
+type GroupedAggregation struct {
+	GroupBy  []string
+	Function string
+	Field    string
+}
+
+// LogGroup is one group of a grouped aggregation, keyed by its group-by field values.
+type LogGroup struct {
+	Keys  map[string]string `json:"keys"`
+	Count int               `json:"count"`
+	// Value is the sum or average of the aggregated field, absent for count aggregations.
+	Value *float64 `json:"value,omitempty"`
+}
+
+// GroupedAggregationResult represents the result of a grouped aggregation, ordered from the
+// largest group down.
+type GroupedAggregationResult struct {
+	GroupBy  []string   `json:"groupBy"`
+	Function string     `json:"function"`
+	Field    string     `json:"field,omitempty"`
+	Groups   []LogGroup `json:"groups"`
+	Took     int        `json:"took"`
+}
+
+// groupAlias returns the result column name of the i-th group-by field.
+func groupAlias(i int) string {
+	return "group_" + strconv.Itoa(i)
+}
+
+// generateGroupedAggregationQuery generates a query computing agg.Function over the matching
+// component logs grouped by agg.GroupBy, ordered from the largest group down.
+func generateGroupedAggregationQuery(params ComponentLogsParams, agg GroupedAggregation, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+	if len(agg.GroupBy) == 0 || len(agg.GroupBy) > MaxGroupByFields {
+		return nil, fmt.Errorf("between 1 and %d group-by fields are required", MaxGroupByFields)
+	}
+
+	columns := make([]string, 0, len(agg.GroupBy)+2)
+	groupBy := make([]string, 0, len(agg.GroupBy))
+	for i, field := range agg.GroupBy {
+		column, ok := groupByColumns[field]
+		if !ok {
+			return nil, fmt.Errorf("invalid group-by field %q", field)
+		}
+		columns = append(columns, column(params)+" AS "+groupAlias(i))
+		groupBy = append(groupBy, column(params))
+	}
+	columns = append(columns, "count(*) AS total")
+
+	conditions := componentLogsConditions(params)
+	orderBy := "total"
+	switch agg.Function {
+	case AggregateCount:
+	case AggregateSum, AggregateAvg:
+		if !ValidFieldName(agg.Field) {
+			return nil, fmt.Errorf("invalid field name %q", agg.Field)
+		}
+		columns = append(columns, agg.Function+"("+agg.Field+") AS value")
+		conditions = append(conditions, agg.Field+" IS NOT NULL")
+		orderBy = "value"
+	default:
+		return nil, fmt.Errorf("invalid aggregation function %q", agg.Function)
+	}
+
+	sql := "SELECT " + strings.Join(columns, ", ") + " FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(conditions, " AND ") +
+		" GROUP BY " + strings.Join(groupBy, ", ") +
+		" ORDER BY " + orderBy + " DESC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated grouped %s query for %s component logs:\n", agg.Function, stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// GetGroupedAggregation computes agg over the matching component logs in the time window,
+// e.g. the log count per component and level or the summed response bytes per pod.
+func (c *Client) GetGroupedAggregation(ctx context.Context, params ComponentLogsParams, agg GroupedAggregation) (*GroupedAggregationResult, error) {
+	params = c.withFieldNames(params)
+	stream, err := c.logStream(params)
+	if err != nil {
+		return nil, err
+	}
+	queryJSON, err := generateGroupedAggregationQuery(params, agg, stream, c.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate grouped aggregation query: %w", err)
+	}
+
+	openObserveResp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]LogGroup, 0, len(openObserveResp.Hits))
+	for _, hit := range openObserveResp.Hits {
+		groups = append(groups, parseLogGroupHit(hit, agg))
+	}
+
+	result := &GroupedAggregationResult{
+		GroupBy:  agg.GroupBy,
+		Function: agg.Function,
+		Groups:   groups,
+		Took:     openObserveResp.Took,
+	}
+	if agg.Function != AggregateCount {
+		result.Field = agg.Field
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateGroupedAggregationQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		params ComponentLogsParams
+		agg    GroupedAggregation
+		checks []string
+	}{
+		{
+			name:   "count",
+			params: params,
+			agg:    GroupedAggregation{GroupBy: []string{"componentName", "logLevel"}, Function: AggregateCount},
+			checks: []string{
+				"SELECT kubernetes_labels_openchoreo_dev_component AS group_0, logLevel AS group_1, count(*) AS total FROM",
+				"GROUP BY kubernetes_labels_openchoreo_dev_component, logLevel ORDER BY total DESC",
+			},
+		},
+		{
+			name:   "sum",
+			params: params,
+			agg:    GroupedAggregation{GroupBy: []string{"podName"}, Function: AggregateSum, Field: "bytes"},
+			checks: []string{
+				"kubernetes_pod_name AS group_0, count(*) AS total, sum(bytes) AS value FROM",
+				"bytes IS NOT NULL",
+				"GROUP BY kubernetes_pod_name ORDER BY value DESC",
+			},
+		},
+		{
+			name:   "custom version field",
+			params: ComponentLogsParams{Namespace: "test-ns", versionField: "kubernetes_labels_app_version"},
+			agg:    GroupedAggregation{GroupBy: []string{"version"}, Function: AggregateAvg, Field: "latency_ms"},
+			checks: []string{
+				"kubernetes_labels_app_version AS group_0",
+				"avg(latency_ms) AS value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := generateGroupedAggregationQuery(tt.params, tt.agg, "mystream", testLogger())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			sql, q := sqlOf(t, raw)
+			for _, check := range tt.checks {
+				if !strings.Contains(sql, check) {
+					t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+				}
+			}
+			if q["size"].(float64) != aggregationResultLimit {
+				t.Errorf("expected size %d, got %v", aggregationResultLimit, q["size"])
+			}
+		})
+	}
+}
+
+func TestGenerateGroupedAggregationQuery_Invalid(t *testing.T) {
+	params := ComponentLogsParams{Namespace: "test-ns"}
+	tests := []struct {
+		name   string
+		params ComponentLogsParams
+		agg    GroupedAggregation
+	}{
+		{"missing namespace", ComponentLogsParams{}, GroupedAggregation{GroupBy: []string{"logLevel"}, Function: AggregateCount}},
+		{"no group-by fields", params, GroupedAggregation{Function: AggregateCount}},
+		{"too many group-by fields", params, GroupedAggregation{GroupBy: []string{"logLevel", "podName", "podId", "stream", "version"}, Function: AggregateCount}},
+		{"unknown group-by field", params, GroupedAggregation{GroupBy: []string{"log"}, Function: AggregateCount}},
+		{"unknown function", params, GroupedAggregation{GroupBy: []string{"logLevel"}, Function: "max"}},
+		{"injected field", params, GroupedAggregation{GroupBy: []string{"logLevel"}, Function: AggregateSum, Field: "bytes) FROM x --"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generateGroupedAggregationQuery(tt.params, tt.agg, "mystream", testLogger()); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestGetGroupedAggregation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Took: 4,
+			Hits: []map[string]interface{}{
+				{"group_0": "api", "group_1": "ERROR", "total": float64(12), "value": float64(2048)},
+				{"group_0": "worker", "total": float64(3), "value": float64(512.5)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	result, err := client.GetGroupedAggregation(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}, GroupedAggregation{GroupBy: []string{"componentName", "logLevel"}, Function: AggregateSum, Field: "bytes"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Function != AggregateSum || result.Field != "bytes" || result.Took != 4 || len(result.Groups) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first := result.Groups[0]
+	if first.Keys["componentName"] != "api" || first.Keys["logLevel"] != "ERROR" || first.Count != 12 ||
+		first.Value == nil || *first.Value != 2048 {
+		t.Errorf("unexpected group: %+v", first)
+	}
+	if second := result.Groups[1]; second.Keys["logLevel"] != "" || *second.Value != 512.5 {
+		t.Errorf("unexpected group: %+v", second)
+	}
+}
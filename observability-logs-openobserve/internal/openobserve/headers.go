@@ -0,0 +1,89 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// reservedHeaders are set by the client itself and cannot be overridden by extra headers.
+var reservedHeaders = map[string]bool{
+	"Authorization":     true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Host":              true,
+	"Transfer-Encoding": true,
+}
+
+// ParseHeaders parses a comma-separated list of Name=value pairs (e.g.
+// "X-Scope-OrgID=team-a,X-Api-Key=secret") into the extra headers sent with every request to
+// OpenObserve. Header names must be valid HTTP tokens and may not be one of the headers the
+// client sets itself, such as Authorization.
+func ParseHeaders(value string) (http.Header, error) {
+	headers := make(http.Header)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, val, ok := strings.Cut(pair, "=")
+		name, val = strings.TrimSpace(name), strings.TrimSpace(val)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid header %q: expected Name=value", pair)
+		}
+		if !validHeaderName(name) {
+			return nil, fmt.Errorf("invalid header name %q", name)
+		}
+		name = http.CanonicalHeaderKey(name)
+		if reservedHeaders[name] {
+			return nil, fmt.Errorf("header %s is set by the adapter and cannot be overridden", name)
+		}
+		if strings.ContainsFunc(val, func(r rune) bool { return r < ' ' || r == 0x7f }) {
+			return nil, fmt.Errorf("invalid value for header %s: must not contain control characters", name)
+		}
+		if _, dup := headers[name]; dup {
+			return nil, fmt.Errorf("header %s is set more than once", name)
+		}
+		headers.Set(name, val)
+	}
+	return headers, nil
+}
+
+// validHeaderName reports whether name is an HTTP token (RFC 9110, section 5.1).
+func validHeaderName(name string) bool {
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
+
+// headerTransport adds fixed headers to every request, e.g. those required by a gateway in
+// front of OpenObserve.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		req.Header[name] = values
+	}
+	return t.base.RoundTrip(req)
+}
+
+// CloseIdleConnections lets http.Client.CloseIdleConnections reach the base transport.
+func (t *headerTransport) CloseIdleConnections() {
+	if c, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders(" x-scope-orgid = team-a , X-Api-Key=abc=def,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(headers) != 2 || headers.Get("X-Scope-Orgid") != "team-a" || headers.Get("X-Api-Key") != "abc=def" {
+		t.Errorf("unexpected headers: %v", headers)
+	}
+
+	if headers, err := ParseHeaders(""); err != nil || len(headers) != 0 {
+		t.Errorf("expected no headers, got %v, %v", headers, err)
+	}
+
+	for _, value := range []string{
+		"X-Api-Key",
+		"=value",
+		"X Api Key=value",
+		"X-Api-Key:=value",
+		"authorization=Bearer x",
+		"Content-Type=text/plain",
+		"X-Api-Key=a\tb",
+		"X-Api-Key=a,x-api-key=b",
+	} {
+		if _, err := ParseHeaders(value); err == nil {
+			t.Errorf("%q: expected error, got nil", value)
+		}
+	}
+}
+
+func TestClient_ExtraHeaders(t *testing.T) {
+	var got []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Clone(context.Background()))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/healthz" {
+			w.Write([]byte(`{"status":"ok"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	headers := http.Header{"X-Scope-Orgid": {"team-a"}}
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{Headers: headers}, testLogger())
+	headers.Set("X-Scope-Orgid", "changed")
+
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) == 0 {
+		t.Fatal("expected requests to OpenObserve")
+	}
+	for _, r := range got {
+		if r.Header.Get("X-Scope-OrgID") != "team-a" {
+			t.Errorf("%s: expected the extra header on every request, got %v", r.URL.Path, r.Header)
+		}
+		if _, _, ok := r.BasicAuth(); !ok && r.URL.Path != "/healthz" {
+			t.Errorf("%s: expected the basic auth header to be kept, got %v", r.URL.Path, r.Header)
+		}
+	}
+}
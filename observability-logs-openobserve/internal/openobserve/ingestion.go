@@ -0,0 +1,60 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"time"
+)
+
+// IngestionLagError is returned for a component log query whose MinIngestionLag is malformed,
+// or set although no event time field is configured (see ClientOptions.EventTimeField). The
+// query is not run.
+type IngestionLagError struct {
+	Value  string
+	Reason string
+}
+
+func (e *IngestionLagError) Error() string {
+	return fmt.Sprintf("invalid minIngestionLag %q: %s", e.Value, e.Reason)
+}
+
+// ValidateIngestionLag checks the MinIngestionLag of a component log query. The error is an
+// *IngestionLagError, suitable for returning to the caller.
+func (c *Client) ValidateIngestionLag(value string) error {
+	_, err := c.ingestionLag(ComponentLogsParams{MinIngestionLag: value})
+	return err
+}
+
+// ingestionLag returns the minimum ingestion lag selected by params, or zero if it selects
+// none.
+func (c *Client) ingestionLag(params ComponentLogsParams) (time.Duration, error) {
+	if params.MinIngestionLag == "" {
+		return 0, nil
+	}
+	lag, err := time.ParseDuration(params.MinIngestionLag)
+	if err != nil || lag <= 0 {
+		return 0, &IngestionLagError{Value: params.MinIngestionLag, Reason: "must be a positive duration such as 30s"}
+	}
+	if c.eventTimeField == "" {
+		return 0, &IngestionLagError{Value: params.MinIngestionLag, Reason: "no event time field is configured"}
+	}
+	return lag, nil
+}
+
+// ingestionLagCondition returns the SQL condition selecting the logs ingested more than
+// params.MinIngestionLag after their event time, or "" if the query selects no lag.
+// OpenObserve sets _timestamp at ingestion unless the stream maps it from the log, so the
+// difference is measured against DefaultTimestampField even when a custom timestamp field is
+// configured.
+func (p ComponentLogsParams) ingestionLagCondition() string {
+	if p.MinIngestionLag == "" || p.eventTimeField == "" {
+		return ""
+	}
+	lag, err := time.ParseDuration(p.MinIngestionLag)
+	if err != nil || lag <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s - %s > %d", DefaultTimestampField, p.eventTimeField, lag.Microseconds())
+}
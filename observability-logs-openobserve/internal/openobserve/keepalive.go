@@ -0,0 +1,78 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// keepAlivePingTimeout bounds a single keep-alive health check.
+const keepAlivePingTimeout = 10 * time.Second
+
+// Ping checks that OpenObserve is reachable and reports itself healthy.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/healthz", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so that the connection can be reused by the next request.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// KeepAlive periodically recycles the idle connections to OpenObserve and checks its health,
+// every ClientOptions.KeepAliveInterval, until ctx is done. Connections left idle for long
+// may have been dropped silently by OpenObserve or a proxy in between, failing the first
+// query that reuses them; recycling them keeps a freshly opened connection ready instead.
+// It returns immediately if no interval is configured.
+func (c *Client) KeepAlive(ctx context.Context) {
+	if c.keepAlive <= 0 {
+		return
+	}
+	ticker := time.NewTicker(c.keepAlive)
+	defer ticker.Stop()
+
+	healthy := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.httpClient.CloseIdleConnections()
+		pingCtx, cancel := context.WithTimeout(ctx, keepAlivePingTimeout)
+		err := c.Ping(pingCtx)
+		cancel()
+
+		switch {
+		case err != nil && ctx.Err() == nil:
+			c.logger.Warn("OpenObserve keep-alive health check failed", slog.Any("error", err))
+			healthy = false
+		case err == nil && !healthy:
+			c.logger.Info("OpenObserve keep-alive health check recovered")
+			healthy = true
+		case err == nil:
+			c.logger.Debug("OpenObserve keep-alive health check succeeded")
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPing(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		w.WriteHeader(int(status.Load()))
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	if err := client.Ping(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	status.Store(http.StatusServiceUnavailable)
+	if err := client.Ping(context.Background()); err == nil {
+		t.Error("expected error for unhealthy OpenObserve, got nil")
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	var pings atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pings.Add(1)
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{KeepAliveInterval: 10 * time.Millisecond}, testLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		client.KeepAlive(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for pings.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if pings.Load() < 3 {
+		t.Errorf("expected periodic health checks, got %d", pings.Load())
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("KeepAlive did not return after the context was canceled")
+	}
+}
+
+func TestKeepAlive_Disabled(t *testing.T) {
+	done := make(chan struct{})
+	go func() {
+		newTestClient("http://127.0.0.1:0").KeepAlive(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected KeepAlive to return immediately without an interval")
+	}
+}
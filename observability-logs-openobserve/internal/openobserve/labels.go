@@ -0,0 +1,54 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// labelKeyPattern matches Kubernetes label keys: a name, optionally prefixed with a DNS
+// subdomain and a slash (e.g. app.kubernetes.io/name).
+var labelKeyPattern = regexp.MustCompile(`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
+// ValidLabelKey reports whether key is a Kubernetes label key.
+func ValidLabelKey(key string) bool {
+	return len(key) <= 317 && labelKeyPattern.MatchString(key)
+}
+
+// labelColumn returns the stream field holding a Kubernetes pod label. OpenObserve flattens
+// the label into a kubernetes_labels_ field, lowercased and with every character other than
+// a letter or digit replaced by an underscore, so app.kubernetes.io/name is stored as
+// kubernetes_labels_app_kubernetes_io_name.
+func labelColumn(key string) string {
+	return "kubernetes_labels_" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		default:
+			return '_'
+		}
+	}, key)
+}
+
+// labelSelectorConditions returns the SQL conditions matching the pod labels in selectors,
+// sorted by label key so that identical selectors produce identical queries. Keys that are
+// not valid label keys are skipped; callers are expected to validate them first.
+func labelSelectorConditions(selectors map[string]string) []string {
+	keys := make([]string, 0, len(selectors))
+	for key := range selectors {
+		if ValidLabelKey(key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	conditions := make([]string, len(keys))
+	for i, key := range keys {
+		conditions[i] = labelColumn(key) + " = '" + escapeSQLString(selectors[key]) + "'"
+	}
+	return conditions
+}
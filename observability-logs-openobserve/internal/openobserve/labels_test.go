@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidLabelKey(t *testing.T) {
+	for _, key := range []string{"app", "app.kubernetes.io/name", "openchoreo.dev/component-uid", "Tier_1"} {
+		if !ValidLabelKey(key) {
+			t.Errorf("expected %q to be a valid label key", key)
+		}
+	}
+	for _, key := range []string{"", "-app", "app/", "/name", "a/b/c", "app name", "app'"} {
+		if ValidLabelKey(key) {
+			t.Errorf("expected %q to be rejected", key)
+		}
+	}
+}
+
+func TestGenerateComponentLogsQuery_LabelSelectors(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		LabelSelectors: map[string]string{
+			"app.kubernetes.io/name": "checkout",
+			"Tier":                   "it's",
+		},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	want := "kubernetes_labels_tier = 'it''s' AND kubernetes_labels_app_kubernetes_io_name = 'checkout'"
+	if !strings.Contains(sql, want) {
+		t.Errorf("expected label filters %q, got: %s", want, sql)
+	}
+}
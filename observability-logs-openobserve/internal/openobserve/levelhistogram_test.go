@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateComponentLogLevelHistogramQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogLevelHistogramQuery(params, 5*time.Minute, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	want := `SELECT histogram(_timestamp, '300 second') AS bucket, logLevel AS level, count(*) AS total FROM "mystream" WHERE `
+	if !strings.HasPrefix(sql, want) || !strings.HasSuffix(sql, " GROUP BY bucket, level ORDER BY bucket") {
+		t.Errorf("unexpected query: %s", sql)
+	}
+
+	if _, err := generateComponentLogLevelHistogramQuery(params, 1500*time.Millisecond, "mystream", testLogger()); err == nil {
+		t.Error("expected error for a fractional interval, got nil")
+	}
+}
+
+func TestParseLogLevelHistogram_Unordered(t *testing.T) {
+	result := parseLogLevelHistogram([]map[string]interface{}{
+		{"bucket": "2025-01-01T01:00:00", "level": "WARN", "total": float64(1)},
+		{"bucket": "2025-01-01T00:00:00", "level": "ERROR", "total": float64(2)},
+	})
+	if len(result.Buckets) != 2 || !result.Buckets[0].Start.Before(result.Buckets[1].Start) {
+		t.Fatalf("expected the buckets oldest first, got %+v", result.Buckets)
+	}
+	if counts := result.Buckets[0].Counts; counts["ERROR"] != 2 || counts["WARN"] != 0 || len(counts) != 2 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+}
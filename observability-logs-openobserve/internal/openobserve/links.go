@@ -0,0 +1,46 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// logLinkMargin is how far around the timestamp of a log its OpenObserve UI link searches.
+// The link selects the log by its exact timestamp; the margin only sets the time picker.
+const logLinkMargin = time.Second
+
+// logLink returns the URL of the OpenObserve UI showing entry, read from stream, or "" if no
+// UI URL is configured (see ClientOptions.UIURL). The link runs a SQL query selecting the
+// log by its timestamp and, when known, its pod, in a window of logLinkMargin around it.
+func (c *Client) logLink(stream string, entry ComponentLogsEntry) string {
+	if c.uiURL == "" {
+		return ""
+	}
+	at := entry.Timestamp.UnixMicro()
+	sql := fmt.Sprintf("SELECT * FROM %s WHERE %s = %d", quoteIdentifier(stream), c.timestampField, at)
+	if entry.PodName != "" {
+		sql += " AND kubernetes_pod_name = '" + escapeSQLString(entry.PodName) + "'"
+	}
+	org := c.org
+	if entry.Org != "" {
+		org = entry.Org
+	}
+
+	query := url.Values{}
+	query.Set("org_identifier", org)
+	query.Set("stream_type", "logs")
+	query.Set("stream", stream)
+	query.Set("from", strconv.FormatInt(at-logLinkMargin.Microseconds(), 10))
+	query.Set("to", strconv.FormatInt(at+logLinkMargin.Microseconds(), 10))
+	query.Set("sql_mode", "true")
+	// The UI takes the query base64-encoded.
+	query.Set("query", base64.StdEncoding.EncodeToString([]byte(sql)))
+	return strings.TrimSuffix(c.uiURL, "/") + "/web/logs?" + query.Encode()
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestGetComponentLogs_Links(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"hits":[{"total":1}]}`))
+			return
+		}
+		w.Write([]byte(`{"took":1,"hits":[{"_timestamp":1735689600000000,"log":"boom","kubernetes_pod_name":"api-0"}]}`))
+	}))
+	defer server.Close()
+
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := newTestClient(server.URL).GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Logs[0].Link != "" {
+		t.Errorf("expected no link without a UI URL, got %q", result.Logs[0].Link)
+	}
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", ClientOptions{
+		UIURL: "https://ui.example.com/",
+	}, testLogger())
+	result, err = client.GetComponentLogs(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	link, err := url.Parse(result.Logs[0].Link)
+	if err != nil {
+		t.Fatalf("invalid link %q: %v", result.Logs[0].Link, err)
+	}
+	if got := link.Scheme + "://" + link.Host + link.Path; got != "https://ui.example.com/web/logs" {
+		t.Errorf("unexpected link target %q", got)
+	}
+	query := link.Query()
+	if query.Get("org_identifier") != "default" || query.Get("stream") != "default" ||
+		query.Get("from") != "1735689599000000" || query.Get("to") != "1735689601000000" {
+		t.Errorf("unexpected link parameters: %v", query)
+	}
+	sql, err := base64.StdEncoding.DecodeString(query.Get("query"))
+	if err != nil {
+		t.Fatalf("invalid link query: %v", err)
+	}
+	if want := `SELECT * FROM "default" WHERE _timestamp = 1735689600000000 AND kubernetes_pod_name = 'api-0'`; string(sql) != want {
+		t.Errorf("expected link query %q, got %q", want, sql)
+	}
+}
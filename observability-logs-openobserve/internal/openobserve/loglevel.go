@@ -0,0 +1,88 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"regexp"
+	"strings"
+)
+
+// DefaultLevelField is the stream field holding the log level when ClientOptions.LevelField
+// is not set.
+const DefaultLevelField = "logLevel"
+
+// defaultLogLevel is the level of the logs whose message names none.
+const defaultLogLevel = "INFO"
+
+// messageLevels are the levels looked for in a log message by extractLogLevel, in order of
+// precedence, with the level each stands for.
+var messageLevels = []struct{ keyword, level string }{
+	{"ERROR", "ERROR"},
+	{"FATAL", "FATAL"},
+	{"SEVERE", "SEVERE"},
+	{"WARN", "WARN"},
+	{"INFO", "INFO"},
+	{"DEBUG", "DEBUG"},
+}
+
+// ValidLevelPattern reports whether pattern can derive log levels from log messages: it must
+// compile and have a capturing group, which captures the level.
+func ValidLevelPattern(pattern *regexp.Regexp) bool {
+	return pattern != nil && pattern.NumSubexp() >= 1
+}
+
+// levelColumn returns the SQL expression of the log level: the level field, or, for streams
+// without one, the level derived from the log message the same way as the level of the
+// returned entries (see Client.entryLogLevel).
+func (p ComponentLogsParams) levelColumn() string {
+	if !p.levelFromMessage {
+		if p.levelField == "" {
+			return DefaultLevelField
+		}
+		return p.levelField
+	}
+	if p.levelPattern != "" {
+		return "coalesce(upper(regexp_match(" + p.messageColumn() + ", '" + escapeSQLString(p.levelPattern) + "')[1]), '" + defaultLogLevel + "')"
+	}
+	var b strings.Builder
+	b.WriteString("CASE")
+	for _, l := range messageLevels {
+		b.WriteString(" WHEN " + phraseCondition(p.messageColumn(), l.keyword, false) + " THEN '" + l.level + "'")
+	}
+	b.WriteString(" ELSE '" + defaultLogLevel + "' END")
+	return b.String()
+}
+
+// levelConditions returns the SQL condition matching the logs of any of levels, or "" if
+// there are none.
+func (p ComponentLogsParams) levelConditions(levels []string) string {
+	if len(levels) == 0 {
+		return ""
+	}
+	column := p.levelColumn()
+	conditions := make([]string, len(levels))
+	for i, level := range levels {
+		conditions[i] = column + " = '" + escapeSQLString(level) + "'"
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")"
+}
+
+// entryLogLevel returns the level of a returned log: its level field, unless the levels are
+// derived from the messages (see ClientOptions.LevelFromMessage). Logs without a level get
+// the one their message names.
+func (c *Client) entryLogLevel(source map[string]interface{}, log string) string {
+	if !c.levelFromMessage {
+		if level, ok := scalarString(source[c.levelField]); ok && strings.TrimSpace(level) != "" {
+			return strings.TrimSpace(level)
+		}
+		return extractLogLevel(log)
+	}
+	if c.levelPattern != nil {
+		if match := c.levelPattern.FindStringSubmatch(log); len(match) > 1 && match[1] != "" {
+			return strings.ToUpper(match[1])
+		}
+		return defaultLogLevel
+	}
+	return extractLogLevel(log)
+}
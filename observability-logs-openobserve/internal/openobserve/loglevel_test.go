@@ -0,0 +1,114 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComponentLogsQuery_LevelSources(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ClientOptions
+		want    string
+		notWant string
+	}{
+		{
+			name: "default field",
+			want: "(logLevel = 'ERROR' OR logLevel = 'WARN')",
+		},
+		{
+			name: "custom field",
+			opts: ClientOptions{LevelField: "severity"},
+			want: "(severity = 'ERROR' OR severity = 'WARN')",
+		},
+		{
+			name:    "derived from the message",
+			opts:    ClientOptions{LevelFromMessage: true},
+			want:    `CASE WHEN log ILIKE '%ERROR%' ESCAPE '\\' THEN 'ERROR' WHEN log ILIKE '%FATAL%' ESCAPE '\\' THEN 'FATAL'`,
+			notWant: "logLevel",
+		},
+		{
+			name:    "derived with a pattern",
+			opts:    ClientOptions{LevelFromMessage: true, LevelPattern: regexp.MustCompile(`level=(\w+)`), MessageField: "message"},
+			want:    `(coalesce(upper(regexp_match(message, 'level=(\\w+)')[1]), 'INFO') = 'ERROR' OR `,
+			notWant: "logLevel",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sqls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				sql, _ := sqlOf(t, body)
+				sqls = append(sqls, sql)
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"took":1,"hits":[]}`))
+			}))
+			defer server.Close()
+
+			client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token", tt.opts, testLogger())
+			params := ComponentLogsParams{
+				Namespace: "test-ns",
+				StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+				LogLevels: []string{"ERROR", "WARN"},
+			}
+			if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, sql := range sqls {
+				if !strings.Contains(sql, tt.want) {
+					t.Errorf("expected %q in %s", tt.want, sql)
+				}
+				if tt.notWant != "" && strings.Contains(sql, tt.notWant) {
+					t.Errorf("expected no %q in %s", tt.notWant, sql)
+				}
+			}
+		})
+	}
+}
+
+func TestParseApplicationLogEntry_LevelSources(t *testing.T) {
+	source := map[string]interface{}{
+		"_timestamp": float64(1735689600000000),
+		"log":        "ts=1 level=warn msg=\"disk almost full, ERROR soon\"",
+		"logLevel":   "DEBUG",
+	}
+	tests := []struct {
+		name string
+		opts ClientOptions
+		want string
+	}{
+		{"level field", ClientOptions{}, "DEBUG"},
+		{"missing custom field", ClientOptions{LevelField: "severity"}, "ERROR"},
+		{"keywords", ClientOptions{LevelFromMessage: true}, "ERROR"},
+		{"pattern", ClientOptions{LevelFromMessage: true, LevelPattern: regexp.MustCompile(`level=(\w+)`)}, "WARN"},
+		{"unmatched pattern", ClientOptions{LevelFromMessage: true, LevelPattern: regexp.MustCompile(`severity=(\w+)`)}, "INFO"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token", tt.opts, testLogger())
+			if got := client.parseApplicationLogEntry(source).LogLevel; got != tt.want {
+				t.Errorf("expected level %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidLevelPattern(t *testing.T) {
+	if !ValidLevelPattern(regexp.MustCompile(`level=(\w+)`)) {
+		t.Error("expected a pattern with a group to be valid")
+	}
+	if ValidLevelPattern(regexp.MustCompile(`level=\w+`)) || ValidLevelPattern(nil) {
+		t.Error("expected a pattern without a group to be invalid")
+	}
+}
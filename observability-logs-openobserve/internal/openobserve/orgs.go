@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultOrgConcurrency is the number of organizations searched at a time when
+// GetComponentLogsAcrossOrgs is given no bound.
+const DefaultOrgConcurrency = 4
+
+// OrgLogsResult holds the logs matching a query across several organizations, merged in the
+// requested order. Each entry carries its organization in Org.
+type OrgLogsResult struct {
+	Orgs []string             `json:"orgs"`
+	Logs []ComponentLogsEntry `json:"logs"`
+	// TotalCount is the number of matching logs summed over the organizations.
+	TotalCount int `json:"totalCount"`
+	// Took is the duration of the slowest organization's query, in milliseconds.
+	Took int `json:"took"`
+	// NearLimit is set when an organization's result was likely truncated or the merged
+	// logs were cut to the query limit.
+	NearLimit bool `json:"nearLimit"`
+}
+
+// ForOrg returns a copy of the client querying org instead of the configured organization,
+// e.g. to serve a tenant mapped to its own organization. The copy shares the HTTP client but
+// not the query deduplication, whose keys do not include the organization, so identical
+// concurrent queries are only deduplicated among the copy's own queries.
+func (c *Client) ForOrg(org string) *Client {
+	clone := *c
+	clone.org = org
+	clone.flights = &queryFlightGroup{window: c.flights.window}
+	return &clone
+}
+
+// Org returns the OpenObserve organization the client queries.
+func (c *Client) Org() string {
+	return c.org
+}
+
+// GetComponentLogsAcrossOrgs runs a component log query in each of orgs, at most concurrency
+// at a time, and merges the logs in the requested order up to params.Limit. If any
+// organization's query fails, the others are canceled and the first failure is returned.
+func (c *Client) GetComponentLogsAcrossOrgs(ctx context.Context, orgs []string, params ComponentLogsParams, concurrency int) (*OrgLogsResult, error) {
+	if len(orgs) == 0 {
+		return nil, fmt.Errorf("at least one organization is required")
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultOrgConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*ComponentLogsResult, len(orgs))
+	var mu sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, org := range orgs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			result, err := c.ForOrg(org).GetComponentLogs(ctx, params)
+			if err != nil {
+				mu.Lock()
+				// Report the first failure rather than the cancellation it causes in the others.
+				if firstErr == nil {
+					firstErr = fmt.Errorf("org %s: %w", org, err)
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	merged := OrgLogsResult{Orgs: orgs, Logs: []ComponentLogsEntry{}}
+	for i, result := range results {
+		for _, entry := range result.Logs {
+			entry.Org = orgs[i]
+			merged.Logs = append(merged.Logs, entry)
+		}
+		merged.TotalCount += result.TotalCount
+		merged.Took = max(merged.Took, result.Took)
+		merged.NearLimit = merged.NearLimit || result.NearLimit
+	}
+	sortLogEntries(merged.Logs, params.SortOrder)
+	// Each organization returned up to the limit, so the merged logs are trimmed back to it.
+	if limit := logsLimit(params.Limit); len(merged.Logs) > limit {
+		merged.Logs = merged.Logs[:limit]
+		merged.NearLimit = true
+	}
+	// The logs are numbered in the merged order rather than within their organization.
+	if !params.sampled() {
+		for i := range merged.Logs {
+			merged.Logs[i].Index = i + 1
+		}
+	}
+	if params.SortField != "" {
+		sortLogEntriesBy(merged.Logs, params.SortField, params.SortOrder)
+	}
+	return &merged, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGetComponentLogsAcrossOrgs(t *testing.T) {
+	// Each organization logged one line, at a second past the epoch of its index.
+	orgSeconds := map[string]int64{"team-a": 3, "team-b": 1, "team-c": 2}
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		org := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/"), "/")[0]
+		seconds, ok := orgSeconds[org]
+		if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("unknown org"))
+			return
+		}
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		resp := OpenObserveResponse{Took: int(seconds), Hits: []map[string]interface{}{
+			{"_timestamp": float64(seconds * 1000000), "log": "hello from " + org},
+		}}
+		if strings.HasPrefix(body.Query.SQL, "SELECT count(*)") {
+			resp.Hits = []map[string]interface{}{{"total": float64(10)}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Unix(0, 0),
+		EndTime:   time.Unix(60, 0),
+		Limit:     2,
+	}
+	result, err := client.GetComponentLogsAcrossOrgs(context.Background(), []string{"team-a", "team-b", "team-c"}, params, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxInFlight != 1 {
+		t.Errorf("expected the organizations to be searched one at a time, got %d at once", maxInFlight)
+	}
+	if len(result.Logs) != 2 || result.Logs[0].Org != "team-a" || result.Logs[1].Org != "team-c" {
+		t.Fatalf("expected the newest logs of team-a and team-c, got %+v", result.Logs)
+	}
+	if result.Logs[0].Log != "hello from team-a" {
+		t.Errorf("unexpected log: %q", result.Logs[0].Log)
+	}
+	if result.TotalCount != 30 || result.Took != 3 || !result.NearLimit {
+		t.Errorf("unexpected totals: count %d, took %d, near limit %v", result.TotalCount, result.Took, result.NearLimit)
+	}
+
+	params.SortOrder = "asc"
+	params.Limit = 10
+	result, err = client.GetComponentLogsAcrossOrgs(context.Background(), []string{"team-a", "team-b"}, params, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 2 || result.Logs[0].Org != "team-b" || result.NearLimit {
+		t.Errorf("expected team-b first and no truncation, got %+v", result)
+	}
+
+	if _, err := client.GetComponentLogsAcrossOrgs(context.Background(), []string{"team-a", "unknown"}, params, 2); err == nil ||
+		!strings.Contains(err.Error(), "org unknown") || !strings.Contains(err.Error(), "status 403") {
+		t.Errorf("expected the failing organization to be reported, got %v", err)
+	}
+	if _, err := client.GetComponentLogsAcrossOrgs(context.Background(), nil, params, 2); err == nil {
+		t.Error("expected an error without organizations")
+	}
+}
+
+func TestGetComponentLogsAcrossOrgs_DefaultLimit(t *testing.T) {
+	// Each organization returns a full page of the default limit.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{}
+		for i := 0; i < 100; i++ {
+			resp.Hits = append(resp.Hits, map[string]interface{}{"_timestamp": float64(1000000 + i), "log": "line"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	params := ComponentLogsParams{Namespace: "test-ns", StartTime: time.Unix(0, 0), EndTime: time.Unix(60, 0)}
+	result, err := client.GetComponentLogsAcrossOrgs(context.Background(), []string{"team-a", "team-b", "team-c"}, params, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 100 || !result.NearLimit {
+		t.Errorf("expected the merged logs to be trimmed to the default limit of 100, got %d (near limit %v)", len(result.Logs), result.NearLimit)
+	}
+}
@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// aggregationResultLimit caps the number of rows returned by grouped (GROUP BY) queries.
+const aggregationResultLimit = 1000
+
 // quoteIdentifier wraps a SQL identifier (e.g. table/stream name) in double
 // quotes and escapes any embedded double-quote characters to prevent SQL injection.
 func quoteIdentifier(identifier string) string {
@@ -26,6 +30,44 @@ func escapeSQLString(value string) string {
 	return value
 }
 
+// likeEscaper escapes the LIKE metacharacters, using backslash as the escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likeCondition returns a LIKE condition matching column against value taken literally, with
+// the given prefix and suffix wildcards (e.g. "%"). Wildcards in value are escaped, so a
+// search for "100%" or "user_id" matches only those characters.
+func likeCondition(column, prefix, value, suffix string) string {
+	return likeOperation(column, "LIKE", prefix, value, suffix)
+}
+
+// notLikeCondition is the negation of likeCondition.
+func notLikeCondition(column, prefix, value, suffix string) string {
+	return likeOperation(column, "NOT LIKE", prefix, value, suffix)
+}
+
+// phraseCondition returns a condition matching the logs containing phrase. The phrase is
+// matched case-insensitively with ILIKE, so "error" also finds "ERROR", unless caseSensitive
+// is set.
+func phraseCondition(column, phrase string, caseSensitive bool) string {
+	if caseSensitive {
+		return likeCondition(column, "%", phrase, "%")
+	}
+	return likeOperation(column, "ILIKE", "%", phrase, "%")
+}
+
+// excludedPhraseCondition is the negation of phraseCondition.
+func excludedPhraseCondition(column, phrase string, caseSensitive bool) string {
+	if caseSensitive {
+		return notLikeCondition(column, "%", phrase, "%")
+	}
+	return likeOperation(column, "NOT ILIKE", "%", phrase, "%")
+}
+
+func likeOperation(column, operator, prefix, value, suffix string) string {
+	return column + " " + operator + " '" + prefix + escapeSQLString(likeEscaper.Replace(value)) + suffix +
+		"' ESCAPE '" + escapeSQLString(`\`) + "'"
+}
+
 // mapOperator maps the API operator string to the OpenObserve SQL operator.
 func mapOperator(op string) (string, error) {
 	switch op {
@@ -66,9 +108,10 @@ func ReverseMapOperator(op string) string {
 	}
 }
 
-// strMatchPattern matches str_match(log, 'pattern') in SQL queries.
+// strMatchPattern matches str_match(field, 'pattern') in SQL queries, whichever message field
+// the alert was created with.
 // The pattern allows embedded doubled single quotes (SQL escape: '' for ').
-var strMatchPattern = regexp.MustCompile(`str_match\s*\(\s*log\s*,\s*'((?:[^']|'')*)'\s*\)`)
+var strMatchPattern = regexp.MustCompile(`str_match\s*\(\s*[A-Za-z_][A-Za-z0-9_]*\s*,\s*'((?:[^']|'')*)'\s*\)`)
 
 // ExtractSearchPattern extracts the search pattern from a str_match SQL expression.
 // It unescapes SQL-escaped doubled single quotes ('') and doubled backslashes (\\)
@@ -126,37 +169,149 @@ func generateComponentLogsCountQuery(params ComponentLogsParams, stream string,
 		return nil, fmt.Errorf("namespace is required for component log queries")
 	}
 
-	var conditions []string
+	sql := "SELECT count(*) as total FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ")
 
-	conditions = append(conditions, "kubernetes_labels_openchoreo_dev_namespace = '"+escapeSQLString(params.Namespace)+"'")
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       0,
+		},
+	}
+	if timeout := params.queryTimeoutSeconds(); timeout > 0 {
+		query["timeout"] = timeout
+	}
 
-	if params.ProjectID != "" {
-		conditions = append(conditions, "kubernetes_labels_openchoreo_dev_project_uid = '"+escapeSQLString(params.ProjectID)+"'")
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated count query for component logs:\n")
+			fmt.Println(string(prettyJSON))
+		}
 	}
-	if params.EnvironmentID != "" {
-		conditions = append(conditions, "kubernetes_labels_openchoreo_dev_environment_uid = '"+escapeSQLString(params.EnvironmentID)+"'")
+
+	return json.Marshal(query)
+}
+
+// generateComponentLogVolumeQuery generates a query that counts matching component logs
+// grouped by component, ordered from the noisiest component to the quietest.
+func generateComponentLogVolumeQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
 	}
-	if len(params.ComponentIDs) > 0 {
-		componentConditions := make([]string, len(params.ComponentIDs))
-		for i, id := range params.ComponentIDs {
-			componentConditions[i] = "kubernetes_labels_openchoreo_dev_component_uid = '" + escapeSQLString(id) + "'"
+
+	sql := "SELECT kubernetes_labels_openchoreo_dev_component_uid AS component_uid, " +
+		"kubernetes_labels_openchoreo_dev_component AS component_name, count(*) AS total FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component" +
+		" ORDER BY total DESC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated volume query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
 		}
-		conditions = append(conditions, "("+strings.Join(componentConditions, " OR ")+")")
 	}
-	if params.SearchPhrase != "" {
-		conditions = append(conditions, "log LIKE '%"+escapeSQLString(params.SearchPhrase)+"%'")
+
+	return json.Marshal(query)
+}
+
+// fieldNamePattern matches the stream field names that may be interpolated into SQL.
+var fieldNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidFieldName reports whether name is a plain stream field name that is safe to use
+// unquoted in a query.
+func ValidFieldName(name string) bool {
+	return fieldNamePattern.MatchString(name)
+}
+
+// percentileAlias returns the result column name for a percentile, e.g. p95 for 0.95
+// and p99_9 for 0.999.
+func percentileAlias(percentile float64) string {
+	return "p" + strings.ReplaceAll(strconv.FormatFloat(percentile*100, 'f', -1, 64), ".", "_")
+}
+
+// generateComponentPercentilesQuery generates a query computing approximate percentiles of a
+// numeric log field per component, e.g. latencies embedded in structured logs.
+func generateComponentPercentilesQuery(params ComponentLogsParams, field string, percentiles []float64, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
 	}
-	if len(params.LogLevels) > 0 {
-		levelConditions := make([]string, len(params.LogLevels))
-		for i, level := range params.LogLevels {
-			levelConditions[i] = "logLevel = '" + escapeSQLString(level) + "'"
+	if !ValidFieldName(field) {
+		return nil, fmt.Errorf("invalid field name %q", field)
+	}
+	if len(percentiles) == 0 {
+		return nil, fmt.Errorf("at least one percentile is required")
+	}
+
+	columns := []string{
+		"kubernetes_labels_openchoreo_dev_component_uid AS component_uid",
+		"kubernetes_labels_openchoreo_dev_component AS component_name",
+		"count(*) AS total",
+	}
+	for _, p := range percentiles {
+		if p <= 0 || p >= 1 {
+			return nil, fmt.Errorf("invalid percentile %v: must be between 0 and 1", p)
 		}
-		conditions = append(conditions, "("+strings.Join(levelConditions, " OR ")+")")
+		columns = append(columns, fmt.Sprintf("approx_percentile_cont(%s, %s) AS %s",
+			field, strconv.FormatFloat(p, 'f', -1, 64), percentileAlias(p)))
 	}
 
-	sql := "SELECT count(*) as total FROM " + quoteIdentifier(stream)
-	if len(conditions) > 0 {
-		sql += " WHERE " + strings.Join(conditions, " AND ")
+	conditions := append(componentLogsConditions(params), field+" IS NOT NULL")
+	sql := "SELECT " + strings.Join(columns, ", ") + " FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(conditions, " AND ") +
+		" GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component" +
+		" ORDER BY component_name"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated %s percentiles query for %s component logs:\n", field, stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// generateDistinctLogMessagesQuery generates a query that collapses identical component log
+// messages, returning each unique message with its frequency and the time it was last seen,
+// most frequent first.
+func generateDistinctLogMessagesQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	sql := "SELECT log, count(*) AS total, max(" + params.timestampColumn() + ") AS last_seen FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY log ORDER BY total DESC"
+
+	limit := logsLimit(params.Limit)
+	if limit > aggregationResultLimit {
+		limit = aggregationResultLimit
 	}
 
 	query := map[string]interface{}{
@@ -165,13 +320,184 @@ func generateComponentLogsCountQuery(params ComponentLogsParams, stream string,
 			"start_time": params.StartTime.UnixMicro(),
 			"end_time":   params.EndTime.UnixMicro(),
 			"from":       0,
-			"size":       0,
+			"size":       limit,
 		},
+		"timeout": 0,
 	}
 
 	if logger.Enabled(nil, slog.LevelDebug) {
 		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
-			fmt.Printf("Generated count query for component logs:\n")
+			fmt.Printf("Generated distinct messages query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// histogramBucket returns the SQL expression of the start of the histogram bucket of the given
+// interval that a log falls in. A zero interval lets OpenObserve choose it.
+func histogramBucket(params ComponentLogsParams, interval time.Duration) (string, error) {
+	if interval <= 0 {
+		return "histogram(" + params.timestampColumn() + ")", nil
+	}
+	seconds := int64(interval / time.Second)
+	if seconds < 1 || interval%time.Second != 0 {
+		return "", fmt.Errorf("histogram interval must be a whole number of seconds, got %s", interval)
+	}
+	return fmt.Sprintf("histogram(%s, '%d second')", params.timestampColumn(), seconds), nil
+}
+
+// generateComponentLogHistogramQuery generates a query counting the matching component logs
+// per time bucket of the given interval. A zero interval lets OpenObserve pick one based on
+// the time range.
+func generateComponentLogHistogramQuery(params ComponentLogsParams, interval time.Duration, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	bucket, err := histogramBucket(params, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := "SELECT " + bucket + " AS bucket, count(*) AS total FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY bucket ORDER BY bucket"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated histogram query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// generateComponentLogBucketSamplesQuery generates a query returning the newest samples
+// matching component logs of each time bucket of the given interval, with the bucket start
+// as "bucket". The logs are numbered per bucket with a window function.
+func generateComponentLogBucketSamplesQuery(params ComponentLogsParams, interval time.Duration, samples int, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+	seconds := int64(interval / time.Second)
+	if seconds < 1 || interval%time.Second != 0 {
+		return nil, fmt.Errorf("bucket interval must be a whole number of seconds, got %s", interval)
+	}
+	if samples < 1 {
+		return nil, fmt.Errorf("samples per bucket must be positive, got %d", samples)
+	}
+
+	bucket := fmt.Sprintf("histogram(%s, '%d second')", params.timestampColumn(), seconds)
+	sql := "SELECT * FROM (SELECT *, " + bucket + " AS bucket, row_number() OVER (PARTITION BY " + bucket +
+		" ORDER BY " + params.timestampColumn() + " DESC) AS bucket_row FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") + ")" +
+		" WHERE bucket_row <= " + strconv.Itoa(samples) +
+		" ORDER BY bucket, " + params.timestampColumn() + " DESC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit * samples,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated bucket samples query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// generateComponentPodsQuery generates a query listing the pods that produced matching logs
+// in the time window, each with the time of its most recent log, newest first.
+func generateComponentPodsQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	sql := "SELECT kubernetes_pod_id AS pod_id, kubernetes_pod_name AS pod_name, count(*) AS total, max(" + params.timestampColumn() + ") AS last_seen" +
+		" FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") +
+		" GROUP BY kubernetes_pod_id, kubernetes_pod_name ORDER BY last_seen DESC"
+
+	limit := logsLimit(params.Limit)
+	if limit > aggregationResultLimit {
+		limit = aggregationResultLimit
+	}
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       limit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated pods query for %s component logs:\n", stream)
+			fmt.Println(string(prettyJSON))
+		}
+	}
+
+	return json.Marshal(query)
+}
+
+// generateComponentPodLinesQuery generates a query returning, for each pod that produced
+// matching logs in the time window, its newest log, or its oldest one if earliest is set.
+// The logs are numbered per pod with a window function.
+func generateComponentPodLinesQuery(params ComponentLogsParams, earliest bool, stream string, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	order := "DESC"
+	if earliest {
+		order = "ASC"
+	}
+	sql := "SELECT * FROM (SELECT *, row_number() OVER (PARTITION BY kubernetes_pod_id ORDER BY " + params.timestampColumn() + " " + order + ") AS pod_row" +
+		" FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(componentLogsConditions(params), " AND ") + ")" +
+		" WHERE pod_row = 1 ORDER BY " + params.timestampColumn() + " DESC"
+
+	query := map[string]interface{}{
+		"query": map[string]interface{}{
+			"sql":        sql,
+			"start_time": params.StartTime.UnixMicro(),
+			"end_time":   params.EndTime.UnixMicro(),
+			"from":       0,
+			"size":       aggregationResultLimit,
+		},
+		"timeout": 0,
+	}
+
+	if logger.Enabled(nil, slog.LevelDebug) {
+		if prettyJSON, err := json.MarshalIndent(query, "", "    "); err == nil {
+			fmt.Printf("Generated pod lines query for %s component logs:\n", stream)
 			fmt.Println(string(prettyJSON))
 		}
 	}
@@ -190,7 +516,7 @@ func generateWorkflowLogsCountQuery(params WorkflowLogsParams, stream string, lo
 		conditions = append(conditions, "kubernetes_labels_workflows_argoproj_io_workflow = '"+escapeSQLString(params.WorkflowRunName)+"'")
 	}
 	if params.SearchPhrase != "" {
-		conditions = append(conditions, "log LIKE '%"+escapeSQLString(params.SearchPhrase)+"%'")
+		conditions = append(conditions, phraseCondition("log", params.SearchPhrase, params.CaseSensitive))
 	}
 	if len(params.LogLevels) > 0 {
 		levelConditions := make([]string, len(params.LogLevels))
@@ -225,15 +551,25 @@ func generateWorkflowLogsCountQuery(params WorkflowLogsParams, stream string, lo
 	return json.Marshal(query)
 }
 
-// generateAlertConfig generates an OpenObserve alert configuration as JSON
-func generateAlertConfig(params LogAlertParams, streamName string, logger *slog.Logger) ([]byte, error) {
-	query := fmt.Sprintf(
-		"SELECT _timestamp FROM %s WHERE str_match(log, '%s') AND kubernetes_labels_openchoreo_dev_environment_uid = '%s' AND kubernetes_labels_openchoreo_dev_component_uid = '%s'",
-		quoteIdentifier(streamName),
+// alertConditions returns the SQL conditions selecting the logs counted by an alert.
+func alertConditions(params LogAlertParams) string {
+	return fmt.Sprintf(
+		"str_match(%s, '%s') AND kubernetes_labels_openchoreo_dev_environment_uid = '%s' AND kubernetes_labels_openchoreo_dev_component_uid = '%s'",
+		params.messageColumn(),
 		escapeSQLString(params.SearchPattern),
 		escapeSQLString(params.EnvironmentUID),
 		escapeSQLString(params.ComponentUID),
 	)
+}
+
+// alertQuery returns the SQL query an alert runs against streamName on every evaluation.
+func alertQuery(params LogAlertParams, streamName string) string {
+	return fmt.Sprintf("SELECT _timestamp FROM %s WHERE %s", quoteIdentifier(streamName), alertConditions(params))
+}
+
+// generateAlertConfig generates an OpenObserve alert configuration as JSON
+func generateAlertConfig(params LogAlertParams, streamName string, logger *slog.Logger) ([]byte, error) {
+	query := alertQuery(params, streamName)
 
 	sqlOperator, err := mapOperator(params.Operator)
 	if err != nil {
@@ -255,6 +591,14 @@ func generateAlertConfig(params LogAlertParams, streamName string, logger *slog.
 		return nil, fmt.Errorf("invalid alert interval: %w", err)
 	}
 
+	triggerCondition := map[string]interface{}{
+		"period":    period,
+		"frequency": frequency,
+		"threshold": params.ThresholdValue,
+		"operator":  sqlOperator,
+		"silence":   0,
+	}
+
 	alertConfig := map[string]interface{}{
 		"name":         alertName,
 		"stream_name":  streamName,
@@ -266,14 +610,8 @@ func generateAlertConfig(params LogAlertParams, streamName string, logger *slog.
 			"sql":        query,
 			"conditions": nil,
 		},
-		"trigger_condition": map[string]interface{}{
-			"period":    period,
-			"frequency": frequency,
-			"threshold": params.ThresholdValue,
-			"operator":  sqlOperator,
-			"silence":   0,
-		},
-		"destinations": []string{"openchoreo"},
+		"trigger_condition": triggerCondition,
+		"destinations":      []string{"openchoreo"},
 		"context_attributes": map[string]interface{}{
 			"namespace":      params.Namespace,
 			"projectUid":     params.ProjectUID,
@@ -281,6 +619,18 @@ func generateAlertConfig(params LogAlertParams, streamName string, logger *slog.
 			"componentUid":   params.ComponentUID,
 		},
 	}
+	if params.Schedule != nil {
+		// Mute windows are applied by evaluating the alert on a cron schedule that skips them.
+		cron, err := params.Schedule.cron(frequency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alert schedule: %w", err)
+		}
+		timeZone, tzOffset := params.Schedule.timeZone(time.Now())
+		triggerCondition["frequency_type"] = "cron"
+		triggerCondition["cron"] = cron
+		triggerCondition["timezone"] = timeZone
+		alertConfig["tz_offset"] = tzOffset
+	}
 
 	if logger.Enabled(nil, slog.LevelDebug) {
 		if prettyJSON, err := json.MarshalIndent(alertConfig, "", "    "); err == nil {
@@ -308,7 +658,7 @@ func generateWorkflowLogsQuery(params WorkflowLogsParams, stream string, logger
 
 	// Add search phrase filter
 	if params.SearchPhrase != "" {
-		conditions = append(conditions, "log LIKE '%"+escapeSQLString(params.SearchPhrase)+"%'")
+		conditions = append(conditions, phraseCondition("log", params.SearchPhrase, params.CaseSensitive))
 	}
 
 	// Add log levels filter
@@ -333,11 +683,7 @@ func generateWorkflowLogsQuery(params WorkflowLogsParams, stream string, logger
 		sql += " ORDER BY _timestamp DESC"
 	}
 
-	// Set default limit if not specified
-	limit := params.Limit
-	if limit <= 0 {
-		limit = 100
-	}
+	limit := logsLimit(params.Limit)
 
 	query := map[string]interface{}{
 		"query": map[string]interface{}{
@@ -360,25 +706,21 @@ func generateWorkflowLogsQuery(params WorkflowLogsParams, stream string, logger
 	return json.Marshal(query)
 }
 
-// generateComponentLogsQuery generates the OpenObserve query for application logs
-func generateComponentLogsQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
-	if params.Namespace == "" {
-		return nil, fmt.Errorf("namespace is required for component log queries")
-	}
-
+// componentLogsConditions builds the SQL WHERE conditions shared by the component log queries.
+func componentLogsConditions(params ComponentLogsParams) []string {
 	var conditions []string
 
 	// Add namespace filter
 	conditions = append(conditions, "kubernetes_labels_openchoreo_dev_namespace = '"+escapeSQLString(params.Namespace)+"'")
 
-	// Add project filter
-	if params.ProjectID != "" {
-		conditions = append(conditions, "kubernetes_labels_openchoreo_dev_project_uid = '"+escapeSQLString(params.ProjectID)+"'")
-	}
-
-	// Add environment filter
-	if params.EnvironmentID != "" {
-		conditions = append(conditions, "kubernetes_labels_openchoreo_dev_environment_uid = '"+escapeSQLString(params.EnvironmentID)+"'")
+	// Add project and environment filters; a correlated request may span both
+	if params.CorrelationID == "" {
+		if params.ProjectID != "" {
+			conditions = append(conditions, "kubernetes_labels_openchoreo_dev_project_uid = '"+escapeSQLString(params.ProjectID)+"'")
+		}
+		if params.EnvironmentID != "" {
+			conditions = append(conditions, "kubernetes_labels_openchoreo_dev_environment_uid = '"+escapeSQLString(params.EnvironmentID)+"'")
+		}
 	}
 
 	// Add optional component IDs filter
@@ -390,48 +732,182 @@ func generateComponentLogsQuery(params ComponentLogsParams, stream string, logge
 		conditions = append(conditions, "("+strings.Join(componentConditions, " OR ")+")")
 	}
 
+	// Add pod instance filter
+	if params.PodID != "" {
+		conditions = append(conditions, "kubernetes_pod_id = '"+escapeSQLString(params.PodID)+"'")
+	}
+
+	// Add pod label filters
+	conditions = append(conditions, labelSelectorConditions(params.LabelSelectors)...)
+
+	// Add deployment version filter
+	if params.Version != "" {
+		conditions = append(conditions, params.versionColumn()+" = '"+escapeSQLString(params.Version)+"'")
+	}
+
+	// Add request correlation filter
+	if params.CorrelationID != "" {
+		conditions = append(conditions, params.correlationColumn()+" = '"+escapeSQLString(params.CorrelationID)+"'")
+	}
+
+	// Add output stream filter
+	if params.Stream != "" {
+		conditions = append(conditions, params.streamColumn()+" = '"+escapeSQLString(params.Stream)+"'")
+	}
+
 	// Add search phrase filter
 	if params.SearchPhrase != "" {
-		conditions = append(conditions, "log LIKE '%"+escapeSQLString(params.SearchPhrase)+"%'")
+		conditions = append(conditions, phraseCondition(params.messageColumn(), params.SearchPhrase, params.CaseSensitive))
+	}
+
+	// Add excluded phrase filters
+	for _, phrase := range params.ExcludePhrases {
+		if phrase != "" {
+			conditions = append(conditions, excludedPhraseCondition(params.messageColumn(), phrase, params.CaseSensitive))
+		}
 	}
 
 	// Add log levels filter
-	if len(params.LogLevels) > 0 {
-		levelConditions := make([]string, len(params.LogLevels))
-		for i, level := range params.LogLevels {
-			levelConditions[i] = "logLevel = '" + escapeSQLString(level) + "'"
+	if condition := params.levelConditions(params.LogLevels); condition != "" {
+		conditions = append(conditions, condition)
+	}
+
+	// Add ingestion lag filter
+	if condition := params.ingestionLagCondition(); condition != "" {
+		conditions = append(conditions, condition)
+	}
+
+	// Add exact timestamp filters
+	if condition := params.atTimestampsCondition(); condition != "" {
+		conditions = append(conditions, condition)
+	}
+
+	// start_time and end_time only apply to _timestamp, so a custom timestamp field needs
+	// its own time range filter.
+	if column := params.timestampColumn(); column != DefaultTimestampField {
+		conditions = append(conditions, fmt.Sprintf("%s >= %d AND %s <= %d",
+			column, params.StartTime.UnixMicro(), column, params.EndTime.UnixMicro()))
+	}
+
+	return conditions
+}
+
+// sampled reports whether the query returns a random sample of the matching logs.
+func (p ComponentLogsParams) sampled() bool {
+	return p.Sample || p.SampleRate > 0
+}
+
+// streamColumn returns the stream field holding the container output stream.
+func (p ComponentLogsParams) streamColumn() string {
+	if p.streamField == "" {
+		return DefaultStreamField
+	}
+	return p.streamField
+}
+
+// versionColumn returns the stream field holding the deployment version.
+func (p ComponentLogsParams) versionColumn() string {
+	if p.versionField == "" {
+		return DefaultVersionField
+	}
+	return p.versionField
+}
+
+// correlationColumn returns the stream field holding the request correlation ID.
+func (p ComponentLogsParams) correlationColumn() string {
+	if p.correlationField == "" {
+		return DefaultCorrelationField
+	}
+	return p.correlationField
+}
+
+// messageColumn returns the stream field holding the log message.
+func (p ComponentLogsParams) messageColumn() string {
+	if p.messageField == "" {
+		return DefaultMessageField
+	}
+	return p.messageField
+}
+
+// messageColumn returns the stream field the alert's search pattern is matched against.
+func (p LogAlertParams) messageColumn() string {
+	if p.messageField == "" {
+		return DefaultMessageField
+	}
+	return p.messageField
+}
+
+// timestampColumn returns the stream field holding the log timestamp.
+func (p ComponentLogsParams) timestampColumn() string {
+	if p.timestampField == "" {
+		return DefaultTimestampField
+	}
+	return p.timestampField
+}
+
+// generateComponentLogsQuery generates the OpenObserve query for application logs
+func generateComponentLogsQuery(params ComponentLogsParams, stream string, logger *slog.Logger) ([]byte, error) {
+	return generateComponentLogsPageQuery(params, stream, params.Offset, logger)
+}
+
+// generateComponentLogsPageQuery generates the OpenObserve query for the page of application
+// logs starting at the given offset.
+func generateComponentLogsPageQuery(params ComponentLogsParams, stream string, from int, logger *slog.Logger) ([]byte, error) {
+	if params.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required for component log queries")
+	}
+
+	if params.SampleRate < 0 || params.SampleRate > 1 {
+		return nil, fmt.Errorf("sample rate must be between 0 and 1, got %v", params.SampleRate)
+	}
+
+	conditions := componentLogsConditions(params)
+	if params.SampleRate > 0 && params.SampleRate < 1 {
+		conditions = append(conditions, "random() < "+strconv.FormatFloat(params.SampleRate, 'f', -1, 64))
+	}
+	// A pagination cursor continues from its timestamp, skipping the logs at that timestamp
+	// already returned. The logs next to the cursor are fetched first, oldest first after it
+	// and newest first before it, so none are skipped whatever the requested sort order.
+	if c := params.cursor; c != nil {
+		if c.after {
+			conditions = append(conditions, fmt.Sprintf("%s >= %d", params.timestampColumn(), c.timestamp))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s <= %d", params.timestampColumn(), c.timestamp))
 		}
-		conditions = append(conditions, "("+strings.Join(levelConditions, " OR ")+")")
+		from += c.skip
 	}
 
 	// Build SQL
-	sql := "SELECT * FROM " + quoteIdentifier(stream)
-	if len(conditions) > 0 {
-		sql += " WHERE " + strings.Join(conditions, " AND ")
-	}
+	columns := append([]string{"*"}, computedColumns(params.Expressions)...)
+	sql := "SELECT " + strings.Join(columns, ", ") + " FROM " + quoteIdentifier(stream) +
+		" WHERE " + strings.Join(conditions, " AND ")
 
 	// Add sort order (whitelist to prevent injection since this is not inside quotes)
-	if params.SortOrder == "ASC" || params.SortOrder == "asc" {
-		sql += " ORDER BY _timestamp ASC"
+	if params.sampled() {
+		sql += " ORDER BY random()"
+	} else if c := params.cursor; c != nil {
+		if c.after {
+			sql += " ORDER BY " + params.timestampColumn() + " ASC"
+		} else {
+			sql += " ORDER BY " + params.timestampColumn() + " DESC"
+		}
+	} else if params.SortOrder == "ASC" || params.SortOrder == "asc" {
+		sql += " ORDER BY " + params.timestampColumn() + " ASC"
 	} else {
-		sql += " ORDER BY _timestamp DESC"
+		sql += " ORDER BY " + params.timestampColumn() + " DESC"
 	}
 
-	// Set default limit if not specified
-	limit := params.Limit
-	if limit <= 0 {
-		limit = 100
-	}
+	limit := logsLimit(params.Limit)
 
 	query := map[string]interface{}{
 		"query": map[string]interface{}{
 			"sql":        sql,
 			"start_time": params.StartTime.UnixMicro(),
 			"end_time":   params.EndTime.UnixMicro(),
-			"from":       0,
+			"from":       from,
 			"size":       limit,
 		},
-		"timeout": 0,
+		"timeout": params.queryTimeoutSeconds(),
 	}
 
 	if logger.Enabled(nil, slog.LevelDebug) {
@@ -464,11 +940,11 @@ func componentEventsConditions(params EventsQueryParams) []string {
 // workflowEventsConditions builds the SQL WHERE conditions for the workflow-scoped events query.
 func workflowEventsConditions(params WorkflowEventsQueryParams) []string {
 	conditions := []string{
-		evObjectName + " LIKE '" + escapeSQLString(params.WorkflowRunName) + "%'",
+		likeCondition(evObjectName, "", params.WorkflowRunName, "%"),
 		evObjectNamespace + " = 'workflows-" + escapeSQLString(params.Namespace) + "'",
 	}
 	if params.TaskName != "" {
-		conditions = append(conditions, evObjectName+" LIKE '%"+escapeSQLString(params.TaskName)+"%'")
+		conditions = append(conditions, likeCondition(evObjectName, "%", params.TaskName, "%"))
 	}
 	return conditions
 }
@@ -481,6 +957,14 @@ func eventsSortClause(sortOrder string) string {
 	return " ORDER BY " + evTimestamp + " DESC"
 }
 
+// logsLimit normalizes the requested log query limit, defaulting to 100.
+func logsLimit(limit int) int {
+	if limit <= 0 {
+		return 100
+	}
+	return limit
+}
+
 // eventsLimit normalizes the requested limit, defaulting to 100.
 func eventsLimit(limit int) int {
 	if limit <= 0 {
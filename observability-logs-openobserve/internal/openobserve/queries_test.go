@@ -5,6 +5,7 @@ package openobserve
 
 import (
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"os"
 	"strings"
@@ -49,6 +50,25 @@ func TestEscapeSQLString(t *testing.T) {
 	}
 }
 
+func TestLikeCondition(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"timeout", `log LIKE '%timeout%' ESCAPE '\\'`},
+		{"100%", `log LIKE '%100\\%%' ESCAPE '\\'`},
+		{"user_id", `log LIKE '%user\\_id%' ESCAPE '\\'`},
+		{`C:\temp`, `log LIKE '%C:\\\\temp%' ESCAPE '\\'`},
+		{"it's 50%_off", `log LIKE '%it''s 50\\%\\_off%' ESCAPE '\\'`},
+	}
+	for _, tt := range tests {
+		got := likeCondition("log", "%", tt.input, "%")
+		if got != tt.expected {
+			t.Errorf("likeCondition(%q) = %s, want %s", tt.input, got, tt.expected)
+		}
+	}
+}
+
 func TestMapOperator(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -120,6 +140,7 @@ func TestExtractSearchPattern(t *testing.T) {
 		{"basic pattern", "SELECT count(*) as match_count FROM \"default\" WHERE str_match(log, 'error')", "error"},
 		{"pattern with escaped quote", "SELECT count(*) as match_count FROM \"default\" WHERE str_match(log, 'it''s an error')", "it's an error"},
 		{"pattern with escaped backslash", "SELECT count(*) as match_count FROM \"default\" WHERE str_match(log, 'path\\\\file')", "path\\file"},
+		{"custom message field", "SELECT _timestamp FROM \"default\" WHERE str_match(message, 'error')", "error"},
 		{"no str_match", "SELECT * FROM default", ""},
 		{"empty pattern", "SELECT count(*) as match_count FROM \"default\" WHERE str_match(log, '')", ""},
 	}
@@ -260,7 +281,7 @@ func TestGenerateComponentLogsQuery(t *testing.T) {
 			"kubernetes_labels_openchoreo_dev_environment_uid = 'env-1'",
 			"kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'",
 			"kubernetes_labels_openchoreo_dev_component_uid = 'comp-2'",
-			"log LIKE '%error%'",
+			"log ILIKE '%error%'",
 			"logLevel = 'ERROR'",
 			"logLevel = 'WARN'",
 			"ORDER BY _timestamp ASC",
@@ -356,7 +377,7 @@ func TestGenerateWorkflowLogsQuery(t *testing.T) {
 		q := query["query"].(map[string]interface{})
 		sql := q["sql"].(string)
 
-		if !strings.Contains(sql, "log LIKE '%timeout%'") {
+		if !strings.Contains(sql, "log ILIKE '%timeout%'") {
 			t.Errorf("expected search phrase in SQL: %s", sql)
 		}
 		if !strings.Contains(sql, "logLevel = 'ERROR'") {
@@ -481,3 +502,579 @@ func TestGenerateAlertConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestGenerateComponentLogVolumeQuery(t *testing.T) {
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("groups by component within the scope", func(t *testing.T) {
+		params := ComponentLogsParams{
+			Namespace:     "test-ns",
+			ProjectID:     "proj-1",
+			EnvironmentID: "env-1",
+			StartTime:     startTime,
+			EndTime:       endTime,
+		}
+
+		result, err := generateComponentLogVolumeQuery(params, "mystream", testLogger())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		sql, q := sqlOf(t, result)
+		checks := []string{
+			`FROM "mystream"`,
+			"count(*) AS total",
+			"kubernetes_labels_openchoreo_dev_namespace = 'test-ns'",
+			"kubernetes_labels_openchoreo_dev_project_uid = 'proj-1'",
+			"kubernetes_labels_openchoreo_dev_environment_uid = 'env-1'",
+			"GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component",
+			"ORDER BY total DESC",
+		}
+		for _, check := range checks {
+			if !strings.Contains(sql, check) {
+				t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+			}
+		}
+		if q["start_time"].(float64) != float64(startTime.UnixMicro()) {
+			t.Errorf("unexpected start_time: %v", q["start_time"])
+		}
+		if q["size"].(float64) != aggregationResultLimit {
+			t.Errorf("expected size %d, got %v", aggregationResultLimit, q["size"])
+		}
+	})
+
+	t.Run("missing namespace returns error", func(t *testing.T) {
+		_, err := generateComponentLogVolumeQuery(ComponentLogsParams{StartTime: startTime, EndTime: endTime}, "mystream", testLogger())
+		if err == nil {
+			t.Fatal("expected error for missing namespace")
+		}
+	})
+}
+
+func TestGenerateDistinctLogMessagesQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		LogLevels: []string{"ERROR"},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateDistinctLogMessagesQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sql, q := sqlOf(t, result)
+	checks := []string{
+		`SELECT log, count(*) AS total, max(_timestamp) AS last_seen FROM "mystream"`,
+		"kubernetes_labels_openchoreo_dev_namespace = 'test-ns'",
+		"GROUP BY log ORDER BY total DESC",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if q["size"].(float64) != 100 {
+		t.Errorf("expected default size 100, got %v", q["size"])
+	}
+
+	params.Limit = 5000
+	result, err = generateDistinctLogMessagesQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, q := sqlOf(t, result); q["size"].(float64) != aggregationResultLimit {
+		t.Errorf("expected size capped at %d, got %v", aggregationResultLimit, q["size"])
+	}
+
+	if _, err := generateDistinctLogMessagesQuery(ComponentLogsParams{}, "mystream", testLogger()); err == nil {
+		t.Error("expected error for missing namespace")
+	}
+}
+
+func TestGenerateComponentPercentilesQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:     "test-ns",
+		EnvironmentID: "env-1",
+		StartTime:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentPercentilesQuery(params, "latency_ms", []float64{0.5, 0.95, 0.999}, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sql, q := sqlOf(t, result)
+	checks := []string{
+		"approx_percentile_cont(latency_ms, 0.5) AS p50",
+		"approx_percentile_cont(latency_ms, 0.95) AS p95",
+		"approx_percentile_cont(latency_ms, 0.999) AS p99_9",
+		`FROM "mystream"`,
+		"kubernetes_labels_openchoreo_dev_environment_uid = 'env-1'",
+		"latency_ms IS NOT NULL",
+		"GROUP BY kubernetes_labels_openchoreo_dev_component_uid, kubernetes_labels_openchoreo_dev_component",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if q["size"].(float64) != aggregationResultLimit {
+		t.Errorf("expected size %d, got %v", aggregationResultLimit, q["size"])
+	}
+}
+
+func TestGenerateComponentPercentilesQuery_Invalid(t *testing.T) {
+	params := ComponentLogsParams{Namespace: "test-ns"}
+	tests := []struct {
+		name        string
+		params      ComponentLogsParams
+		field       string
+		percentiles []float64
+	}{
+		{"missing namespace", ComponentLogsParams{}, "latency_ms", []float64{0.5}},
+		{"injected field", params, "latency_ms) FROM x --", []float64{0.5}},
+		{"no percentiles", params, "latency_ms", nil},
+		{"percentile out of range", params, "latency_ms", []float64{95}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := generateComponentPercentilesQuery(tt.params, tt.field, tt.percentiles, "mystream", testLogger()); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestGenerateComponentLogsQuery_PodID(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		PodID:     "5f1c0b3e-9a2d-4c1e-8f00-0123456789ab",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	if !strings.Contains(sql, "kubernetes_pod_id = '5f1c0b3e-9a2d-4c1e-8f00-0123456789ab'") {
+		t.Errorf("expected pod instance filter, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_ExcludePhrases(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:      "test-ns",
+		SearchPhrase:   "error",
+		ExcludePhrases: []string{"connection reset", "", "100%_done'"},
+		StartTime:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	want := `log ILIKE '%error%' ESCAPE '\\' AND log NOT ILIKE '%connection reset%' ESCAPE '\\' AND log NOT ILIKE '%100\\%\\_done''%' ESCAPE '\\'`
+	if !strings.Contains(sql, want) {
+		t.Errorf("expected the excluded phrases after the search phrase, got: %s", sql)
+	}
+	if strings.Count(sql, "NOT ILIKE") != 2 {
+		t.Errorf("expected empty phrases to be ignored, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_CaseSensitive(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:      "test-ns",
+		SearchPhrase:   "Error",
+		ExcludePhrases: []string{"Retrying"},
+		CaseSensitive:  true,
+		StartTime:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	want := `log LIKE '%Error%' ESCAPE '\\' AND log NOT LIKE '%Retrying%' ESCAPE '\\'`
+	if !strings.Contains(sql, want) || strings.Contains(sql, "ILIKE") {
+		t.Errorf("expected exact-case matching, got: %s", sql)
+	}
+
+	workflow, err := generateWorkflowLogsQuery(WorkflowLogsParams{Namespace: "test-ns", SearchPhrase: "Error", CaseSensitive: true}, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, workflow); !strings.Contains(sql, "log LIKE '%Error%'") {
+		t.Errorf("expected exact-case matching of workflow logs, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_MessageField(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:      "test-ns",
+		SearchPhrase:   "error",
+		ExcludePhrases: []string{"retrying"},
+		StartTime:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		messageField:   "message",
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	if !strings.Contains(sql, "message ILIKE '%error%'") || !strings.Contains(sql, "message NOT ILIKE '%retrying%'") {
+		t.Errorf("expected the phrases to match the message field, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_Version(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		PodID:     "pod-uid",
+		Version:   "canary",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	if !strings.Contains(sql, "kubernetes_pod_id = 'pod-uid' AND kubernetes_labels_version = 'canary'") {
+		t.Errorf("expected pod and version filters, got: %s", sql)
+	}
+
+	params.versionField = "kubernetes_labels_app_version"
+	result, err = generateComponentLogsCountQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "kubernetes_labels_app_version = 'canary'") {
+		t.Errorf("expected a filter on the configured field, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentPodsQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:    "test-ns",
+		ComponentIDs: []string{"comp-1"},
+		StartTime:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentPodsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	sql, q := sqlOf(t, result)
+	checks := []string{
+		"SELECT kubernetes_pod_id AS pod_id, kubernetes_pod_name AS pod_name, count(*) AS total, max(_timestamp) AS last_seen",
+		"kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'",
+		"GROUP BY kubernetes_pod_id, kubernetes_pod_name ORDER BY last_seen DESC",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if q["size"].(float64) != 100 {
+		t.Errorf("expected default size 100, got %v", q["size"])
+	}
+
+	if _, err := generateComponentPodsQuery(ComponentLogsParams{}, "mystream", testLogger()); err == nil {
+		t.Error("expected error for missing namespace")
+	}
+}
+
+func TestGenerateComponentPodLinesQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:    "test-ns",
+		ComponentIDs: []string{"comp-1"},
+		StartTime:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentPodLinesQuery(params, false, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	checks := []string{
+		"row_number() OVER (PARTITION BY kubernetes_pod_id ORDER BY _timestamp DESC) AS pod_row",
+		"kubernetes_labels_openchoreo_dev_component_uid = 'comp-1'",
+		"WHERE pod_row = 1 ORDER BY _timestamp DESC",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+
+	result, err = generateComponentPodLinesQuery(params, true, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "ORDER BY _timestamp ASC) AS pod_row") {
+		t.Errorf("expected the oldest log per pod, got: %s", sql)
+	}
+
+	if _, err := generateComponentPodLinesQuery(ComponentLogsParams{}, false, "mystream", testLogger()); err == nil {
+		t.Error("expected error for missing namespace")
+	}
+}
+
+func TestGenerateComponentLogHistogramQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		LogLevels: []string{"ERROR"},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogHistogramQuery(params, 5*time.Minute, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	checks := []string{
+		`SELECT histogram(_timestamp, '300 second') AS bucket, count(*) AS total FROM "mystream"`,
+		"logLevel = 'ERROR'",
+		"GROUP BY bucket ORDER BY bucket",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+
+	result, err = generateComponentLogHistogramQuery(params, 0, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "SELECT histogram(_timestamp) AS bucket") {
+		t.Errorf("expected an automatic interval, got: %s", sql)
+	}
+
+	if _, err := generateComponentLogHistogramQuery(params, 1500*time.Millisecond, "mystream", testLogger()); err == nil {
+		t.Error("expected error for a fractional interval")
+	}
+	if _, err := generateComponentLogHistogramQuery(ComponentLogsParams{}, time.Minute, "mystream", testLogger()); err == nil {
+		t.Error("expected error for missing namespace")
+	}
+}
+
+func TestGenerateComponentLogsQuery_CustomTimestampField(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	params := ComponentLogsParams{
+		Namespace:      "test-ns",
+		StartTime:      start,
+		EndTime:        end,
+		SortOrder:      "asc",
+		timestampField: "event_time",
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	checks := []string{
+		fmt.Sprintf("event_time >= %d AND event_time <= %d", start.UnixMicro(), end.UnixMicro()),
+		"ORDER BY event_time ASC",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if strings.Contains(sql, "_timestamp") {
+		t.Errorf("expected no reference to _timestamp, got: %s", sql)
+	}
+
+	result, err = generateComponentPodsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "max(event_time) AS last_seen") {
+		t.Errorf("expected last_seen from event_time, got: %s", sql)
+	}
+
+	// The default field relies on start_time/end_time alone.
+	params.timestampField = ""
+	result, err = generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); strings.Contains(sql, "_timestamp >=") || !strings.Contains(sql, "ORDER BY _timestamp ASC") {
+		t.Errorf("unexpected SQL for the default timestamp field: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_LiteralWildcards(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:    "test-ns",
+		SearchPhrase: "disk 95%_full",
+		StartTime:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	for name, generate := range map[string]func(ComponentLogsParams, string, *slog.Logger) ([]byte, error){
+		"logs":  generateComponentLogsQuery,
+		"count": generateComponentLogsCountQuery,
+	} {
+		result, err := generate(params, "mystream", testLogger())
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		sql, _ := sqlOf(t, result)
+		want := `log ILIKE '%disk 95\\%\\_full%' ESCAPE '\\'`
+		if !strings.Contains(sql, want) {
+			t.Errorf("%s: expected SQL to contain %s, got: %s", name, want, sql)
+		}
+	}
+}
+
+func TestGenerateComponentLogsQuery_Sampling(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:  "test-ns",
+		SampleRate: 0.01,
+		StartTime:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:    time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, _ := sqlOf(t, result)
+	if !strings.Contains(sql, "AND random() < 0.01 ORDER BY random()") {
+		t.Errorf("expected a sampled query, got: %s", sql)
+	}
+
+	// The count query reports the unsampled total.
+	result, err = generateComponentLogsCountQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); strings.Contains(sql, "random()") {
+		t.Errorf("expected an unsampled count query, got: %s", sql)
+	}
+
+	params.SampleRate = 0
+	params.Sample = true
+	result, err = generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); strings.Contains(sql, "random() <") || !strings.HasSuffix(sql, "ORDER BY random()") {
+		t.Errorf("expected random ordering without a rate filter, got: %s", sql)
+	}
+
+	params.SampleRate = 2
+	if _, err := generateComponentLogsQuery(params, "mystream", testLogger()); err == nil {
+		t.Error("expected error for a sample rate above 1")
+	}
+}
+
+func TestGenerateComponentLogsQuery_StreamFilter(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		Stream:    "stderr",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "stream = 'stderr'") {
+		t.Errorf("expected a stream filter, got: %s", sql)
+	}
+
+	params.streamField = "log_stream"
+	result, err = generateComponentLogsCountQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "log_stream = 'stderr'") {
+		t.Errorf("expected a filter on the configured field, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogsQuery_IngestionLag(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace:       "test-ns",
+		MinIngestionLag: "30s",
+		StartTime:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:         time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogsQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); strings.Contains(sql, "_timestamp -") {
+		t.Errorf("expected no lag filter without an event time field, got: %s", sql)
+	}
+
+	params.eventTimeField = "event_time"
+	result, err = generateComponentLogsCountQuery(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sql, _ := sqlOf(t, result); !strings.Contains(sql, "_timestamp - event_time > 30000000") {
+		t.Errorf("expected a lag filter in microseconds, got: %s", sql)
+	}
+}
+
+func TestGenerateComponentLogBucketSamplesQuery(t *testing.T) {
+	params := ComponentLogsParams{
+		Namespace: "test-ns",
+		LogLevels: []string{"ERROR"},
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := generateComponentLogBucketSamplesQuery(params, time.Hour, 3, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sql, query := sqlOf(t, result)
+	checks := []string{
+		"SELECT *, histogram(_timestamp, '3600 second') AS bucket",
+		"row_number() OVER (PARTITION BY histogram(_timestamp, '3600 second') ORDER BY _timestamp DESC) AS bucket_row",
+		`FROM "mystream"`,
+		"logLevel = 'ERROR'",
+		"WHERE bucket_row <= 3 ORDER BY bucket, _timestamp DESC",
+	}
+	for _, check := range checks {
+		if !strings.Contains(sql, check) {
+			t.Errorf("expected SQL to contain %q, got: %s", check, sql)
+		}
+	}
+	if size, _ := query["size"].(float64); size != 3*aggregationResultLimit {
+		t.Errorf("expected room for every sample, got size %v", size)
+	}
+
+	if _, err := generateComponentLogBucketSamplesQuery(params, 0, 3, "mystream", testLogger()); err == nil {
+		t.Error("expected error for a missing interval")
+	}
+	if _, err := generateComponentLogBucketSamplesQuery(params, time.Hour, 0, "mystream", testLogger()); err == nil {
+		t.Error("expected error for no samples")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// maxRetryBackoff caps the wait between two attempts of a request.
+const maxRetryBackoff = 30 * time.Second
+
+// RetryPolicy is the retry budget of a class of OpenObserve requests. Requests that fail
+// without a response, or with a 429, 502, 503 or 504 status, are retried up to Retries times,
+// waiting Backoff before the first retry and twice as long before each further one. The zero
+// value does not retry.
+type RetryPolicy struct {
+	Retries int
+	Backoff time.Duration
+}
+
+// retryableStatus reports whether a response status signals a transient OpenObserve problem.
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// doWithRetry sends req, retrying it according to policy. The request body is replayed from
+// req.GetBody, which http.NewRequest sets for in-memory bodies. It stops early when the
+// request's context is done, returning the last response or error. A retried POST may be
+// applied twice if OpenObserve processed it before failing, e.g. creating an alert that then
+// already exists.
+func (c *Client) doWithRetry(req *http.Request, policy RetryPolicy) (*http.Response, error) {
+	backoff := policy.Backoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to replay request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := c.httpClient.Do(req)
+		retryable := (err != nil && req.Context().Err() == nil) || (err == nil && retryableStatus(resp.StatusCode))
+		if !retryable || attempt >= policy.Retries || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		reason := "connection error"
+		if err == nil {
+			reason = resp.Status
+			// Drain the body so that the connection can be reused by the retry.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		c.logger.Warn("OpenObserve request failed, retrying",
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+			slog.String("reason", reason),
+			slog.Int("attempt", attempt+1),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("gave up retrying: %w", errors.Join(req.Context().Err(), err))
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateAlert_Retry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"name":"test-alert"`) {
+			t.Errorf("expected the request body on every attempt, got %s", body)
+		}
+		if calls.Add(1) < 3 {
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "alert-123"})
+	}))
+	defer server.Close()
+
+	name, enabled := "test-alert", true
+	params := LogAlertParams{Name: &name, Operator: "gt", ThresholdValue: 5, Window: "5m", Interval: "1m", Enabled: &enabled}
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{
+		AlertRetry: RetryPolicy{Retries: 3, Backoff: time.Millisecond},
+	}, testLogger())
+	alertID, err := client.CreateAlert(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if alertID != "alert-123" || calls.Load() != 3 {
+		t.Errorf("expected success on the third attempt, got %q after %d attempts", alertID, calls.Load())
+	}
+
+	// Queries have their own budget, which is not spent on alert operations and vice versa.
+	calls.Store(0)
+	client = NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{
+		QueryRetry: RetryPolicy{Retries: 5, Backoff: time.Millisecond},
+		AlertRetry: RetryPolicy{Retries: 1, Backoff: time.Millisecond},
+	}, testLogger())
+	if _, err := client.CreateAlert(context.Background(), params); err == nil || calls.Load() != 2 {
+		t.Errorf("expected failure after 2 attempts, got %v after %d attempts", err, calls.Load())
+	}
+}
+
+func TestDoWithRetry_NotRetryable(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "bad query", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{
+		QueryRetry: RetryPolicy{Retries: 3, Backoff: time.Millisecond},
+	}, testLogger())
+	if _, err := client.executeSearchQuery(context.Background(), []byte(`{}`)); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected a client error not to be retried, got %d attempts", calls.Load())
+	}
+}
+
+func TestDoWithRetry_ContextCanceled(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{
+		AlertRetry: RetryPolicy{Retries: 10, Backoff: time.Hour},
+	}, testLogger())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := client.DeleteAlert(ctx, "test-alert"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if time.Since(start) > 5*time.Second || calls.Load() != 1 {
+		t.Errorf("expected the retry to stop when the context is done, got %d attempts in %v", calls.Load(), time.Since(start))
+	}
+}
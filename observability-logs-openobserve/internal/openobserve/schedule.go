@@ -0,0 +1,138 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AlertSchedule mutes an alert during recurring daily windows, e.g. while nightly batch jobs
+// run. OpenObserve has no mute windows of its own; its silence only delays repeated
+// notifications after an alert fired. The schedule is therefore translated into a cron
+// trigger that evaluates the alert only outside the mute windows, which requires windows
+// starting and ending on the hour and an evaluation interval that divides an hour.
+type AlertSchedule struct {
+	MuteWindows []MuteWindow `json:"muteWindows"`
+	// TimeZone is the IANA time zone of the mute windows. Empty selects UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// MuteWindow is a daily window, from Start to End in "HH:MM" form, during which an alert is
+// not evaluated. A window ending before it starts wraps past midnight, e.g. 22:00 to 02:00.
+type MuteWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// parseWindowHour parses a mute window boundary, which must fall on the hour.
+func parseWindowHour(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("must be a time of day such as 22:00, got %q", value)
+	}
+	if t.Minute() != 0 {
+		return 0, fmt.Errorf("must fall on the hour, got %q", value)
+	}
+	return t.Hour(), nil
+}
+
+// activeHours returns, for each hour of the day, whether the alert is evaluated in it.
+func (s AlertSchedule) activeHours() ([24]bool, error) {
+	var active [24]bool
+	for h := range active {
+		active[h] = true
+	}
+	for i, w := range s.MuteWindows {
+		start, err := parseWindowHour(w.Start)
+		if err != nil {
+			return active, fmt.Errorf("schedule.muteWindows[%d].start %w", i, err)
+		}
+		end, err := parseWindowHour(w.End)
+		if err != nil {
+			return active, fmt.Errorf("schedule.muteWindows[%d].end %w", i, err)
+		}
+		if start == end {
+			return active, fmt.Errorf("schedule.muteWindows[%d] must not be empty or cover the whole day", i)
+		}
+		for h := start; h != end; h = (h + 1) % 24 {
+			active[h] = false
+		}
+	}
+	return active, nil
+}
+
+// validate checks the schedule of an alert evaluated every intervalMinutes. The error names
+// the offending request field.
+func (s AlertSchedule) validate(intervalMinutes int) error {
+	if len(s.MuteWindows) == 0 {
+		return fmt.Errorf("schedule.muteWindows must not be empty")
+	}
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("schedule.timeZone must be an IANA time zone such as Europe/Berlin, got %q", s.TimeZone)
+	}
+	if intervalMinutes > 60 || 60%intervalMinutes != 0 {
+		return fmt.Errorf("condition.interval must divide an hour (e.g. 5m, 15m, 1h) when mute windows are set, got %d minutes", intervalMinutes)
+	}
+	active, err := s.activeHours()
+	if err != nil {
+		return err
+	}
+	for _, a := range active {
+		if a {
+			return nil
+		}
+	}
+	return fmt.Errorf("schedule.muteWindows must leave the alert active for at least an hour a day")
+}
+
+// cron returns the OpenObserve cron expression (with a seconds field) that evaluates an
+// alert every intervalMinutes outside the mute windows. It assumes validate has succeeded.
+func (s AlertSchedule) cron(intervalMinutes int) (string, error) {
+	active, err := s.activeHours()
+	if err != nil {
+		return "", err
+	}
+
+	minutes := "0"
+	if intervalMinutes < 60 {
+		minutes = "*/" + strconv.Itoa(intervalMinutes)
+	}
+
+	// Collapse the active hours into ranges, e.g. 0-1,4-23.
+	var ranges []string
+	for h := 0; h < 24; h++ {
+		if !active[h] {
+			continue
+		}
+		end := h
+		for end+1 < 24 && active[end+1] {
+			end++
+		}
+		if end == h {
+			ranges = append(ranges, strconv.Itoa(h))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", h, end))
+		}
+		h = end
+	}
+	return fmt.Sprintf("0 %s %s * * *", minutes, strings.Join(ranges, ",")), nil
+}
+
+// timeZone returns the name of the schedule's time zone and its current offset from UTC in
+// minutes, as OpenObserve expects both.
+func (s AlertSchedule) timeZone(now time.Time) (string, int) {
+	name := s.TimeZone
+	if name == "" {
+		name = "UTC"
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return name, 0
+	}
+	_, offset := now.In(loc).Zone()
+	return name, offset / 60
+}
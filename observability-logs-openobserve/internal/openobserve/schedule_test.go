@@ -0,0 +1,126 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAlertSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule AlertSchedule
+		interval int
+		wantErr  string
+	}{
+		{"nightly window", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "04:00"}}}, 5, ""},
+		{"time zone", AlertSchedule{MuteWindows: []MuteWindow{{"22:00", "02:00"}}, TimeZone: "Europe/Berlin"}, 60, ""},
+		{"no windows", AlertSchedule{}, 5, "schedule.muteWindows must not be empty"},
+		{"unknown time zone", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "04:00"}}, TimeZone: "Mars/Base"}, 5, "schedule.timeZone"},
+		{"malformed time", AlertSchedule{MuteWindows: []MuteWindow{{"1am", "04:00"}}}, 5, "schedule.muteWindows[0].start"},
+		{"not on the hour", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "04:30"}}}, 5, "schedule.muteWindows[0].end must fall on the hour"},
+		{"empty window", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "01:00"}}}, 5, "schedule.muteWindows[0] must not be empty"},
+		{"whole day", AlertSchedule{MuteWindows: []MuteWindow{{"00:00", "12:00"}, {"12:00", "00:00"}}}, 5, "at least an hour a day"},
+		{"interval not dividing an hour", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "04:00"}}}, 7, "condition.interval must divide an hour"},
+		{"interval over an hour", AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "04:00"}}}, 120, "condition.interval must divide an hour"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.validate(tt.interval)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAlertSchedule_Cron(t *testing.T) {
+	tests := []struct {
+		windows  []MuteWindow
+		interval int
+		want     string
+	}{
+		{[]MuteWindow{{"02:00", "04:00"}}, 5, "0 */5 0-1,4-23 * * *"},
+		{[]MuteWindow{{"22:00", "02:00"}}, 60, "0 0 2-21 * * *"},
+		{[]MuteWindow{{"00:00", "23:00"}}, 15, "0 */15 23 * * *"},
+		{[]MuteWindow{{"01:00", "03:00"}, {"02:00", "05:00"}, {"12:00", "13:00"}}, 1, "0 */1 0,5-11,13-23 * * *"},
+	}
+	for _, tt := range tests {
+		got, err := AlertSchedule{MuteWindows: tt.windows}.cron(tt.interval)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("cron(%v, %d) = %q, want %q", tt.windows, tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestAlertSchedule_TimeZone(t *testing.T) {
+	summer := time.Date(2025, 7, 1, 12, 0, 0, 0, time.UTC)
+	if name, offset := (AlertSchedule{TimeZone: "Europe/Berlin"}).timeZone(summer); name != "Europe/Berlin" || offset != 120 {
+		t.Errorf("unexpected time zone %q with offset %d", name, offset)
+	}
+	if name, offset := (AlertSchedule{}).timeZone(summer); name != "UTC" || offset != 0 {
+		t.Errorf("expected UTC by default, got %q with offset %d", name, offset)
+	}
+}
+
+func TestGenerateAlertConfig_Schedule(t *testing.T) {
+	enabled := true
+	name := "nightly"
+	params := LogAlertParams{
+		Name:           &name,
+		Namespace:      "ns-1",
+		EnvironmentUID: "env-uid",
+		ComponentUID:   "comp-uid",
+		SearchPattern:  "error",
+		Operator:       "gt",
+		ThresholdValue: 5,
+		Window:         "5m",
+		Interval:       "5m",
+		Enabled:        &enabled,
+		Schedule:       &AlertSchedule{MuteWindows: []MuteWindow{{"01:00", "03:00"}}},
+	}
+	if err := params.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	result, err := generateAlertConfig(params, "mystream", testLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var config map[string]interface{}
+	if err := json.Unmarshal(result, &config); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	tc := config["trigger_condition"].(map[string]interface{})
+	if tc["frequency_type"] != "cron" || tc["cron"] != "0 */5 0,3-23 * * *" || tc["timezone"] != "UTC" {
+		t.Errorf("unexpected trigger condition: %v", tc)
+	}
+	if config["tz_offset"] != float64(0) {
+		t.Errorf("expected tz_offset 0, got %v", config["tz_offset"])
+	}
+
+	params.Interval = "7m"
+	if err := params.Validate(); err == nil || !strings.Contains(err.Error(), "condition.interval") {
+		t.Errorf("expected the interval to be rejected with mute windows, got %v", err)
+	}
+
+	params.Interval = "5m"
+	params.Schedule = nil
+	result, _ = generateAlertConfig(params, "mystream", testLogger())
+	if strings.Contains(string(result), "cron") || strings.Contains(string(result), "tz_offset") {
+		t.Errorf("expected no cron schedule without mute windows: %s", result)
+	}
+}
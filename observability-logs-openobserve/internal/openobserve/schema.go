@@ -0,0 +1,177 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSchemaCacheTTL is how long the schema of a stream is cached when
+// ClientOptions.SchemaCacheTTL is not set.
+const DefaultSchemaCacheTTL = time.Minute
+
+// The types of StreamSchemaField, coarse enough for a query builder to pick a filter control.
+const (
+	FieldTypeString    = "string"
+	FieldTypeNumber    = "number"
+	FieldTypeBoolean   = "boolean"
+	FieldTypeTimestamp = "timestamp"
+	// FieldTypeOther is the type of the fields whose data type is none of the above, e.g.
+	// binary or nested fields.
+	FieldTypeOther = "other"
+)
+
+// StreamSchemaField is a field of a stream with its type.
+type StreamSchemaField struct {
+	Name string `json:"name"`
+	// Type is one of the FieldType constants.
+	Type string `json:"type"`
+	// DataType is the data type OpenObserve stores the field as, e.g. Utf8 or Int64.
+	DataType string `json:"dataType"`
+}
+
+// StreamSchema lists the fields of a stream, ordered by name.
+type StreamSchema struct {
+	Stream string              `json:"stream"`
+	Fields []StreamSchemaField `json:"fields"`
+}
+
+// schemaCache keeps the schemas fetched from OpenObserve for a TTL, keyed by organization
+// and stream. A schema only changes when logs with new fields are ingested, so serving it
+// slightly stale is preferable to fetching it for every query builder that opens.
+type schemaCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]schemaCacheEntry
+}
+
+type schemaCacheEntry struct {
+	schema  *StreamSchema
+	expires time.Time
+}
+
+func newSchemaCache(ttl time.Duration) *schemaCache {
+	if ttl <= 0 {
+		ttl = DefaultSchemaCacheTTL
+	}
+	return &schemaCache{ttl: ttl, now: time.Now, entries: make(map[string]schemaCacheEntry)}
+}
+
+func (c *schemaCache) get(key string) (*StreamSchema, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.schema, true
+}
+
+func (c *schemaCache) put(key string, schema *StreamSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = schemaCacheEntry{schema: schema, expires: c.now().Add(c.ttl)}
+}
+
+// GetStreamSchema returns the fields of the stream referenced by ref, as accepted by
+// ComponentLogsParams.LogStream, with their types. Empty ref selects the configured stream.
+// Schemas are cached for ClientOptions.SchemaCacheTTL.
+func (c *Client) GetStreamSchema(ctx context.Context, ref string) (*StreamSchema, error) {
+	stream, err := c.logStream(ComponentLogsParams{LogStream: ref})
+	if err != nil {
+		return nil, err
+	}
+	key := c.org + "/" + stream
+	if schema, ok := c.schemas.get(key); ok {
+		return schema, nil
+	}
+
+	schema, err := c.fetchStreamSchema(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+	c.schemas.put(key, schema)
+	return schema, nil
+}
+
+// fetchStreamSchema fetches the schema of stream from OpenObserve's stream schema API.
+func (c *Client) fetchStreamSchema(ctx context.Context, stream string) (*StreamSchema, error) {
+	url := fmt.Sprintf("%s/api/%s/streams/%s/schema?type=logs", c.baseURL, c.org, url.PathEscape(stream))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(c.user, c.token)
+
+	resp, err := c.doWithRetry(req, c.queryRetry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openobserve returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		Schema []struct {
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"schema"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	schema := &StreamSchema{Stream: stream, Fields: make([]StreamSchemaField, 0, len(result.Schema))}
+	for _, field := range result.Schema {
+		schema.Fields = append(schema.Fields, StreamSchemaField{
+			Name:     field.Name,
+			Type:     c.schemaFieldType(field.Name, field.Type),
+			DataType: field.Type,
+		})
+	}
+	sort.Slice(schema.Fields, func(i, j int) bool { return schema.Fields[i].Name < schema.Fields[j].Name })
+	return schema, nil
+}
+
+// schemaFieldType maps an OpenObserve data type to the type of a StreamSchemaField. The
+// timestamp fields are stored as integers of microseconds, so they are recognized by name.
+func (c *Client) schemaFieldType(name, dataType string) string {
+	if name == c.timestampField || (c.eventTimeField != "" && name == c.eventTimeField) {
+		return FieldTypeTimestamp
+	}
+	dataType = strings.ToLower(dataType)
+	switch {
+	case strings.Contains(dataType, "utf8"):
+		return FieldTypeString
+	case strings.HasPrefix(dataType, "int"), strings.HasPrefix(dataType, "uint"),
+		strings.HasPrefix(dataType, "float"), strings.HasPrefix(dataType, "decimal"):
+		return FieldTypeNumber
+	case dataType == "boolean":
+		return FieldTypeBoolean
+	case strings.HasPrefix(dataType, "timestamp"), strings.HasPrefix(dataType, "date"):
+		return FieldTypeTimestamp
+	default:
+		return FieldTypeOther
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testSchemaResponse = `{"name":"default","stream_type":"logs","schema":[
+	{"name":"log","type":"Utf8"},
+	{"name":"_timestamp","type":"Int64"},
+	{"name":"latency_ms","type":"Float64"},
+	{"name":"retried","type":"Boolean"},
+	{"name":"payload","type":"Binary"}
+]}`
+
+func TestGetStreamSchema(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path != "/api/default/streams/default/schema" || r.URL.Query().Get("type") != "logs" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testSchemaResponse))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	schema, err := client.GetStreamSchema(context.Background(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []StreamSchemaField{
+		{Name: "_timestamp", Type: FieldTypeTimestamp, DataType: "Int64"},
+		{Name: "latency_ms", Type: FieldTypeNumber, DataType: "Float64"},
+		{Name: "log", Type: FieldTypeString, DataType: "Utf8"},
+		{Name: "payload", Type: FieldTypeOther, DataType: "Binary"},
+		{Name: "retried", Type: FieldTypeBoolean, DataType: "Boolean"},
+	}
+	if schema.Stream != "default" || len(schema.Fields) != len(want) {
+		t.Fatalf("unexpected schema: %+v", schema)
+	}
+	for i, field := range schema.Fields {
+		if field != want[i] {
+			t.Errorf("field %d: expected %+v, got %+v", i, want[i], field)
+		}
+	}
+
+	if _, err := client.GetStreamSchema(context.Background(), ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected the schema to be cached, got %d requests", n)
+	}
+}
+
+func TestGetStreamSchema_CacheExpires(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(testSchemaResponse))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{SchemaCacheTTL: 30 * time.Second}, testLogger())
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client.schemas.now = func() time.Time { return now }
+
+	for _, advance := range []time.Duration{0, 20 * time.Second, 20 * time.Second} {
+		now = now.Add(advance)
+		if _, err := client.GetStreamSchema(context.Background(), ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected the schema to be fetched again after the TTL, got %d requests", n)
+	}
+}
+
+func TestGetStreamSchema_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"stream not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	var streamErr *LogStreamError
+	if _, err := client.GetStreamSchema(context.Background(), "audit"); !errors.As(err, &streamErr) {
+		t.Errorf("expected a LogStreamError for a stream that is not allowed, got %v", err)
+	}
+	if _, err := client.GetStreamSchema(context.Background(), ""); err == nil {
+		t.Error("expected an error for a failed request, got nil")
+	}
+}
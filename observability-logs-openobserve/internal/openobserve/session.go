@@ -0,0 +1,200 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before its expiry a session token is refreshed, so that a
+// token is not sent just as it expires.
+const tokenRefreshMargin = 30 * time.Second
+
+// SessionToken is a session token used to authenticate requests to OpenObserve.
+type SessionToken struct {
+	Value string
+	// ExpiresAt is when the token expires. Zero means it is used until OpenObserve rejects it.
+	ExpiresAt time.Time
+}
+
+// TokenSource obtains a new session token, e.g. by logging in to OpenObserve.
+type TokenSource func(ctx context.Context) (SessionToken, error)
+
+// PasswordLogin returns a TokenSource that logs in to the OAuth2 token endpoint tokenURL, such
+// as that of the identity provider in front of OpenObserve, with the resource owner password
+// grant (RFC 6749, section 4.3). clientID is sent when not empty. The token expires after the
+// expires_in of the response, if any.
+func PasswordLogin(tokenURL, clientID, user, password string) TokenSource {
+	return func(ctx context.Context) (SessionToken, error) {
+		form := url.Values{
+			"grant_type": {"password"},
+			"username":   {user},
+			"password":   {password},
+		}
+		if clientID != "" {
+			form.Set("client_id", clientID)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return SessionToken{}, fmt.Errorf("failed to create login request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return SessionToken{}, fmt.Errorf("failed to log in: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return SessionToken{}, fmt.Errorf("login failed with status %d", resp.StatusCode)
+		}
+
+		var body struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return SessionToken{}, fmt.Errorf("failed to decode login response: %w", err)
+		}
+		token := SessionToken{Value: body.AccessToken}
+		if body.ExpiresIn > 0 {
+			token.ExpiresAt = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+		}
+		return token, nil
+	}
+}
+
+// tokenRefresh is a call to the token source on behalf of every request waiting for a token.
+type tokenRefresh struct {
+	done  chan struct{}
+	token SessionToken
+	err   error
+}
+
+// tokenCache caches the session token of a TokenSource and refreshes it lazily: the first
+// request to find the token missing or about to expire calls the source, and requests
+// arriving meanwhile wait for that call instead of refreshing the token themselves.
+type tokenCache struct {
+	source TokenSource
+	now    func() time.Time
+
+	mu      sync.Mutex
+	token   SessionToken
+	refresh *tokenRefresh
+}
+
+func newTokenCache(source TokenSource) *tokenCache {
+	return &tokenCache{source: source, now: time.Now}
+}
+
+// get returns a valid session token, refreshing it if needed.
+func (c *tokenCache) get(ctx context.Context) (SessionToken, error) {
+	c.mu.Lock()
+	if c.valid(c.token) {
+		token := c.token
+		c.mu.Unlock()
+		return token, nil
+	}
+	r := c.refresh
+	if r == nil {
+		r = &tokenRefresh{done: make(chan struct{})}
+		c.refresh = r
+		// The refresh runs detached from the caller's context so that a caller giving up
+		// does not fail the refresh for the others waiting for it.
+		go c.run(context.WithoutCancel(ctx), r)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return r.token, r.err
+	case <-ctx.Done():
+		return SessionToken{}, ctx.Err()
+	}
+}
+
+func (c *tokenCache) run(ctx context.Context, r *tokenRefresh) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	token, err := c.source(ctx)
+	if err == nil && token.Value == "" {
+		err = fmt.Errorf("token source returned an empty token")
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to obtain an OpenObserve session token: %w", err)
+	}
+
+	c.mu.Lock()
+	if err == nil {
+		c.token = token
+	}
+	c.refresh = nil
+	c.mu.Unlock()
+
+	r.token, r.err = token, err
+	close(r.done)
+}
+
+// valid reports whether token can still be used. It is called with c.mu held.
+func (c *tokenCache) valid(token SessionToken) bool {
+	if token.Value == "" {
+		return false
+	}
+	return token.ExpiresAt.IsZero() || c.now().Add(tokenRefreshMargin).Before(token.ExpiresAt)
+}
+
+// invalidate drops token from the cache after OpenObserve rejected it, unless it has already
+// been replaced.
+func (c *tokenCache) invalidate(token SessionToken) {
+	c.mu.Lock()
+	if c.token.Value == token.Value {
+		c.token = SessionToken{}
+	}
+	c.mu.Unlock()
+}
+
+// tokenTransport authenticates the requests to OpenObserve with a session token instead of
+// basic auth. Requests sent without credentials, such as health checks, are left alone.
+type tokenTransport struct {
+	base   http.RoundTripper
+	tokens *tokenCache
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") == "" {
+		return t.base.RoundTrip(req)
+	}
+	token, err := t.tokens.get(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// A RoundTripper must not modify the request it is given.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.Value)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// The token was revoked or expired early; the next request obtains a new one.
+		t.tokens.invalidate(token)
+	}
+	return resp, err
+}
+
+// CloseIdleConnections lets http.Client.CloseIdleConnections reach the base transport.
+func (t *tokenTransport) CloseIdleConnections() {
+	if c, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
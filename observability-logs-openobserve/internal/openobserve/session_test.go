@@ -0,0 +1,199 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenCache_ConcurrentRefresh(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	cache := newTokenCache(func(ctx context.Context) (SessionToken, error) {
+		n := calls.Add(1)
+		<-release
+		return SessionToken{Value: fmt.Sprintf("token-%d", n), ExpiresAt: now.Add(time.Hour)}, nil
+	})
+	cache.now = func() time.Time { return now }
+
+	fetch := func() []string {
+		var wg sync.WaitGroup
+		tokens := make([]string, 50)
+		for i := range tokens {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				token, err := cache.get(context.Background())
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				tokens[i] = token.Value
+			}()
+		}
+		// Let every caller reach the cache before the refresh completes.
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		return tokens
+	}
+
+	for _, token := range fetch() {
+		if token != "token-1" {
+			t.Fatalf("expected every caller to share the first token, got %q", token)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected a single refresh, got %d", n)
+	}
+
+	// The cached token is reused until shortly before it expires.
+	now = now.Add(time.Hour - 2*tokenRefreshMargin)
+	if token, err := cache.get(context.Background()); err != nil || token.Value != "token-1" {
+		t.Fatalf("expected the cached token, got %q, %v", token.Value, err)
+	}
+	now = now.Add(tokenRefreshMargin)
+	release = make(chan struct{})
+	for _, token := range fetch() {
+		if token != "token-2" {
+			t.Fatalf("expected every caller to share the refreshed token, got %q", token)
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected a single refresh on expiry, got %d refreshes in total", n)
+	}
+}
+
+func TestTokenCache_RefreshError(t *testing.T) {
+	var calls atomic.Int32
+	cache := newTokenCache(func(ctx context.Context) (SessionToken, error) {
+		if calls.Add(1) == 1 {
+			return SessionToken{}, fmt.Errorf("login failed")
+		}
+		return SessionToken{Value: "token"}, nil
+	})
+
+	if _, err := cache.get(context.Background()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	// A failed refresh is not cached.
+	if token, err := cache.get(context.Background()); err != nil || token.Value != "token" {
+		t.Errorf("expected a new token, got %q, %v", token.Value, err)
+	}
+}
+
+func TestTokenCache_CallerGivesUp(t *testing.T) {
+	release := make(chan struct{})
+	cache := newTokenCache(func(ctx context.Context) (SessionToken, error) {
+		<-release
+		return SessionToken{Value: "token"}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cache.get(ctx); err == nil {
+		t.Fatal("expected error for a canceled caller, got nil")
+	}
+	close(release)
+	if token, err := cache.get(context.Background()); err != nil || token.Value != "token" {
+		t.Errorf("expected the token of the refresh started earlier, got %q, %v", token.Value, err)
+	}
+}
+
+func TestClient_TokenSource(t *testing.T) {
+	var mu sync.Mutex
+	var auth []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth = append(auth, r.URL.Path+" "+r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/healthz" {
+			w.Write([]byte(`{"status":"ok"}`))
+			return
+		}
+		if r.Header.Get("Authorization") == "Bearer revoked" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"message":"unauthorized"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	tokens := []string{"revoked", "fresh"}
+	var logins atomic.Int32
+	source := func(ctx context.Context) (SessionToken, error) {
+		return SessionToken{Value: tokens[logins.Add(1)-1]}, nil
+	}
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "pass", ClientOptions{TokenSource: source}, testLogger())
+
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	if _, err := client.GetComponentLogs(context.Background(), params); err == nil {
+		t.Fatal("expected error for a revoked token, got nil")
+	}
+	// The rejected token is dropped, so the next query logs in again.
+	params.SearchPhrase = "retry"
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.Ping(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := logins.Load(); n != 2 {
+		t.Errorf("expected 2 logins, got %d", n)
+	}
+	if len(auth) < 3 || auth[0] != "/api/default/_search Bearer revoked" || auth[len(auth)-1] != "/healthz " {
+		t.Fatalf("unexpected requests: %q", auth)
+	}
+	for _, request := range auth[1 : len(auth)-1] {
+		if request != "/api/default/_search Bearer fresh" {
+			t.Errorf("expected the new token after the rejected one, got %q", request)
+		}
+	}
+}
+
+func TestPasswordLogin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("invalid login form: %v", err)
+		}
+		if r.PostForm.Get("grant_type") != "password" || r.PostForm.Get("username") != "admin" ||
+			r.PostForm.Get("password") != "pass" || r.PostForm.Get("client_id") != "logs-adapter" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"session","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	token, err := PasswordLogin(server.URL, "logs-adapter", "admin", "pass")(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Value != "session" {
+		t.Errorf("expected the access token, got %q", token.Value)
+	}
+	if d := time.Until(token.ExpiresAt); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("expected the token to expire in an hour, got %s", d)
+	}
+
+	if _, err := PasswordLogin(server.URL, "logs-adapter", "admin", "wrong")(context.Background()); err == nil {
+		t.Error("expected error for rejected credentials, got nil")
+	}
+}
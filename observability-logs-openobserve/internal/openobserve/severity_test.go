@@ -0,0 +1,37 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import "testing"
+
+func TestParseApplicationLogEntry_SeverityClass(t *testing.T) {
+	if entry := newTestClient("http://localhost").parseApplicationLogEntry(map[string]interface{}{"logLevel": "ERROR"}); entry.SeverityClass != "" {
+		t.Errorf("expected no severity class by default, got %q", entry.SeverityClass)
+	}
+
+	client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{SeverityClasses: map[string]string{"ERROR": "critical", "WARN": "warning", SeverityClassDefault: "normal"}}, testLogger())
+	tests := []struct {
+		name   string
+		source map[string]interface{}
+		want   string
+	}{
+		{"source level", map[string]interface{}{"logLevel": "error"}, "critical"},
+		{"detected level", map[string]interface{}{"log": "WARN disk almost full"}, "warning"},
+		{"unlisted level", map[string]interface{}{"logLevel": "DEBUG"}, "normal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := client.parseApplicationLogEntry(tt.source).SeverityClass; got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	client = NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{SeverityClasses: map[string]string{"ERROR": "critical"}}, testLogger())
+	if got := client.parseApplicationLogEntry(map[string]interface{}{"logLevel": "INFO"}).SeverityClass; got != "" {
+		t.Errorf("expected no class without a default, got %q", got)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+)
+
+// queryFlight is a component log query that is running, or has recently completed, on behalf
+// of every caller that issued it.
+type queryFlight struct {
+	done    chan struct{}
+	result  *ComponentLogsResult
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// queryFlightGroup de-duplicates identical component log queries: concurrent callers issuing
+// the same query wait for a single upstream request and share its result. A successful result
+// is also shared with identical queries issued within window after it completed.
+type queryFlightGroup struct {
+	window  time.Duration
+	mu      sync.Mutex
+	flights map[string]*queryFlight
+}
+
+// do runs fn once per key at a time and returns its result to every caller of that key.
+// The upstream query runs detached from the callers' contexts and is canceled once every
+// caller waiting for it has given up. shared reports whether the result came from a query
+// started by another caller.
+func (g *queryFlightGroup) do(ctx context.Context, key string, fn func(context.Context) (*ComponentLogsResult, error)) (result *ComponentLogsResult, shared bool, err error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*queryFlight)
+	}
+	f, shared := g.flights[key]
+	if shared {
+		f.waiters++
+	} else {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		f = &queryFlight{done: make(chan struct{}), waiters: 1, cancel: cancel}
+		g.flights[key] = f
+		go g.run(flightCtx, key, f, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-f.done:
+		if f.err != nil {
+			return nil, shared, f.err
+		}
+		return cloneComponentLogsResult(f.result), shared, nil
+	case <-ctx.Done():
+		g.mu.Lock()
+		f.waiters--
+		if f.waiters == 0 {
+			// Callers arriving later start a new query rather than share the canceled one.
+			f.cancel()
+			if g.flights[key] == f {
+				delete(g.flights, key)
+			}
+		}
+		g.mu.Unlock()
+		return nil, shared, ctx.Err()
+	}
+}
+
+func (g *queryFlightGroup) run(ctx context.Context, key string, f *queryFlight, fn func(context.Context) (*ComponentLogsResult, error)) {
+	defer f.cancel()
+	f.result, f.err = fn(ctx)
+	close(f.done)
+
+	if f.err != nil || g.window <= 0 {
+		g.forget(key, f)
+		return
+	}
+	time.AfterFunc(g.window, func() { g.forget(key, f) })
+}
+
+// forget removes f from the group, unless key has since been taken by a newer flight.
+func (g *queryFlightGroup) forget(key string, f *queryFlight) {
+	g.mu.Lock()
+	if g.flights[key] == f {
+		delete(g.flights, key)
+	}
+	g.mu.Unlock()
+}
+
+// componentLogsFlightKey returns the normalized form of a component log query: queries that
+// differ only in the order of their component IDs or log levels, or in the time zone of their
+// time range, share a key.
+func componentLogsFlightKey(params ComponentLogsParams) string {
+	params.ComponentIDs = slices.Clone(params.ComponentIDs)
+	slices.Sort(params.ComponentIDs)
+	params.LogLevels = slices.Clone(params.LogLevels)
+	slices.Sort(params.LogLevels)
+	params.StartTime = params.StartTime.UTC()
+	params.EndTime = params.EndTime.UTC()
+
+	key, _ := json.Marshal(params)
+	return string(key)
+}
+
+// cloneComponentLogsResult copies a shared result so that callers can modify their logs
+// independently.
+func cloneComponentLogsResult(result *ComponentLogsResult) *ComponentLogsResult {
+	if result == nil {
+		return nil
+	}
+	clone := *result
+	clone.Logs = slices.Clone(result.Logs)
+	return &clone
+}
@@ -0,0 +1,263 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitForWaiters blocks until the flight for key has the given number of callers waiting on it.
+func waitForWaiters(t *testing.T, g *queryFlightGroup, key string, waiters int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		g.mu.Lock()
+		f := g.flights[key]
+		n := 0
+		if f != nil {
+			n = f.waiters
+		}
+		g.mu.Unlock()
+		if n == waiters {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d callers of %q", waiters, key)
+}
+
+func TestQueryFlightGroup_SharesConcurrentCalls(t *testing.T) {
+	g := &queryFlightGroup{}
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fn := func(ctx context.Context) (*ComponentLogsResult, error) {
+		calls.Add(1)
+		<-release
+		return &ComponentLogsResult{Logs: []ComponentLogsEntry{{Log: "shared"}}, TotalCount: 1}, nil
+	}
+
+	const callers = 5
+	results := make([]*ComponentLogsResult, callers)
+	shared := make([]bool, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			results[i], shared[i], err = g.do(context.Background(), "key", fn)
+			if err != nil {
+				t.Errorf("caller %d: unexpected error: %v", i, err)
+			}
+		}()
+	}
+	waitForWaiters(t, g, "key", callers)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected a single upstream call, got %d", n)
+	}
+	sharedCount := 0
+	for i, result := range results {
+		if result == nil || len(result.Logs) != 1 || result.Logs[0].Log != "shared" {
+			t.Fatalf("caller %d: unexpected result %+v", i, result)
+		}
+		if shared[i] {
+			sharedCount++
+		}
+	}
+	if sharedCount != callers-1 {
+		t.Errorf("expected %d shared results, got %d", callers-1, sharedCount)
+	}
+
+	// Each caller owns its copy of the logs.
+	results[0].Logs[0].Log = "modified"
+	if results[1].Logs[0].Log != "shared" {
+		t.Error("expected callers to receive independent copies of the logs")
+	}
+}
+
+func TestQueryFlightGroup_Errors(t *testing.T) {
+	g := &queryFlightGroup{window: time.Minute}
+	var calls atomic.Int32
+	fn := func(ctx context.Context) (*ComponentLogsResult, error) {
+		calls.Add(1)
+		return nil, errors.New("upstream failed")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := g.do(context.Background(), "key", fn); err == nil {
+			t.Fatal("expected the upstream error")
+		}
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected failed queries not to be reused, got %d upstream calls", n)
+	}
+}
+
+func TestQueryFlightGroup_Window(t *testing.T) {
+	fn := func(calls *atomic.Int32) func(context.Context) (*ComponentLogsResult, error) {
+		return func(ctx context.Context) (*ComponentLogsResult, error) {
+			calls.Add(1)
+			return &ComponentLogsResult{}, nil
+		}
+	}
+
+	t.Run("no window", func(t *testing.T) {
+		g := &queryFlightGroup{}
+		var calls atomic.Int32
+		g.do(context.Background(), "key", fn(&calls))
+		g.do(context.Background(), "key", fn(&calls))
+		if n := calls.Load(); n != 2 {
+			t.Errorf("expected sequential queries to run separately, got %d upstream calls", n)
+		}
+	})
+
+	t.Run("within window", func(t *testing.T) {
+		g := &queryFlightGroup{window: time.Minute}
+		var calls atomic.Int32
+		g.do(context.Background(), "key", fn(&calls))
+		_, shared, _ := g.do(context.Background(), "key", fn(&calls))
+		if n := calls.Load(); n != 1 || !shared {
+			t.Errorf("expected the result to be reused within the window, got %d upstream calls", n)
+		}
+		g.do(context.Background(), "other", fn(&calls))
+		if n := calls.Load(); n != 2 {
+			t.Errorf("expected a different query to run, got %d upstream calls", n)
+		}
+	})
+
+	t.Run("after window", func(t *testing.T) {
+		g := &queryFlightGroup{window: 10 * time.Millisecond}
+		var calls atomic.Int32
+		g.do(context.Background(), "key", fn(&calls))
+		time.Sleep(50 * time.Millisecond)
+		g.do(context.Background(), "key", fn(&calls))
+		if n := calls.Load(); n != 2 {
+			t.Errorf("expected the query to run again after the window, got %d upstream calls", n)
+		}
+	})
+}
+
+func TestQueryFlightGroup_Cancellation(t *testing.T) {
+	g := &queryFlightGroup{}
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	fn := func(ctx context.Context) (*ComponentLogsResult, error) {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return nil, ctx.Err()
+	}
+
+	first, cancelFirst := context.WithCancel(context.Background())
+	second, cancelSecond := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() { _, _, err := g.do(first, "key", fn); errs <- err }()
+	<-started
+	go func() { _, _, err := g.do(second, "key", fn); errs <- err }()
+	waitForWaiters(t, g, "key", 2)
+
+	cancelFirst()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the first caller to be canceled, got %v", err)
+	}
+	select {
+	case <-canceled:
+		t.Fatal("expected the query to keep running for the remaining caller")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancelSecond()
+	<-errs
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the query to be canceled once every caller gave up")
+	}
+}
+
+func TestComponentLogsFlightKey(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	base := ComponentLogsParams{
+		Namespace:    "ns",
+		ComponentIDs: []string{"a", "b"},
+		LogLevels:    []string{"ERROR", "WARN"},
+		StartTime:    start,
+		EndTime:      start.Add(time.Hour),
+		Limit:        100,
+	}
+
+	reordered := base
+	reordered.ComponentIDs = []string{"b", "a"}
+	reordered.LogLevels = []string{"WARN", "ERROR"}
+	reordered.StartTime = start.In(time.FixedZone("UTC+2", 2*60*60))
+	if componentLogsFlightKey(base) != componentLogsFlightKey(reordered) {
+		t.Error("expected reordered filters to share a key")
+	}
+	if base.ComponentIDs[0] != "a" || reordered.ComponentIDs[0] != "b" {
+		t.Error("expected the params not to be modified")
+	}
+
+	different := base
+	different.Limit = 10
+	if componentLogsFlightKey(base) == componentLogsFlightKey(different) {
+		t.Error("expected a different limit to change the key")
+	}
+}
+
+func TestGetComponentLogs_DeduplicatesIdenticalQueries(t *testing.T) {
+	var pageQueries atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{Hits: []map[string]interface{}{{"total": float64(1)}}}
+		if !isCountQuery(r) {
+			pageQueries.Add(1)
+			resp.Hits = []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "line", "logLevel": "INFO"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{QueryDedupWindow: time.Minute}, testLogger())
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		Limit:     10,
+	}
+
+	for i := 0; i < 3; i++ {
+		result, err := client.GetComponentLogs(context.Background(), params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Logs) != 1 || result.TotalCount != 1 {
+			t.Fatalf("unexpected result: %+v", result)
+		}
+	}
+	if n := pageQueries.Load(); n != 1 {
+		t.Errorf("expected identical queries to reach OpenObserve once, got %d", n)
+	}
+
+	params.Limit = 20
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := pageQueries.Load(); n != 2 {
+		t.Errorf("expected a different query to reach OpenObserve, got %d", n)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Fields that ComponentLogsParams.SortField may order the returned logs by.
+const (
+	SortFieldTimestamp = "timestamp"
+	SortFieldLevel     = "level"
+	SortFieldComponent = "component"
+	SortFieldNamespace = "namespace"
+)
+
+// sortFieldValues maps the sort fields other than the timestamp to the value of a log entry
+// they order by.
+var sortFieldValues = map[string]func(*ComponentLogsEntry) string{
+	SortFieldLevel:     func(e *ComponentLogsEntry) string { return e.LogLevel },
+	SortFieldComponent: func(e *ComponentLogsEntry) string { return e.ComponentName },
+	SortFieldNamespace: func(e *ComponentLogsEntry) string { return e.Namespace },
+}
+
+// ValidateSortField checks that SortField is empty or one of the sort fields.
+func (p ComponentLogsParams) ValidateSortField() error {
+	if p.SortField == "" || p.SortField == SortFieldTimestamp || sortFieldValues[p.SortField] != nil {
+		return nil
+	}
+	return fmt.Errorf("sortField must be one of %s, %s, %s or %s", SortFieldComponent, SortFieldLevel, SortFieldNamespace, SortFieldTimestamp)
+}
+
+// sortLogEntriesBy sorts log entries by the value of field, in sortOrder, breaking ties by
+// timestamp in the same order. Entries equal in both keep their relative order. An empty or
+// unknown field sorts by timestamp alone, like sortLogEntries.
+func sortLogEntriesBy(logs []ComponentLogsEntry, field, sortOrder string) {
+	value, ok := sortFieldValues[field]
+	if !ok {
+		sortLogEntries(logs, sortOrder)
+		return
+	}
+	asc := strings.EqualFold(sortOrder, "asc")
+	sort.SliceStable(logs, func(i, j int) bool {
+		a, b := &logs[i], &logs[j]
+		if !asc {
+			a, b = b, a
+		}
+		if va, vb := value(a), value(b); va != vb {
+			return va < vb
+		}
+		return a.Timestamp.Before(b.Timestamp)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSortLogEntriesBy(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	logs := func() []ComponentLogsEntry {
+		return []ComponentLogsEntry{
+			{Timestamp: base.Add(3 * time.Second), Log: "d", LogLevel: "INFO", ComponentName: "api"},
+			{Timestamp: base.Add(2 * time.Second), Log: "c", LogLevel: "ERROR", ComponentName: "web"},
+			{Timestamp: base.Add(1 * time.Second), Log: "b", LogLevel: "INFO", ComponentName: "web"},
+			{Timestamp: base, Log: "a", LogLevel: "ERROR", ComponentName: "api"},
+		}
+	}
+	order := func(entries []ComponentLogsEntry) string {
+		var out string
+		for _, e := range entries {
+			out += e.Log
+		}
+		return out
+	}
+
+	tests := []struct {
+		field, sortOrder, want string
+	}{
+		{SortFieldLevel, "asc", "acbd"},
+		{SortFieldLevel, "desc", "dbca"},
+		{SortFieldComponent, "asc", "adbc"},
+		{SortFieldComponent, "desc", "cbda"},
+		{SortFieldTimestamp, "asc", "abcd"},
+		{"", "desc", "dcba"},
+	}
+	for _, tt := range tests {
+		entries := logs()
+		sortLogEntriesBy(entries, tt.field, tt.sortOrder)
+		if got := order(entries); got != tt.want {
+			t.Errorf("sortLogEntriesBy(%q, %q) = %s, want %s", tt.field, tt.sortOrder, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSortField(t *testing.T) {
+	for _, field := range []string{"", SortFieldTimestamp, SortFieldLevel, SortFieldComponent, SortFieldNamespace} {
+		if err := (ComponentLogsParams{SortField: field}).ValidateSortField(); err != nil {
+			t.Errorf("expected %q to be accepted, got %v", field, err)
+		}
+	}
+	if err := (ComponentLogsParams{SortField: "log"}).ValidateSortField(); err == nil {
+		t.Error("expected an unknown sort field to be rejected")
+	}
+}
+
+func TestGetComponentLogs_SortField(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if isCountQuery(r) {
+			w.Write([]byte(`{"took":1,"hits":[{"total":3}]}`))
+			return
+		}
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{
+			{"_timestamp": float64(base.UnixMicro()), "log": "oldest", "logLevel": "DEBUG"},
+			{"_timestamp": float64(base.Add(time.Second).UnixMicro()), "log": "middle", "logLevel": "WARN"},
+			{"_timestamp": float64(base.Add(2 * time.Second).UnixMicro()), "log": "newest", "logLevel": "ERROR"},
+		}})
+	}))
+	defer server.Close()
+
+	result, err := newTestClient(server.URL).GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		SortOrder: "asc",
+		SortField: SortFieldLevel,
+		StartTime: base.Add(-time.Hour),
+		EndTime:   base.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Logs) != 3 || result.Logs[0].Log != "oldest" || result.Logs[1].Log != "newest" || result.Logs[2].Log != "middle" {
+		t.Fatalf("expected the logs ordered by level, got %+v", result.Logs)
+	}
+	// Indexes and cursors still follow the timestamps.
+	if result.Logs[1].Index != 3 {
+		t.Errorf("expected the newest log to keep index 3, got %d", result.Logs[1].Index)
+	}
+	if after, _ := decodeLogCursor(result.AfterCursor); after.timestamp != base.Add(2*time.Second).UnixMicro() {
+		t.Errorf("expected the after cursor at the newest log, got %+v", after)
+	}
+}
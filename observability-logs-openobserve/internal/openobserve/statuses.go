@@ -0,0 +1,94 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// UpstreamStatus reports the HTTP status codes of the responses OpenObserve returned to the
+// client, e.g. to spot a growing share of 429 or 5xx responses before queries start failing.
+type UpstreamStatus struct {
+	// Since is when counting started, i.e. when the client was created.
+	Since time.Time `json:"since"`
+	// Requests is the number of requests sent, including those that failed without a response.
+	Requests int64 `json:"requests"`
+	// StatusCodes counts the responses per status code.
+	StatusCodes map[string]int64 `json:"statusCodes"`
+	// ConnectionErrors counts the requests that failed without a response, e.g. because
+	// OpenObserve could not be reached or timed out. Requests canceled by the caller are not
+	// counted.
+	ConnectionErrors int64 `json:"connectionErrors"`
+}
+
+// statusCounter counts the responses of OpenObserve per status code.
+type statusCounter struct {
+	since time.Time
+
+	mu       sync.Mutex
+	codes    map[int]int64
+	failures int64
+}
+
+func newStatusCounter() *statusCounter {
+	return &statusCounter{since: time.Now().UTC(), codes: make(map[int]int64)}
+}
+
+func (s *statusCounter) record(req *http.Request, resp *http.Response, err error) {
+	if err != nil && req.Context().Err() != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.failures++
+		return
+	}
+	s.codes[resp.StatusCode]++
+}
+
+func (s *statusCounter) snapshot() UpstreamStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := UpstreamStatus{
+		Since:            s.since,
+		Requests:         s.failures,
+		StatusCodes:      make(map[string]int64, len(s.codes)),
+		ConnectionErrors: s.failures,
+	}
+	for code, n := range s.codes {
+		status.StatusCodes[strconv.Itoa(code)] = n
+		status.Requests += n
+	}
+	return status
+}
+
+// statusTransport counts the status codes of the responses to every request sent to
+// OpenObserve, whichever client method sent it.
+type statusTransport struct {
+	base    http.RoundTripper
+	counter *statusCounter
+}
+
+func (t *statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	t.counter.record(req, resp, err)
+	return resp, err
+}
+
+// CloseIdleConnections lets http.Client.CloseIdleConnections reach the base transport.
+func (t *statusTransport) CloseIdleConnections() {
+	if c, ok := t.base.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// UpstreamStatus returns the number of OpenObserve responses per status code since the client
+// was created.
+func (c *Client) UpstreamStatus() UpstreamStatus {
+	return c.statuses.snapshot()
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_UpstreamStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(server.URL)
+
+	client.Ping(context.Background())
+	client.Ping(context.Background())
+	client.getAlertIDByName(context.Background(), "missing")
+
+	server.Close()
+	client.Ping(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client.Ping(ctx)
+
+	status := client.UpstreamStatus()
+	want := map[string]int64{"503": 2, "200": 1}
+	if !reflect.DeepEqual(status.StatusCodes, want) || status.ConnectionErrors != 1 || status.Requests != 4 {
+		t.Errorf("unexpected status distribution: %+v", status)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+const (
+	// DefaultStreamPollInterval is how often StreamComponentLogs polls OpenObserve for new logs.
+	DefaultStreamPollInterval = 2 * time.Second
+	// streamBatchLimit caps the number of logs fetched per poll.
+	streamBatchLimit = 500
+)
+
+// StreamComponentLogs polls OpenObserve every interval for component logs newer than the
+// last one delivered and calls emit for each new entry, oldest first. Polling starts at
+// params.StartTime, or at the current time when it is zero. It returns nil when ctx is
+// cancelled, or the error returned by emit. Failed polls are logged and retried on the
+// next tick so that a transient OpenObserve error does not end the stream.
+func (c *Client) StreamComponentLogs(ctx context.Context, params ComponentLogsParams, interval time.Duration, emit func(ComponentLogsEntry) error) error {
+	if interval <= 0 {
+		interval = DefaultStreamPollInterval
+	}
+
+	// cursor is the position from which the next poll starts. Logs written after a poll may
+	// share the timestamp of the last one delivered, so polls resume at that timestamp and
+	// skip the logs at it that were already delivered.
+	cursor := logCursor{timestamp: params.StartTime.UnixMicro(), after: true}
+	if params.StartTime.IsZero() {
+		cursor.timestamp = time.Now().UnixMicro()
+	}
+
+	params.SortOrder = "ASC"
+	params.Limit = streamBatchLimit
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		next, err := c.pollComponentLogs(ctx, params, cursor, emit)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		cursor = next
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollComponentLogs fetches the logs written since cursor, emits them and returns the
+// cursor for the next poll. Only errors returned by emit are propagated.
+func (c *Client) pollComponentLogs(ctx context.Context, params ComponentLogsParams, cursor logCursor, emit func(ComponentLogsEntry) error) (logCursor, error) {
+	params.StartTime = time.UnixMicro(cursor.timestamp)
+	params.EndTime = time.Now()
+	params = c.withFieldNames(params)
+	params.cursor = &cursor
+	stream, err := c.logStream(params)
+	if err != nil {
+		return cursor, err
+	}
+
+	queryJSON, err := generateComponentLogsQuery(params, stream, c.logger)
+	if err != nil {
+		return cursor, fmt.Errorf("failed to generate component logs stream query: %w", err)
+	}
+
+	resp, err := c.executeSearchQuery(ctx, queryJSON)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("Failed to poll component logs for stream, retrying on next tick",
+				slog.String("namespace", params.Namespace),
+				slog.Any("error", err))
+		}
+		return cursor, nil
+	}
+
+	for _, hit := range resp.Hits {
+		entry := c.parseApplicationLogEntry(hit)
+		if err := emit(entry); err != nil {
+			return cursor, err
+		}
+		if timestamp := entry.Timestamp.UnixMicro(); timestamp > cursor.timestamp {
+			cursor = logCursor{timestamp: timestamp, after: true}
+		}
+		cursor.skip++
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStreamComponentLogs(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	var mu sync.Mutex
+	var startTimes []int64
+	var froms []int
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				StartTime int64 `json:"start_time"`
+				From      int   `json:"from"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		startTimes = append(startTimes, body.Query.StartTime)
+		froms = append(froms, body.Query.From)
+		polls++
+		poll := polls
+		mu.Unlock()
+
+		resp := OpenObserveResponse{}
+		switch poll {
+		case 1:
+			resp.Hits = []map[string]interface{}{
+				{"_timestamp": float64(base.UnixMicro()), "log": "first"},
+				{"_timestamp": float64(base.Add(time.Second).UnixMicro()), "log": "second"},
+			}
+		case 2:
+			resp.Hits = []map[string]interface{}{
+				{"_timestamp": float64(base.Add(2 * time.Second).UnixMicro()), "log": "third"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got []string
+	err := c.StreamComponentLogs(ctx, ComponentLogsParams{Namespace: "ns", StartTime: base}, 10*time.Millisecond, func(entry ComponentLogsEntry) error {
+		got = append(got, entry.Log)
+		if len(got) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) != 3 || got[0] != "first" || got[1] != "second" || got[2] != "third" {
+		t.Fatalf("unexpected entries: %v", got)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if startTimes[0] != base.UnixMicro() {
+		t.Errorf("expected first poll to start at %d, got %d", base.UnixMicro(), startTimes[0])
+	}
+	if want := base.Add(time.Second).UnixMicro(); startTimes[1] != want || froms[1] != 1 {
+		t.Errorf("expected second poll to start at the last seen entry (%d) skipping it, got %d skipping %d", want, startTimes[1], froms[1])
+	}
+}
+
+func TestStreamComponentLogs_SharedTimestamp(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC).UnixMicro()
+
+	// OpenObserve holds three logs written in the same microsecond, the last of which only
+	// arrives after the first poll.
+	var mu sync.Mutex
+	hits := []map[string]interface{}{
+		{"_timestamp": float64(base), "log": "first"},
+		{"_timestamp": float64(base), "log": "second"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				StartTime int64 `json:"start_time"`
+				From      int   `json:"from"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		mu.Lock()
+		resp := OpenObserveResponse{}
+		for _, hit := range hits {
+			if int64(hit["_timestamp"].(float64)) >= body.Query.StartTime {
+				resp.Hits = append(resp.Hits, hit)
+			}
+		}
+		if body.Query.From < len(resp.Hits) {
+			resp.Hits = resp.Hits[body.Query.From:]
+		} else {
+			resp.Hits = nil
+		}
+		if len(hits) == 2 {
+			hits = append(hits, map[string]interface{}{"_timestamp": float64(base), "log": "third"})
+		}
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var got []string
+	err := c.StreamComponentLogs(ctx, ComponentLogsParams{Namespace: "ns", StartTime: time.UnixMicro(base)}, 10*time.Millisecond, func(entry ComponentLogsEntry) error {
+		got = append(got, entry.Log)
+		if len(got) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 3 || got[0] != "first" || got[1] != "second" || got[2] != "third" {
+		t.Fatalf("expected each log once, got %v", got)
+	}
+}
+
+func TestStreamComponentLogs_StopsOnCancel(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	polled := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polls++
+		mu.Unlock()
+		select {
+		case polled <- struct{}{}:
+		default:
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(OpenObserveResponse{})
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- c.StreamComponentLogs(ctx, ComponentLogsParams{Namespace: "ns"}, 5*time.Millisecond, func(ComponentLogsEntry) error {
+			return nil
+		})
+	}()
+
+	<-polled
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("expected a canceled stream to end without an error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the stream to stop when its context was canceled")
+	}
+
+	mu.Lock()
+	stopped := polls
+	mu.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	if polls != stopped {
+		t.Errorf("expected no polls after the stream stopped, got %d more", polls-stopped)
+	}
+}
+
+func TestStreamComponentLogs_EmitError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := OpenObserveResponse{
+			Hits: []map[string]interface{}{{"_timestamp": float64(time.Now().UnixMicro()), "log": "line"}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	emitErr := errors.New("client gone")
+	err := c.StreamComponentLogs(context.Background(), ComponentLogsParams{Namespace: "ns"}, 10*time.Millisecond, func(ComponentLogsEntry) error {
+		return emitErr
+	})
+	if !errors.Is(err, emitErr) {
+		t.Fatalf("expected emit error, got %v", err)
+	}
+}
+
+func TestStreamComponentLogs_RetriesOnServerError(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		polls++
+		poll := polls
+		mu.Unlock()
+
+		if poll == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("boom"))
+			return
+		}
+		resp := OpenObserveResponse{
+			Hits: []map[string]interface{}{{"_timestamp": float64(time.Now().UnixMicro()), "log": "recovered"}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var got string
+	err := c.StreamComponentLogs(ctx, ComponentLogsParams{Namespace: "ns"}, 10*time.Millisecond, func(entry ComponentLogsEntry) error {
+		got = entry.Log
+		cancel()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "recovered" {
+		t.Errorf("expected stream to recover after a failed poll, got %q", got)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StreamRef references an OpenObserve log stream, optionally qualified by the folder that
+// organizes it, written "folder/stream".
+type StreamRef struct {
+	Folder string
+	Name   string
+}
+
+// String returns the reference in its "folder/stream" form, or the bare stream name when it
+// has no folder.
+func (r StreamRef) String() string {
+	if r.Folder == "" {
+		return r.Name
+	}
+	return r.Folder + "/" + r.Name
+}
+
+// ParseStreamRef parses a stream reference of the form "stream" or "folder/stream".
+func ParseStreamRef(ref string) (StreamRef, error) {
+	folder, name, qualified := strings.Cut(strings.TrimSpace(ref), "/")
+	if !qualified {
+		folder, name = "", folder
+	}
+	folder, name = strings.TrimSpace(folder), strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return StreamRef{}, fmt.Errorf("stream name is empty")
+	case qualified && folder == "":
+		return StreamRef{}, fmt.Errorf("folder name is empty")
+	case strings.Contains(name, "/"):
+		return StreamRef{}, fmt.Errorf("expected stream or folder/stream")
+	}
+	return StreamRef{Folder: folder, Name: name}, nil
+}
+
+// LogStreamError is returned for a component log query whose LogStream is malformed or not
+// among ClientOptions.AllowedStreams. The query is not run.
+type LogStreamError struct {
+	Ref    string
+	Reason string
+}
+
+func (e *LogStreamError) Error() string {
+	return fmt.Sprintf("invalid logStream %q: %s", e.Ref, e.Reason)
+}
+
+// ValidateLogStream checks a LogStream reference of a component log query. The error is a
+// *LogStreamError, suitable for returning to the caller.
+func (c *Client) ValidateLogStream(ref string) error {
+	_, err := c.logStream(ComponentLogsParams{LogStream: ref})
+	return err
+}
+
+// logStream returns the name of the OpenObserve stream queried for params: the configured
+// stream, or params.LogStream if it is allowed. OpenObserve's search API addresses streams by
+// name within the organization, so a folder qualifier scopes which streams may be queried
+// rather than changing the query. It also rejects a query whose MinIngestionLag cannot be
+// applied (see IngestionLagError) or that lists too many ComponentIDs (see
+// ComponentIDsError), as every component log query resolves its stream first.
+func (c *Client) logStream(params ComponentLogsParams) (string, error) {
+	if _, err := c.ingestionLag(params); err != nil {
+		return "", err
+	}
+	if err := c.ValidateComponentIDs(params.ComponentIDs); err != nil {
+		return "", err
+	}
+	if params.LogStream == "" {
+		return c.stream, nil
+	}
+	ref, err := ParseStreamRef(params.LogStream)
+	if err != nil {
+		return "", &LogStreamError{Ref: params.LogStream, Reason: err.Error()}
+	}
+	if ref.Folder == "" && ref.Name == c.stream {
+		return c.stream, nil
+	}
+	if !c.allowedStreams[ref.String()] {
+		return "", &LogStreamError{Ref: params.LogStream, Reason: "stream is not among the allowed streams"}
+	}
+	return ref.Name, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseStreamRef(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    StreamRef
+		wantErr bool
+	}{
+		{"app_logs", StreamRef{Name: "app_logs"}, false},
+		{" team-a / app_logs ", StreamRef{Folder: "team-a", Name: "app_logs"}, false},
+		{"", StreamRef{}, true},
+		{"team-a/", StreamRef{}, true},
+		{"/app_logs", StreamRef{}, true},
+		{"a/b/c", StreamRef{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStreamRef(tt.ref)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseStreamRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseStreamRef(%q) = %+v, want %+v", tt.ref, got, tt.want)
+		}
+	}
+
+	if got := (StreamRef{Folder: "team-a", Name: "app_logs"}).String(); got != "team-a/app_logs" {
+		t.Errorf("unexpected String(): %q", got)
+	}
+}
+
+func TestLogStream(t *testing.T) {
+	client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{AllowedStreams: []StreamRef{{Name: "archive"}, {Folder: "team-a", Name: "app_logs"}}}, testLogger())
+
+	tests := []struct {
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{"", "default", false},
+		{"default", "default", false},
+		{"archive", "archive", false},
+		{"team-a/app_logs", "app_logs", false},
+		{"app_logs", "", true},
+		{"team-b/app_logs", "", true},
+		{"team-a/", "", true},
+	}
+	for _, tt := range tests {
+		got, err := client.logStream(ComponentLogsParams{LogStream: tt.ref})
+		if tt.wantErr {
+			var streamErr *LogStreamError
+			if !errors.As(err, &streamErr) {
+				t.Errorf("logStream(%q): expected a LogStreamError, got %v", tt.ref, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("logStream(%q) = %q, %v; want %q", tt.ref, got, err, tt.want)
+		}
+	}
+}
+
+func TestGetComponentLogs_LogStream(t *testing.T) {
+	var mu sync.Mutex
+	var sqls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		sqls = append(sqls, body.Query.SQL)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(OpenObserveResponse{Hits: []map[string]interface{}{}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{
+			AllowedStreams:  []StreamRef{{Folder: "team-a", Name: "app_logs"}},
+			FallbackStreams: []string{"archive"},
+		}, testLogger())
+	params := ComponentLogsParams{
+		Namespace: "ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		LogStream: "team-a/app_logs",
+	}
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sqls) != 2 {
+		t.Fatalf("expected the log and count queries without fallback, got %v", sqls)
+	}
+	for _, sql := range sqls {
+		if !strings.Contains(sql, `FROM "app_logs"`) {
+			t.Errorf("expected the selected stream to be queried, got %s", sql)
+		}
+	}
+
+	params.LogStream = "team-b/app_logs"
+	_, err := client.GetComponentLogs(context.Background(), params)
+	var streamErr *LogStreamError
+	if !errors.As(err, &streamErr) {
+		t.Errorf("expected a LogStreamError, got %v", err)
+	}
+	if len(sqls) != 2 {
+		t.Errorf("expected no query for a stream that is not allowed, got %v", sqls[2:])
+	}
+}
+
+func TestValidateIngestionLag(t *testing.T) {
+	client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{EventTimeField: "event_time"}, testLogger())
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"", false},
+		{"30s", false},
+		{"1h30m", false},
+		{"0s", true},
+		{"-5s", true},
+		{"30", true},
+	}
+	for _, tt := range tests {
+		err := client.ValidateIngestionLag(tt.value)
+		var lagErr *IngestionLagError
+		if tt.wantErr != errors.As(err, &lagErr) {
+			t.Errorf("ValidateIngestionLag(%q) = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+	}
+
+	client = NewClient("http://localhost", "default", "default", "k8s_events", "admin", "token", testLogger())
+	if err := client.ValidateIngestionLag("30s"); err == nil {
+		t.Error("expected an error without an event time field, got nil")
+	}
+	if _, err := client.logStream(ComponentLogsParams{MinIngestionLag: "30s"}); err == nil {
+		t.Error("expected queries filtering on ingestion lag to be rejected, got nil")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxAtTimestamps caps the number of ComponentLogsParams.AtTimestamps of a query, bounding
+// the size of its SQL.
+const MaxAtTimestamps = 100
+
+// pinned reports whether the query is pinned to exact timestamps (AtTimestamp or
+// AtTimestamps) rather than a time range.
+func (p ComponentLogsParams) pinned() bool {
+	return p.AtTimestamp != 0 || len(p.AtTimestamps) > 0
+}
+
+// ValidateAtTimestamps checks AtTimestamp and AtTimestamps. The error is suitable for
+// returning to the caller.
+func (p ComponentLogsParams) ValidateAtTimestamps() error {
+	if p.AtTimestamp < 0 {
+		return fmt.Errorf("atTimestamp must be a positive number of microseconds since the epoch")
+	}
+	if len(p.AtTimestamps) == 0 {
+		return nil
+	}
+	if p.AtTimestamp != 0 {
+		return fmt.Errorf("atTimestamp and atTimestamps cannot be combined")
+	}
+	if len(p.AtTimestamps) > MaxAtTimestamps {
+		return fmt.Errorf("atTimestamps must not list more than %d timestamps, got %d", MaxAtTimestamps, len(p.AtTimestamps))
+	}
+	for _, at := range p.AtTimestamps {
+		if at <= 0 {
+			return fmt.Errorf("atTimestamps must be positive numbers of microseconds since the epoch, got %d", at)
+		}
+	}
+	return nil
+}
+
+// atTimestampsCondition returns the SQL condition matching the logs within the epsilon of
+// any of AtTimestamps, or "" if there are none. The timestamps are sorted and deduplicated,
+// so that identical lists produce identical queries.
+func (p ComponentLogsParams) atTimestampsCondition() string {
+	if len(p.AtTimestamps) == 0 {
+		return ""
+	}
+	timestamps := slices.Clone(p.AtTimestamps)
+	slices.Sort(timestamps)
+	timestamps = slices.Compact(timestamps)
+
+	column := p.timestampColumn()
+	eps := p.atTimestampEps.Microseconds()
+	ranges := make([]string, len(timestamps))
+	for i, at := range timestamps {
+		ranges[i] = fmt.Sprintf("(%s >= %d AND %s <= %d)", column, at-eps, column, at+eps)
+	}
+	return "(" + strings.Join(ranges, " OR ") + ")"
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetComponentLogs_AtTimestamps(t *testing.T) {
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"took":1,"hits":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions(server.URL, "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{AtTimestampEpsilon: 2 * time.Millisecond}, testLogger())
+	params := ComponentLogsParams{
+		Namespace:    "test-ns",
+		AtTimestamps: []int64{1735732900000000, 1735732800000000, 1735732900000000},
+		SortOrder:    "desc",
+	}
+	if _, err := client.GetComponentLogs(context.Background(), params); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(bodies) == 0 {
+		t.Fatal("expected a query")
+	}
+
+	sql, query := sqlOf(t, bodies[0])
+	want := "((_timestamp >= 1735732799998000 AND _timestamp <= 1735732800002000) OR " +
+		"(_timestamp >= 1735732899998000 AND _timestamp <= 1735732900002000))"
+	if !strings.Contains(sql, want) {
+		t.Errorf("expected one range per distinct timestamp, got %s", sql)
+	}
+	if !strings.HasSuffix(sql, "ORDER BY _timestamp ASC") {
+		t.Errorf("expected the logs oldest first, got %s", sql)
+	}
+	if query["start_time"] != float64(1735732799998000) || query["end_time"] != float64(1735732900002000) {
+		t.Errorf("expected the window to span the timestamps, got %v to %v", query["start_time"], query["end_time"])
+	}
+}
+
+func TestValidateAtTimestamps(t *testing.T) {
+	tooMany := make([]int64, MaxAtTimestamps+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+	tests := []struct {
+		name    string
+		params  ComponentLogsParams
+		wantErr bool
+	}{
+		{"none", ComponentLogsParams{}, false},
+		{"single", ComponentLogsParams{AtTimestamp: 1}, false},
+		{"list", ComponentLogsParams{AtTimestamps: []int64{1, 2}}, false},
+		{"negative single", ComponentLogsParams{AtTimestamp: -1}, true},
+		{"both", ComponentLogsParams{AtTimestamp: 1, AtTimestamps: []int64{2}}, true},
+		{"non-positive entry", ComponentLogsParams{AtTimestamps: []int64{1, 0}}, true},
+		{"too many", ComponentLogsParams{AtTimestamps: tooMany}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.ValidateAtTimestamps(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAtTimestamps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
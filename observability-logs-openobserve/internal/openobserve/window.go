@@ -0,0 +1,45 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"log/slog"
+	"time"
+)
+
+// DefaultQueryWindow is the time window queried, up to now, by a component log query that
+// gives neither a start nor an end time, when ClientOptions.DefaultWindow is not set.
+const DefaultQueryWindow = 15 * time.Minute
+
+// TimeWindow is the time range a query ran over.
+type TimeWindow struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// resolveTimeWindow gives a query that selects no time range, neither through its start and
+// end times nor through AtTimestamp or AtTimestamps, the default window up to now. OpenObserve would
+// otherwise search the empty range [0, 0].
+func (c *Client) resolveTimeWindow(params ComponentLogsParams) ComponentLogsParams {
+	if !params.StartTime.IsZero() || !params.EndTime.IsZero() || params.pinned() {
+		return params
+	}
+	params.EndTime = time.Now()
+	params.StartTime = params.EndTime.Add(-c.defaultWindow)
+	params.defaultWindow = true
+	c.logger.Debug("Applied the default time window to a component log query",
+		slog.String("namespace", params.Namespace),
+		slog.Duration("window", c.defaultWindow),
+	)
+	return params
+}
+
+// appliedWindow returns the time window of params if it was defaulted by resolveTimeWindow,
+// so that responses show which logs they cover, and nil otherwise.
+func (p ComponentLogsParams) appliedWindow() *TimeWindow {
+	if !p.defaultWindow {
+		return nil
+	}
+	return &TimeWindow{StartTime: p.StartTime, EndTime: p.EndTime}
+}
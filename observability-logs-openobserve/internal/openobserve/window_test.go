@@ -0,0 +1,75 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package openobserve
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestResolveTimeWindow(t *testing.T) {
+	client := NewClientWithOptions("http://localhost", "default", "default", "k8s_events", "admin", "token",
+		ClientOptions{DefaultWindow: time.Hour}, testLogger())
+
+	before := time.Now()
+	params := client.resolveTimeWindow(ComponentLogsParams{Namespace: "test-ns"})
+	if params.EndTime.Before(before) || params.EndTime.Sub(params.StartTime) != time.Hour {
+		t.Errorf("expected the last hour, got %s to %s", params.StartTime, params.EndTime)
+	}
+	if window := params.appliedWindow(); window == nil || !window.StartTime.Equal(params.StartTime) {
+		t.Errorf("expected the applied window to be reported, got %+v", window)
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, given := range []ComponentLogsParams{
+		{StartTime: start},
+		{EndTime: start},
+		{AtTimestamp: start.UnixMicro()},
+	} {
+		if params := client.resolveTimeWindow(given); params.StartTime != given.StartTime || params.EndTime != given.EndTime ||
+			params.appliedWindow() != nil {
+			t.Errorf("expected %+v to be kept, got %s to %s", given, params.StartTime, params.EndTime)
+		}
+	}
+
+	if params := newTestClient("http://localhost").resolveTimeWindow(ComponentLogsParams{}); params.EndTime.Sub(params.StartTime) != DefaultQueryWindow {
+		t.Errorf("expected the default window, got %s", params.EndTime.Sub(params.StartTime))
+	}
+}
+
+func TestGetComponentLogs_DefaultWindow(t *testing.T) {
+	server := newFakeOpenObserve(t)
+	server.hits = []map[string]interface{}{{"_timestamp": float64(time.Now().UnixMicro()), "log": "recent"}}
+	server.total = 1
+	client := newTestClient(server.URL)
+
+	result, err := client.GetComponentLogs(context.Background(), ComponentLogsParams{Namespace: "test-ns"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Window == nil || result.Window.EndTime.Sub(result.Window.StartTime) != DefaultQueryWindow {
+		t.Errorf("expected the default window in the result, got %+v", result.Window)
+	}
+
+	count, err := client.CountComponentLogs(context.Background(), ComponentLogsParams{Namespace: "test-ns"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count.Window == nil {
+		t.Error("expected the default window in the count result")
+	}
+
+	result, err = client.GetComponentLogs(context.Background(), ComponentLogsParams{
+		Namespace: "test-ns",
+		StartTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Window != nil {
+		t.Errorf("expected no window for an explicit time range, got %+v", result.Window)
+	}
+}
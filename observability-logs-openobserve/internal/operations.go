@@ -0,0 +1,82 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// operationIDHeader carries the ID of a cancelable operation (a log stream or export), which
+// can be passed to POST /api/v1/logs/{id}/cancel.
+const operationIDHeader = "X-Operation-Id"
+
+// operationRegistry tracks the in-flight long-running operations so that they can be
+// canceled by ID.
+type operationRegistry struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+func newOperationRegistry() *operationRegistry {
+	return &operationRegistry{cancels: make(map[string]context.CancelFunc)}
+}
+
+// start registers a new operation and returns its context, ID and a function that ends it.
+// The context is canceled when the operation is canceled by ID, when parent is done or when
+// the returned function is called, which must happen once the operation finishes.
+func (r *operationRegistry) start(parent context.Context) (context.Context, string, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	id := newOperationID()
+
+	r.mu.Lock()
+	r.cancels[id] = cancel
+	r.mu.Unlock()
+
+	return ctx, id, func() {
+		r.mu.Lock()
+		delete(r.cancels, id)
+		r.mu.Unlock()
+		cancel()
+	}
+}
+
+// cancel cancels the operation with the given ID. It reports false if no such operation is
+// in flight.
+func (r *operationRegistry) cancel(id string) bool {
+	r.mu.Lock()
+	cancel, ok := r.cancels[id]
+	delete(r.cancels, id)
+	r.mu.Unlock()
+
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// newOperationID returns a random, unguessable operation ID, so that only the client that
+// started an operation can cancel it.
+func newOperationID() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// CancelOperation implements POST /api/v1/logs/{id}/cancel.
+// It cancels an in-flight log stream or export, stopping its OpenObserve queries and
+// closing the response. It responds with 404 if no operation with the ID is in flight.
+func (h *LogsHandler) CancelOperation(w http.ResponseWriter, r *http.Request) {
+	if !h.operations.cancel(r.PathValue("id")) {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "operation not found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
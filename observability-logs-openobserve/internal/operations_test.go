@@ -0,0 +1,56 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOperationRegistry(t *testing.T) {
+	r := newOperationRegistry()
+
+	ctx, id, done := r.start(context.Background())
+	if id == "" {
+		t.Fatal("expected a non-empty operation ID")
+	}
+	_, otherID, otherDone := r.start(context.Background())
+	defer otherDone()
+	if otherID == id {
+		t.Fatal("expected unique operation IDs")
+	}
+
+	if !r.cancel(id) {
+		t.Fatal("expected the operation to be canceled")
+	}
+	if ctx.Err() == nil {
+		t.Error("expected the operation context to be canceled")
+	}
+	if r.cancel(id) {
+		t.Error("expected a canceled operation to be unregistered")
+	}
+	done()
+
+	_, finishedID, finish := r.start(context.Background())
+	finish()
+	if r.cancel(finishedID) {
+		t.Error("expected a finished operation to be unregistered")
+	}
+}
+
+func TestCancelOperation_NotFound(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+	mux := http.NewServeMux()
+	handler.registerRoutes(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/unknown/cancel", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bufio"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// plainTextContentType is the media type of plain-text search responses.
+const plainTextContentType = "text/plain"
+
+// plainTextTimestampFormat is the timestamp layout of plain-text log lines, fixed-width so
+// that the lines align in a terminal.
+const plainTextTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// acceptsPlainText reports whether the Accept header of r explicitly asks for a plain-text
+// response. Wildcards select JSON, which stays the default.
+func acceptsPlainText(r *http.Request) bool {
+	return acceptsMediaType(r, plainTextContentType)
+}
+
+// formatPlainTextLog formats entry as a "timestamp level [component/pod] message" line. The
+// continuation lines of a multi-line message (e.g. a stack trace) are indented, so that every
+// unindented line starts a new log.
+func formatPlainTextLog(entry *openobserve.ComponentLogsEntry) string {
+	level := entry.LogLevel
+	if level == "" {
+		level = "-"
+	}
+	component := entry.ComponentName
+	if component == "" {
+		component = entry.ComponentUID
+	}
+	if component == "" {
+		component = "-"
+	}
+	pod := entry.PodName
+	if pod == "" {
+		pod = "-"
+	}
+	message := strings.ReplaceAll(strings.TrimRight(entry.Log, "\r\n"), "\n", "\n\t")
+	return entry.Timestamp.UTC().Format(plainTextTimestampFormat) + " " + level + " [" + component + "/" + pod + "] " + message + "\n"
+}
+
+// writePlainText writes the logs of result, one per line in the order of the result, as a
+// plain-text response for reading in a terminal.
+func (h *LogsHandler) writePlainText(w http.ResponseWriter, status int, result *openobserve.ComponentLogsResult) {
+	w.Header().Set("Content-Type", plainTextContentType+"; charset=utf-8")
+	w.WriteHeader(status)
+	bw := bufio.NewWriter(w)
+	for i := range result.Logs {
+		if _, err := bw.WriteString(formatPlainTextLog(&result.Logs[i])); err != nil {
+			h.logger.Error("Failed to write plain-text response", slog.Any("error", err))
+			return
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		h.logger.Error("Failed to write plain-text response", slog.Any("error", err))
+	}
+}
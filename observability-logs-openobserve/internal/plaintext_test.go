@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestFormatPlainTextLog(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 123000000, time.FixedZone("IST", 19800))
+	tests := []struct {
+		name  string
+		entry openobserve.ComponentLogsEntry
+		want  string
+	}{
+		{
+			"complete",
+			openobserve.ComponentLogsEntry{Timestamp: ts, LogLevel: "ERROR", ComponentName: "checkout", PodName: "checkout-1", Log: "payment failed\n"},
+			"2025-01-01T06:30:00.123000Z ERROR [checkout/checkout-1] payment failed\n",
+		},
+		{
+			"missing fields",
+			openobserve.ComponentLogsEntry{Timestamp: ts, ComponentUID: "comp-1", Log: "hello"},
+			"2025-01-01T06:30:00.123000Z - [comp-1/-] hello\n",
+		},
+		{
+			"multi-line",
+			openobserve.ComponentLogsEntry{Timestamp: ts, LogLevel: "ERROR", ComponentName: "checkout", PodName: "checkout-1", Log: "panic: boom\ngoroutine 1:\nmain.main()"},
+			"2025-01-01T06:30:00.123000Z ERROR [checkout/checkout-1] panic: boom\n\tgoroutine 1:\n\tmain.main()\n",
+		},
+	}
+	for _, tt := range tests {
+		if got := formatPlainTextLog(&tt.entry); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSearchLogs_PlainText(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(body.Query.SQL, "SELECT count(*)") {
+			json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{"total": 2}}})
+			return
+		}
+		hit := func(offset time.Duration, log string) map[string]interface{} {
+			return map[string]interface{}{
+				"_timestamp":          ts.Add(offset).UnixMicro(),
+				"log":                 log,
+				"logLevel":            "INFO",
+				"kubernetes_pod_name": "checkout-1",
+				"kubernetes_labels_openchoreo_dev_component": "checkout",
+			}
+		}
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{hit(0, "first"), hit(time.Second, "second")}})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","sortOrder":"asc"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	req.Header.Set("Accept", "text/plain")
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; charset=utf-8" {
+		t.Errorf("expected a plain-text content type, got %q", ct)
+	}
+	want := "2025-01-01T12:00:00.000000Z INFO [checkout/checkout-1] first\n" +
+		"2025-01-01T12:00:01.000000Z INFO [checkout/checkout-1] second\n"
+	if rec.Body.String() != want {
+		t.Errorf("unexpected body:\n%s", rec.Body.String())
+	}
+
+	// JSON stays the default.
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	req.Header.Set("Accept", "*/*")
+	rec = httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+		t.Errorf("expected JSON for a wildcard Accept, got %q", ct)
+	}
+}
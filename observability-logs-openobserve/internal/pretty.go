@@ -0,0 +1,79 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// prettyJSONMiddleware indents the JSON responses of requests asking for it with the
+// "pretty=true" query parameter or a "pretty=true" parameter on an application/json Accept
+// header, for people reading responses in a terminal. Responses are compact otherwise.
+// Streaming routes are left alone.
+func prettyJSONMiddleware(next http.Handler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pretty, ok := wantsPrettyJSON(r)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+				Title:   ptr(gen.BadRequest),
+				Message: ptr("pretty must be true or false"),
+			}); err != nil {
+				logger.Error("Failed to write response", slog.Any("error", err))
+			}
+			return
+		}
+		if !pretty || isLongRunningRoute(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bw := &bufferedResponseWriter{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(bw, r)
+
+		body := bw.body.Bytes()
+		if mediaType, _, _ := mime.ParseMediaType(bw.header.Get("Content-Type")); mediaType == "application/json" {
+			var indented bytes.Buffer
+			if err := json.Indent(&indented, body, "", "  "); err == nil {
+				body = indented.Bytes()
+				bw.header.Del("Content-Length")
+			}
+		}
+		for k, v := range bw.header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(bw.status)
+		if _, err := w.Write(body); err != nil {
+			logger.Error("Failed to write response", slog.Any("error", err))
+		}
+	})
+}
+
+// wantsPrettyJSON reports whether r asks for indented JSON. The query parameter takes
+// precedence over the Accept header; ok is false for an invalid query parameter value.
+func wantsPrettyJSON(r *http.Request) (pretty, ok bool) {
+	if value := r.URL.Query().Get("pretty"); value != "" {
+		pretty, err := strconv.ParseBool(value)
+		return pretty, err == nil
+	}
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil || mediaType != "application/json" {
+			continue
+		}
+		if pretty, err := strconv.ParseBool(params["pretty"]); err == nil && pretty {
+			return true, true
+		}
+	}
+	return false, true
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrettyJSONMiddleware(t *testing.T) {
+	handler := prettyJSONMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/text" {
+			w.Header().Set("Content-Type", "text/plain")
+		} else {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"logs":[{"log":"a"}],"total":1}` + "\n"))
+	}), testLogger())
+
+	const compact = `{"logs":[{"log":"a"}],"total":1}` + "\n"
+	const indented = "{\n  \"logs\": [\n    {\n      \"log\": \"a\"\n    }\n  ],\n  \"total\": 1\n}\n"
+	tests := []struct {
+		name   string
+		target string
+		accept string
+		want   string
+	}{
+		{"compact by default", "/api/v1/logs/search", "", compact},
+		{"query parameter", "/api/v1/logs/search?pretty=true", "", indented},
+		{"query parameter disabled", "/api/v1/logs/search?pretty=false", "application/json; pretty=true", compact},
+		{"accept header", "/api/v1/logs/search", "text/html, application/json; pretty=true", indented},
+		{"accept header without pretty", "/api/v1/logs/search", "application/json", compact},
+		{"not JSON", "/text?pretty=1", "", compact},
+		{"streaming route", "/api/v1/logs/export?pretty=true", "", compact},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.target, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusCreated {
+				t.Errorf("expected 201, got %d", rec.Code)
+			}
+			if rec.Body.String() != tt.want {
+				t.Errorf("expected body %q, got %q", tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPrettyJSONMiddleware_InvalidValue(t *testing.T) {
+	handler := prettyJSONMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler should not be called")
+	}), testLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/logs/search?pretty=yes", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
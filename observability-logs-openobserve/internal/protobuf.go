@@ -0,0 +1,102 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// protobufContentType is the media type of protobuf-encoded responses.
+const protobufContentType = "application/x-protobuf"
+
+// acceptsProtobuf reports whether the Accept header of r explicitly asks for a protobuf
+// response. Wildcards select JSON, which stays the default.
+func acceptsProtobuf(r *http.Request) bool {
+	return acceptsMediaType(r, protobufContentType)
+}
+
+// acceptsMediaType reports whether the Accept header of r explicitly lists mediaType.
+func acceptsMediaType(r *http.Request, mediaType string) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(accept, ",") {
+			accepted, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil || accepted != mediaType {
+				continue
+			}
+			// An explicit q=0 means the client refuses the media type.
+			if q, ok := params["q"]; ok {
+				if v, err := strconv.ParseFloat(q, 64); err == nil && v <= 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// toProtoLogsResult converts a component log query result to its protobuf message. The
+// debug details and facets have no protobuf encoding and are left out.
+func toProtoLogsResult(result *openobserve.ComponentLogsResult) *pb.ComponentLogsResult {
+	logs := make([]*pb.ComponentLogsEntry, len(result.Logs))
+	for i := range result.Logs {
+		logs[i] = toProtoLogEntry(&result.Logs[i])
+	}
+	return &pb.ComponentLogsResult{
+		Logs:         logs,
+		TotalCount:   int64(result.TotalCount),
+		Took:         int64(result.Took),
+		NearLimit:    result.NearLimit,
+		Stream:       result.Stream,
+		BeforeCursor: result.BeforeCursor,
+		AfterCursor:  result.AfterCursor,
+	}
+}
+
+// toProtoLogEntry converts a component log entry to its protobuf message.
+func toProtoLogEntry(entry *openobserve.ComponentLogsEntry) *pb.ComponentLogsEntry {
+	return &pb.ComponentLogsEntry{
+		TimestampMicros: entry.Timestamp.UnixMicro(),
+		Log:             entry.Log,
+		LogLevel:        entry.LogLevel,
+		ComponentUid:    entry.ComponentUID,
+		ComponentName:   entry.ComponentName,
+		EnvironmentUid:  entry.EnvironmentUID,
+		EnvironmentName: entry.EnvironmentName,
+		ProjectUid:      entry.ProjectUID,
+		ProjectName:     entry.ProjectName,
+		Namespace:       entry.Namespace,
+		PodName:         entry.PodName,
+		PodId:           entry.PodID,
+		PodNamespace:    entry.PodNamespace,
+		ContainerName:   entry.ContainerName,
+		Stream:          entry.Stream,
+		RawLog:          entry.RawLog,
+	}
+}
+
+// writeProtobuf writes m as a protobuf response with the given status code.
+func (h *LogsHandler) writeProtobuf(w http.ResponseWriter, status int, m proto.Message) {
+	body, err := proto.Marshal(m)
+	if err != nil {
+		h.logger.Error("Failed to encode protobuf response", slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	w.Header().Set("Content-Type", protobufContentType)
+	w.WriteHeader(status)
+	if _, err := w.Write(body); err != nil {
+		h.logger.Error("Failed to write protobuf response", slog.Any("error", err))
+	}
+}
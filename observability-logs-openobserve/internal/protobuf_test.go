@@ -0,0 +1,91 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/pb"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestAcceptsProtobuf(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"application/json", false},
+		{"*/*", false},
+		{"application/x-protobuf", true},
+		{"application/json;q=0.5, application/x-protobuf", true},
+		{"application/x-protobuf;q=0", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", nil)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+		if got := acceptsProtobuf(r); got != tt.want {
+			t.Errorf("acceptsProtobuf(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestSearchLogs_Protobuf(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(body.Query.SQL, "SELECT count(*)") {
+			json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{"total": 1}}})
+			return
+		}
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{Hits: []map[string]interface{}{{
+			"_timestamp":          ts.UnixMicro(),
+			"log":                 "hello",
+			"logLevel":            "INFO",
+			"kubernetes_pod_name": "checkout-1",
+		}}})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	req.Header.Set("Accept", "application/x-protobuf")
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-protobuf" {
+		t.Errorf("expected a protobuf content type, got %q", ct)
+	}
+	var result pb.ComponentLogsResult
+	if err := proto.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid protobuf response: %v", err)
+	}
+	if result.GetTotalCount() != 1 || len(result.GetLogs()) != 1 {
+		t.Fatalf("unexpected result: %v", &result)
+	}
+	entry := result.GetLogs()[0]
+	if entry.GetLog() != "hello" || entry.GetLogLevel() != "INFO" || entry.GetPodName() != "checkout-1" || entry.GetTimestampMicros() != ts.UnixMicro() {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+}
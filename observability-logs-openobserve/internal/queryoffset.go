@@ -0,0 +1,81 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// queryLogsPath is the route of the QueryLogs operation.
+const queryLogsPath = "/api/v1/logs/query"
+
+type queryOffsetKey struct{}
+
+// queryOffsetMiddleware reads the "offset" of POST /api/v1/logs/query bodies and stores it in
+// the request context (see queryOffsetFromContext). Offset pagination is an extension of this
+// adapter that the shared adapter API, and so the generated request type, does not have. The
+// body is left in place for the generated handler, which reports malformed JSON itself.
+func queryOffsetMiddleware(next http.Handler, h *LogsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != queryLogsPath || r.Body == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "failed to read request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(body, &fields) != nil || fields["offset"] == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		var offset int
+		if err := json.Unmarshal(fields["offset"], &offset); err != nil {
+			h.writeError(w, http.StatusBadRequest, gen.BadRequest, "offset must be an integer")
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withQueryOffset(r.Context(), offset)))
+	})
+}
+
+// withQueryOffset returns a copy of ctx carrying the offset of a logs query.
+func withQueryOffset(ctx context.Context, offset int) context.Context {
+	return context.WithValue(ctx, queryOffsetKey{}, offset)
+}
+
+// queryOffsetFromContext returns the offset of the logs query read by queryOffsetMiddleware,
+// and whether the request set one.
+func queryOffsetFromContext(ctx context.Context) (int, bool) {
+	offset, ok := ctx.Value(queryOffsetKey{}).(int)
+	return offset, ok
+}
+
+// componentQueryLogsResponse is a QueryLogs 200 response for component logs. It carries the
+// offset of the page and the offset of the next one besides the fields of
+// gen.LogsQueryResponse; the entries are gen.ComponentLogEntry values, or the objects an
+// entryFieldMapper rewrote them into.
+type componentQueryLogsResponse struct {
+	Logs       interface{} `json:"logs"`
+	Offset     int         `json:"offset"`
+	NextOffset *int        `json:"nextOffset,omitempty"`
+	TookMs     *int        `json:"tookMs,omitempty"`
+	Total      *int        `json:"total,omitempty"`
+}
+
+func (response componentQueryLogsResponse) VisitQueryLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// DefaultResultTTL is how long a persisted query result is served when no TTL is configured.
+const DefaultResultTTL = 24 * time.Hour
+
+// resultCleanupInterval is how often expired persisted query results are deleted.
+const resultCleanupInterval = 10 * time.Minute
+
+// resultsPath is the path under which persisted query results are served.
+const resultsPath = "/api/v1/logs/results/"
+
+// persistedResult describes a query result stored for sharing.
+type persistedResult struct {
+	Token     string    `json:"token"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// resultStore keeps query results as JSON files in a directory, named by an unguessable
+// token, until they expire. The directory can be a volume shared by the adapter replicas.
+type resultStore struct {
+	dir string
+	ttl time.Duration
+}
+
+// newResultStore returns a store in dir, or nil if dir is empty, which disables persisting
+// results.
+func newResultStore(dir string, ttl time.Duration) *resultStore {
+	if dir == "" {
+		return nil
+	}
+	if ttl <= 0 {
+		ttl = DefaultResultTTL
+	}
+	return &resultStore{dir: dir, ttl: ttl}
+}
+
+// save stores v and returns the token under which it is served until it expires. The file is
+// written under a temporary name first so that it is never served half-written.
+func (s *resultStore) save(v interface{}, now time.Time) (persistedResult, error) {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return persistedResult{}, fmt.Errorf("failed to create results directory: %w", err)
+	}
+	f, err := os.CreateTemp(s.dir, ".result-*")
+	if err != nil {
+		return persistedResult{}, fmt.Errorf("failed to create result file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if err := json.NewEncoder(f).Encode(v); err != nil {
+		f.Close()
+		return persistedResult{}, fmt.Errorf("failed to write result: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return persistedResult{}, fmt.Errorf("failed to write result: %w", err)
+	}
+	// The modification time records when the result was stored, from which it expires.
+	if err := os.Chtimes(f.Name(), now, now); err != nil {
+		return persistedResult{}, fmt.Errorf("failed to write result: %w", err)
+	}
+
+	token := newOperationID()
+	if err := os.Rename(f.Name(), s.path(token)); err != nil {
+		return persistedResult{}, fmt.Errorf("failed to store result: %w", err)
+	}
+	return persistedResult{
+		Token:     token,
+		URL:       resultsPath + token,
+		ExpiresAt: now.Add(s.ttl).UTC(),
+	}, nil
+}
+
+// open returns the stored result with the given token and when it expires. It returns an
+// error satisfying errors.Is(err, os.ErrNotExist) if there is no such result or it expired.
+func (s *resultStore) open(token string, now time.Time) (*os.File, time.Time, error) {
+	if !validResultToken(token) {
+		return nil, time.Time{}, os.ErrNotExist
+	}
+	f, err := os.Open(s.path(token))
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, time.Time{}, err
+	}
+	expiresAt := info.ModTime().Add(s.ttl)
+	if !now.Before(expiresAt) {
+		f.Close()
+		return nil, time.Time{}, os.ErrNotExist
+	}
+	return f, expiresAt, nil
+}
+
+// removeExpired deletes the results that expired by now, as well as leftovers of results
+// that failed to be stored. It returns the number of files removed.
+func (s *resultStore) removeExpired(now time.Time) (int, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".json") || strings.HasPrefix(name, ".result-")) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if now.Before(info.ModTime().Add(s.ttl)) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.dir, name)); err == nil {
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (s *resultStore) path(token string) string {
+	return filepath.Join(s.dir, token+".json")
+}
+
+// validResultToken reports whether token has the form of a generated token, which also keeps
+// it from addressing files outside the results directory.
+func validResultToken(token string) bool {
+	if len(token) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(token)
+	return err == nil
+}
+
+// CleanupResults periodically deletes the expired persisted query results until ctx is done.
+// It returns immediately if persisting results is disabled.
+func (h *LogsHandler) CleanupResults(ctx context.Context) {
+	if h.results == nil {
+		return
+	}
+	ticker := time.NewTicker(resultCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		removed, err := h.results.removeExpired(time.Now())
+		if err != nil {
+			h.logger.Warn("Failed to clean up persisted query results", slog.Any("error", err))
+			continue
+		}
+		if removed > 0 {
+			h.logger.Debug("Removed expired persisted query results", slog.Int("count", removed))
+		}
+	}
+}
+
+// persistResult stores result for sharing and responds with its token and URL, or writes an
+// error response if it cannot be stored.
+func (h *LogsHandler) persistResult(w http.ResponseWriter, result interface{}) {
+	persisted, err := h.results.save(h.fieldMapper.mapResponse(result), time.Now())
+	if err != nil {
+		h.logger.Error("Failed to persist query result", slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	w.Header().Set("Location", persisted.URL)
+	h.writeJSON(w, http.StatusCreated, persisted)
+}
+
+// GetPersistedResult implements GET /api/v1/logs/results/{token}.
+// It serves a query result persisted with "persist=true" until it expires, and responds
+// with 404 afterwards.
+func (h *LogsHandler) GetPersistedResult(w http.ResponseWriter, r *http.Request) {
+	if h.results == nil {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "result not found")
+		return
+	}
+	f, expiresAt, err := h.results.open(r.PathValue("token"), time.Now())
+	if errors.Is(err, os.ErrNotExist) {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "result not found")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to open persisted query result", slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	defer f.Close()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Expires", expiresAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, f); err != nil {
+		h.logger.Error("Failed to write persisted query result", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestSearchLogs_PersistResult(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{
+				{"_timestamp": float64(1735732800000000), "log": "shared line", "total": float64(1)},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{ResultsDir: t.TempDir()}, testLogger())
+	mux := http.NewServeMux()
+	handler.registerRoutes(mux)
+
+	body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search?persist=true", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var persisted persistedResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &persisted); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if persisted.URL != "/api/v1/logs/results/"+persisted.Token || rec.Header().Get("Location") != persisted.URL {
+		t.Errorf("unexpected URL %q (Location %q)", persisted.URL, rec.Header().Get("Location"))
+	}
+	if d := time.Until(persisted.ExpiresAt); d < 23*time.Hour || d > DefaultResultTTL {
+		t.Errorf("expected the result to expire after the default TTL, got %v", persisted.ExpiresAt)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, persisted.URL, nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result openobserve.ComponentLogsResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if len(result.Logs) != 1 || result.Logs[0].Log != "shared line" {
+		t.Errorf("unexpected logs: %+v", result.Logs)
+	}
+	if rec.Header().Get("Expires") == "" {
+		t.Error("expected an Expires header")
+	}
+
+	for _, token := range []string{"00000000000000000000000000000000", "..%2F..%2Fetc%2Fpasswd", "not-a-token"} {
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/logs/results/"+token, nil)
+		rec = httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("token %q: expected 404, got %d", token, rec.Code)
+		}
+	}
+}
+
+func TestSearchLogs_PersistResultDisabled(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	for query, want := range map[string]string{
+		"persist=true":  "persisting results is not enabled",
+		"persist=maybe": "persist must be true or false",
+	} {
+		body := `{"namespace":"test-ns","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search?"+query, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.SearchLogs(rec, req)
+
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("%s: expected 400 with %q, got %d: %s", query, want, rec.Code, rec.Body.String())
+		}
+	}
+}
+
+func TestResultStore_Expiry(t *testing.T) {
+	dir := t.TempDir()
+	store := newResultStore(dir, time.Hour)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	old, err := store.save(map[string]string{"log": "old"}, now.Add(-2*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fresh, err := store.save(map[string]string{"log": "fresh"}, now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := store.open(old.Token, now); !os.IsNotExist(err) {
+		t.Errorf("expected the expired result to be gone, got %v", err)
+	}
+	f, expiresAt, err := store.open(fresh.Token, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.Close()
+	if !expiresAt.Equal(now.Add(59 * time.Minute)) {
+		t.Errorf("expected the result to expire at %v, got %v", now.Add(59*time.Minute), expiresAt)
+	}
+
+	// A leftover of a result that failed to be stored is cleaned up as well.
+	leftover := filepath.Join(dir, ".result-123")
+	if err := os.WriteFile(leftover, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(leftover, now.Add(-2*time.Hour), now.Add(-2*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := store.removeExpired(now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if removed != 2 {
+		t.Errorf("expected 2 files removed, got %d", removed)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 || entries[0].Name() != fresh.Token+".json" {
+		t.Errorf("expected only the fresh result to remain, got %v", entries)
+	}
+
+	if newResultStore("", time.Hour) != nil {
+		t.Error("expected no store without a directory")
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// registerRoutes registers the OpenObserve-specific endpoints that are not part of the
+// shared logs adapter API spec. They are served from the same mux as the generated routes.
+func (h *LogsHandler) registerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/v1/logs/search", h.SearchLogs)
+	mux.HandleFunc("POST /api/v1/logs/_search", h.ElasticsearchSearch)
+	mux.HandleFunc("POST /api/v1/logs/count", h.CountLogs)
+	mux.HandleFunc("POST /api/v1/logs/correlate", h.CorrelateLogs)
+	mux.HandleFunc("POST /api/v1/admin/logs/search", h.SearchLogsAcrossOrgs)
+	mux.HandleFunc("POST /api/v1/logs/explore", h.QueryExplore)
+	mux.HandleFunc("POST /api/v1/logs/volume", h.QueryLogVolume)
+	mux.HandleFunc("POST /api/v1/logs/distinct", h.QueryDistinctLogMessages)
+	mux.HandleFunc("POST /api/v1/logs/percentiles", h.QueryComponentPercentiles)
+	mux.HandleFunc("POST /api/v1/logs/aggregate", h.QueryGroupedAggregation)
+	mux.HandleFunc("POST /api/v1/logs/pods", h.QueryComponentPods)
+	mux.HandleFunc("GET /api/v1/logs/schema", h.GetStreamSchema)
+	mux.HandleFunc("GET /api/v1/logs/stream", h.StreamLogs)
+	mux.HandleFunc("POST /api/v1/logs/export", h.ExportLogs)
+	mux.HandleFunc("POST /api/v1/logs/export/s3", h.ExportLogsToBucket)
+	mux.HandleFunc("GET /api/v1/logs/results/{token}", h.GetPersistedResult)
+	mux.HandleFunc("GET /api/v1/logs/templates", h.ListQueryTemplates)
+	mux.HandleFunc("GET /api/v1/logs/templates/{name}", h.GetQueryTemplate)
+	mux.HandleFunc("PUT /api/v1/logs/templates/{name}", h.PutQueryTemplate)
+	mux.HandleFunc("DELETE /api/v1/logs/templates/{name}", h.DeleteQueryTemplate)
+	mux.HandleFunc("POST /api/v1/logs/templates/{name}/run", h.RunQueryTemplate)
+	mux.HandleFunc("POST /api/v1/logs/{id}/cancel", h.CancelOperation)
+	mux.HandleFunc("GET /api/v1/diagnostics/openobserve", h.UpstreamDiagnostics)
+	mux.HandleFunc("GET /api/v1/diagnostics/streams", h.StreamDiagnostics)
+	mux.HandleFunc("GET /api/v1alpha1/alerts/rules", h.ListAlertRules)
+	mux.HandleFunc("POST /api/v1alpha1/alerts/rules/batch", h.CreateAlertRules)
+	mux.HandleFunc("GET /api/v1alpha1/alerts/rules/{ruleName}/stream", h.StreamAlertLogs)
+	mux.HandleFunc("GET /api/v1alpha1/alerts/rules/{ruleName}/evidence", h.GetAlertEvidence)
+	mux.HandleFunc("POST /api/v1alpha1/alerts/rules/{ruleName}/validate", h.ValidateAlertRule)
+	mux.HandleFunc("GET /loki/api/v1/query_range", h.LokiQueryRange)
+	mux.HandleFunc("GET /loki/api/v1/labels", h.LokiLabels)
+}
+
+// decodeJSONBody decodes the JSON request body into v. A malformed timestamp is reported as
+// a *TimeParamError naming the field.
+func decodeJSONBody(r *http.Request, v interface{}) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(v); err != nil {
+		if timeErr := findTimeParamError(body, v); timeErr != nil {
+			return timeErr
+		}
+		return err
+	}
+	return nil
+}
+
+// writeJSON writes v as a JSON response with the given status code. The log entries of
+// query results are written with the configured field mapping (see mapResponse).
+func (h *LogsHandler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(h.fieldMapper.mapResponse(v)); err != nil {
+		h.logger.Error("Failed to write JSON response", slog.Any("error", err))
+	}
+}
+
+// writeError writes an error response using the same shape as the generated endpoints.
+func (h *LogsHandler) writeError(w http.ResponseWriter, status int, title gen.ErrorResponseTitle, message string) {
+	h.writeJSON(w, status, gen.ErrorResponse{
+		Title:   ptr(title),
+		Message: ptr(message),
+	})
+}
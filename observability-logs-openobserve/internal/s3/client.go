@@ -0,0 +1,298 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package s3 uploads objects to Amazon S3 or an S3-compatible object store such as MinIO.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRegion is the signing region used when none is configured. S3-compatible stores
+// typically accept any region.
+const DefaultRegion = "us-east-1"
+
+// DefaultPartSize is the size of the parts in which objects are uploaded. Only one part is
+// held in memory at a time.
+const DefaultPartSize = 8 << 20
+
+// Config configures the bucket objects are uploaded to.
+type Config struct {
+	// Endpoint is the base URL of the object store, e.g. https://s3.eu-west-1.amazonaws.com or
+	// http://minio.minio:9000. Buckets are addressed path-style, as <endpoint>/<bucket>/<key>.
+	Endpoint string
+	// Region is the region requests are signed for. Empty selects DefaultRegion.
+	Region string
+	// Bucket is the bucket objects are uploaded to.
+	Bucket string
+	// Prefix is prepended to the keys of uploaded objects, e.g. "logs/".
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// UploadOptions sets the metadata of an uploaded object.
+type UploadOptions struct {
+	ContentType     string
+	ContentEncoding string
+}
+
+// Client uploads objects to a bucket, signing its requests with AWS Signature Version 4.
+type Client struct {
+	endpoint   *url.URL
+	region     string
+	bucket     string
+	prefix     string
+	accessKey  string
+	secretKey  string
+	partSize   int
+	now        func() time.Time
+	httpClient *http.Client
+}
+
+// NewClient returns a client for the bucket described by cfg.
+func NewClient(cfg Config) (*Client, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: must be an http or https URL", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+	if cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access key ID and secret access key are required")
+	}
+	region := cfg.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+	return &Client{
+		endpoint:  endpoint,
+		region:    region,
+		bucket:    cfg.Bucket,
+		prefix:    cfg.Prefix,
+		accessKey: cfg.AccessKeyID,
+		secretKey: cfg.SecretAccessKey,
+		partSize:  DefaultPartSize,
+		now:       time.Now,
+		// Uploads last as long as the data keeps coming; the caller's context bounds them.
+		httpClient: &http.Client{},
+	}, nil
+}
+
+// Bucket returns the name of the bucket objects are uploaded to.
+func (c *Client) Bucket() string {
+	return c.bucket
+}
+
+// Key returns the full key of the object named name, i.e. with the configured prefix.
+func (c *Client) Key(name string) string {
+	return c.prefix + name
+}
+
+// Upload streams body into the object with the given key (see Key) and returns the number of
+// bytes uploaded. Bodies that fit into a single part are uploaded with one request; larger
+// ones are uploaded part by part with a multipart upload, which is aborted if the upload or
+// reading body fails, so that no partial object is left behind.
+func (c *Client) Upload(ctx context.Context, key string, body io.Reader, opts UploadOptions) (int64, error) {
+	buf := make([]byte, c.partSize)
+	n, err := io.ReadFull(body, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return int64(n), c.putObject(ctx, key, buf[:n], opts)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	uploadID, err := c.createMultipartUpload(ctx, key, opts)
+	if err != nil {
+		return 0, err
+	}
+	size, err := c.uploadParts(ctx, key, uploadID, body, buf)
+	if err != nil {
+		// The upload is aborted even if the caller gave up.
+		abortCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+		if abortErr := c.abortMultipartUpload(abortCtx, key, uploadID); abortErr != nil {
+			err = errors.Join(err, abortErr)
+		}
+		return 0, err
+	}
+	return size, nil
+}
+
+// completedPart is a part of a multipart upload, as listed when completing it.
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// uploadParts uploads the part already read into buf and the rest of body, then completes the
+// multipart upload.
+func (c *Client) uploadParts(ctx context.Context, key, uploadID string, body io.Reader, buf []byte) (int64, error) {
+	var parts []completedPart
+	var size int64
+	part := buf
+	for {
+		etag, err := c.uploadPart(ctx, key, uploadID, len(parts)+1, part)
+		if err != nil {
+			return 0, err
+		}
+		parts = append(parts, completedPart{PartNumber: len(parts) + 1, ETag: etag})
+		size += int64(len(part))
+
+		n, err := io.ReadFull(body, buf)
+		if n == 0 && errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return 0, err
+		}
+		part = buf[:n]
+	}
+
+	payload, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, payload, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	// The store may report a failure with a 200 response once it has started sending it.
+	if bytes.Contains(resp, []byte("<Error>")) {
+		return 0, fmt.Errorf("failed to complete multipart upload: %s", errorMessage(resp))
+	}
+	return size, nil
+}
+
+func (c *Client) putObject(ctx context.Context, key string, data []byte, opts UploadOptions) error {
+	if _, err := c.do(ctx, http.MethodPut, key, nil, data, opts.headers()); err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) createMultipartUpload(ctx context.Context, key string, opts UploadOptions) (string, error) {
+	resp, err := c.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, opts.headers())
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.Unmarshal(resp, &result); err != nil || result.UploadID == "" {
+		return "", fmt.Errorf("failed to create multipart upload: unexpected response %q", string(resp))
+	}
+	return result.UploadID, nil
+}
+
+func (c *Client) uploadPart(ctx context.Context, key, uploadID string, number int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {uploadID}}
+	req, err := c.newRequest(ctx, http.MethodPut, key, query, data, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", number, err)
+	}
+	return resp.header.Get("ETag"), nil
+}
+
+func (c *Client) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	if _, err := c.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, nil); err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
+
+// do sends a signed request for the object key and returns the response body.
+func (c *Client) do(ctx context.Context, method, key string, query url.Values, body []byte, header http.Header) ([]byte, error) {
+	req, err := c.newRequest(ctx, method, key, query, body, header)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.body, nil
+}
+
+// response is a successful response with its body read.
+type response struct {
+	header http.Header
+	body   []byte
+}
+
+// send sends req and reads the response, turning an error status into an error.
+func (c *Client) send(req *http.Request) (*response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("object store returned status %d: %s", resp.StatusCode, errorMessage(body))
+	}
+	return &response{header: resp.Header, body: body}, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, key string, query url.Values, body []byte, header http.Header) (*http.Request, error) {
+	u := *c.endpoint
+	u.Path = c.endpoint.Path + "/" + c.bucket + "/" + key
+	u.RawPath = c.endpoint.EscapedPath() + "/" + uriEncode(c.bucket, false) + "/" + uriEncode(key, false)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	c.sign(req, body)
+	return req, nil
+}
+
+func (o UploadOptions) headers() http.Header {
+	header := make(http.Header)
+	if o.ContentType != "" {
+		header.Set("Content-Type", o.ContentType)
+	}
+	if o.ContentEncoding != "" {
+		header.Set("Content-Encoding", o.ContentEncoding)
+	}
+	return header
+}
+
+// errorMessage extracts the code and message of an S3 error response, falling back to the
+// raw body.
+func errorMessage(body []byte) string {
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.Unmarshal(body, &s3Err); err == nil && s3Err.Code != "" {
+		return s3Err.Code + ": " + s3Err.Message
+	}
+	return strings.TrimSpace(string(body))
+}
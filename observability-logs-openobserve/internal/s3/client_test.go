@@ -0,0 +1,226 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeStore is an in-memory S3-compatible object store serving single and multipart uploads.
+type fakeStore struct {
+	t        *testing.T
+	mu       sync.Mutex
+	requests []string
+	objects  map[string][]byte
+	headers  map[string]http.Header
+	uploads  map[string][][]byte
+	aborted  []string
+}
+
+func newFakeStore(t *testing.T) (*fakeStore, *httptest.Server) {
+	store := &fakeStore{
+		t:       t,
+		objects: make(map[string][]byte),
+		headers: make(map[string]http.Header),
+		uploads: make(map[string][][]byte),
+	}
+	return store, httptest.NewServer(store)
+}
+
+func (s *fakeStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		s.t.Errorf("%s %s: payload hash does not match the body", r.Method, r.URL)
+	}
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20250101/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") {
+		s.t.Errorf("%s %s: unexpected authorization %q", r.Method, r.URL, r.Header.Get("Authorization"))
+	}
+	q := r.URL.Query()
+	key := r.URL.Path
+	s.requests = append(s.requests, r.Method+" "+key+"?"+r.URL.RawQuery)
+
+	switch {
+	case r.Method == http.MethodPut && q.Has("uploadId"):
+		if q.Get("uploadId") != "upload-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.uploads[key] = append(s.uploads[key], body)
+		w.Header().Set("ETag", fmt.Sprintf(`"etag-%s"`, q.Get("partNumber")))
+	case r.Method == http.MethodPut:
+		s.objects[key] = body
+		s.headers[key] = r.Header.Clone()
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		s.headers[key] = r.Header.Clone()
+		w.Write([]byte(`<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`))
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		var complete struct {
+			Parts []completedPart `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			s.t.Errorf("invalid complete request: %v", err)
+		}
+		var object []byte
+		for i, part := range complete.Parts {
+			if part.PartNumber != i+1 || part.ETag != fmt.Sprintf(`"etag-%d"`, i+1) {
+				s.t.Errorf("unexpected part %+v", part)
+			}
+			object = append(object, s.uploads[key][i]...)
+		}
+		s.objects[key] = object
+		w.Write([]byte(`<CompleteMultipartUploadResult><Key>` + key + `</Key></CompleteMultipartUploadResult>`))
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		s.aborted = append(s.aborted, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<Error><Code>InvalidRequest</Code><Message>unexpected request</Message></Error>`))
+	}
+}
+
+func newTestClient(t *testing.T, endpoint string) *Client {
+	t.Helper()
+	client, err := NewClient(Config{
+		Endpoint:        endpoint,
+		Region:          "eu-west-1",
+		Bucket:          "logs",
+		Prefix:          "archive/",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.partSize = 4
+	client.now = func() time.Time { return time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC) }
+	return client
+}
+
+func TestNewClient(t *testing.T) {
+	valid := Config{Endpoint: "http://minio:9000", Bucket: "logs", AccessKeyID: "a", SecretAccessKey: "s"}
+	if _, err := NewClient(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, mutate := range map[string]func(*Config){
+		"endpoint":    func(c *Config) { c.Endpoint = "minio:9000" },
+		"bucket":      func(c *Config) { c.Bucket = "" },
+		"credentials": func(c *Config) { c.SecretAccessKey = "" },
+	} {
+		cfg := valid
+		mutate(&cfg)
+		if _, err := NewClient(cfg); err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
+
+func TestUpload_SinglePart(t *testing.T) {
+	store, server := newFakeStore(t)
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	key := client.Key("ns/logs 1.ndjson")
+	n, err := client.Upload(context.Background(), key, strings.NewReader("abc"), UploadOptions{ContentType: "application/x-ndjson", ContentEncoding: "gzip"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 3 || string(store.objects["/logs/archive/ns/logs 1.ndjson"]) != "abc" {
+		t.Errorf("unexpected object (%d bytes): %v", n, store.objects)
+	}
+	if h := store.headers["/logs/archive/ns/logs 1.ndjson"]; h.Get("Content-Type") != "application/x-ndjson" || h.Get("Content-Encoding") != "gzip" {
+		t.Errorf("unexpected object metadata: %v", h)
+	}
+	if want := []string{"PUT /logs/archive/ns/logs 1.ndjson?"}; fmt.Sprint(store.requests) != fmt.Sprint(want) {
+		t.Errorf("expected requests %q, got %q", want, store.requests)
+	}
+}
+
+func TestUpload_Multipart(t *testing.T) {
+	store, server := newFakeStore(t)
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	// The body arrives in reads that do not line up with the parts.
+	body := io.MultiReader(strings.NewReader("abcde"), strings.NewReader("fghij"))
+	n, err := client.Upload(context.Background(), "k", body, UploadOptions{ContentType: "text/plain"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 10 || string(store.objects["/logs/k"]) != "abcdefghij" {
+		t.Errorf("unexpected object (%d bytes): %q", n, store.objects["/logs/k"])
+	}
+	if parts := store.uploads["/logs/k"]; len(parts) != 3 || string(parts[2]) != "ij" {
+		t.Errorf("unexpected parts: %q", parts)
+	}
+	if store.headers["/logs/k"].Get("Content-Type") != "text/plain" {
+		t.Errorf("expected the content type on the multipart upload, got %v", store.headers["/logs/k"])
+	}
+}
+
+// failingReader returns data and then err.
+type failingReader struct {
+	data *bytes.Reader
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data.Len() == 0 {
+		return 0, r.err
+	}
+	return r.data.Read(p)
+}
+
+func TestUpload_AbortsOnReadError(t *testing.T) {
+	store, server := newFakeStore(t)
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	readErr := errors.New("export failed")
+	body := &failingReader{data: bytes.NewReader([]byte("abcdef")), err: readErr}
+	if _, err := client.Upload(context.Background(), "k", body, UploadOptions{}); !errors.Is(err, readErr) {
+		t.Fatalf("expected the read error, got %v", err)
+	}
+	if len(store.aborted) != 1 || store.objects["/logs/k"] != nil {
+		t.Errorf("expected the multipart upload to be aborted, got objects %v, aborted %v", store.objects, store.aborted)
+	}
+}
+
+func TestUpload_ErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>`))
+	}))
+	defer server.Close()
+	client := newTestClient(t, server.URL)
+
+	_, err := client.Upload(context.Background(), "k", strings.NewReader("a"), UploadOptions{})
+	if err == nil || !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		t.Errorf("expected the store's error, got %v", err)
+	}
+}
+
+func TestURIEncode(t *testing.T) {
+	if got := uriEncode("a b/c~d+é", false); got != "a%20b/c~d%2B%C3%A9" {
+		t.Errorf("unexpected encoding: %s", got)
+	}
+	if got := uriEncode("a/b", true); got != "a%2Fb" {
+		t.Errorf("unexpected encoding: %s", got)
+	}
+}
@@ -8,35 +8,118 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
 	"time"
 
 	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
 )
 
 type Server struct {
-	port       string
-	httpServer *http.Server
-	logger     *slog.Logger
+	port               string
+	httpServer         *http.Server
+	logsHandler        *LogsHandler
+	streamDrainTimeout time.Duration
+	logger             *slog.Logger
+}
+
+// ServerOptions holds the optional settings of a Server.
+type ServerOptions struct {
+	// RequestTimeout caps how long a single request may take before 504 is returned.
+	// Zero disables the limit. Streaming endpoints are not affected.
+	RequestTimeout time.Duration
+	// TrustedProxies are the proxies whose X-Forwarded-For and X-Real-IP headers are used to
+	// attribute requests to clients. Empty attributes requests to the direct peer.
+	TrustedProxies []netip.Prefix
+	// Auth protects every endpoint except the health check with bearer tokens or HMAC
+	// request signatures. The zero value leaves the API unauthenticated.
+	Auth APIAuth
+	// DisableAlerts answers every alert endpoint with 403, for read-only deployments that
+	// only query logs.
+	DisableAlerts bool
+	// EnforceScanBudgets answers the query endpoints with 429 while the organization's queries
+	// have used up their scan budget (see openobserve.ClientOptions.ScanBudgets).
+	EnforceScanBudgets bool
+	// StreamDrainTimeout is how long Shutdown waits for the open log streams to close after
+	// sending them a final "close" event. Defaults to DefaultStreamDrainTimeout.
+	StreamDrainTimeout time.Duration
+	// MaxStreams caps the number of log streams open at once; further stream requests are
+	// answered with 503 and a Retry-After header. Zero means no limit.
+	MaxStreams int
+	// Tenants requires every request except the health check and the admin endpoints to name
+	// a configured tenant, and scopes its queries to the tenant. The zero value disables it.
+	Tenants TenantSettings
 }
 
 func NewServer(port string, logsHandler *LogsHandler, logger *slog.Logger) *Server {
-	strictHandler := gen.NewStrictHandler(logsHandler, nil)
+	return NewServerWithOptions(port, logsHandler, ServerOptions{}, logger)
+}
+
+// NewServerWithOptions constructs a Server with the given optional settings.
+func NewServerWithOptions(port string, logsHandler *LogsHandler, opts ServerOptions, logger *slog.Logger) *Server {
+	strictHandler := gen.NewStrictHandlerWithOptions(logsHandler, nil, gen.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: logsHandler.handleRequestError,
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	})
 
 	mux := http.NewServeMux()
 	handler := gen.HandlerFromMux(strictHandler, mux)
+	handler = queryOffsetMiddleware(handler, logsHandler)
+	logsHandler.registerRoutes(mux)
+	logsHandler.streams.setLimit(opts.MaxStreams)
+
+	if opts.DisableAlerts {
+		handler = alertsDisabledMiddleware(handler, logger)
+	}
+	if opts.EnforceScanBudgets {
+		handler = scanBudgetMiddleware(handler, logsHandler, logger)
+	}
+
+	writeTimeout := 15 * time.Second
+	if opts.RequestTimeout > 0 {
+		handler = requestTimeoutMiddleware(handler, opts.RequestTimeout, logger)
+		// Leave room to deliver the 504 response once the request timeout fires.
+		if opts.RequestTimeout+5*time.Second > writeTimeout {
+			writeTimeout = opts.RequestTimeout + 5*time.Second
+		}
+	}
+
+	handler = prettyJSONMiddleware(handler, logger)
+
+	if opts.Tenants.Enabled() {
+		var defaultOrg string
+		if logsHandler.client != nil {
+			defaultOrg = logsHandler.client.Org()
+		}
+		handler = tenantMiddleware(handler, opts.Tenants.withOrgOwnership(defaultOrg), logger)
+	}
+
+	handler = adminAuthMiddleware(handler, opts.Auth.AdminTokens, logger)
+	if opts.Auth.Enabled() {
+		handler = authMiddleware(handler, opts.Auth, logger)
+	}
+	handler = clientIPMiddleware(handler, opts.TrustedProxies)
 
 	httpServer := &http.Server{
 		Addr:         ":" + port,
 		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
-		WriteTimeout: 15 * time.Second,
+		WriteTimeout: writeTimeout,
 		IdleTimeout:  60 * time.Second,
 	}
 
+	streamDrainTimeout := opts.StreamDrainTimeout
+	if streamDrainTimeout <= 0 {
+		streamDrainTimeout = DefaultStreamDrainTimeout
+	}
+
 	return &Server{
-		port:       port,
-		httpServer: httpServer,
-		logger:     logger,
+		port:               port,
+		httpServer:         httpServer,
+		logsHandler:        logsHandler,
+		streamDrainTimeout: streamDrainTimeout,
+		logger:             logger,
 	}
 }
 
@@ -48,7 +131,11 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// Shutdown closes the open log streams gracefully, within the stream drain timeout, and then
+// stops the HTTP server, waiting for the other requests in flight until ctx is done. The
+// HTTP server would otherwise wait for the streams until ctx is done and then cut them.
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Shutting down server")
+	s.logsHandler.drainStreams(ctx, s.streamDrainTimeout)
 	return s.httpServer.Shutdown(ctx)
 }
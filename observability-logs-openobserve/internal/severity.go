@@ -0,0 +1,119 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// DefaultSeverityLevels is the default severity scale, ordered from least to most severe.
+var DefaultSeverityLevels = []string{"DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// ParseSeverityLevels parses a comma-separated severity scale ordered from least to most
+// severe (e.g. "TRACE,DEBUG,INFO,WARN,ERROR,FATAL").
+func ParseSeverityLevels(value string) ([]string, error) {
+	var levels []string
+	seen := make(map[string]bool)
+	for _, level := range strings.Split(value, ",") {
+		level = strings.ToUpper(strings.TrimSpace(level))
+		if level == "" {
+			continue
+		}
+		if seen[level] {
+			return nil, fmt.Errorf("severity level %q is listed more than once", level)
+		}
+		seen[level] = true
+		levels = append(levels, level)
+	}
+	if len(levels) == 0 {
+		return nil, fmt.Errorf("at least one severity level is required")
+	}
+	return levels, nil
+}
+
+// levelsAtOrAbove returns the levels of the scale at or above minLevel, or false if
+// minLevel is not part of the scale.
+func levelsAtOrAbove(scale []string, minLevel string) ([]string, bool) {
+	minLevel = strings.ToUpper(strings.TrimSpace(minLevel))
+	for i, level := range scale {
+		if level == minLevel {
+			return append([]string(nil), scale[i:]...), true
+		}
+	}
+	return nil, false
+}
+
+// resolveMinLevel expands params.MinLevel into the equivalent LogLevels filter, or checks
+// params.LogLevels against the accepted levels. It returns a user-facing message describing
+// the problem if the level filters are invalid, or "".
+func (h *LogsHandler) resolveMinLevel(params *openobserve.ComponentLogsParams) string {
+	if params.MinLevel == "" {
+		return h.validateLogLevels(params.LogLevels)
+	}
+	if len(params.LogLevels) > 0 {
+		return "logLevels and minLevel cannot be used together"
+	}
+	levels, ok := levelsAtOrAbove(h.severityLevels, params.MinLevel)
+	if !ok {
+		return fmt.Sprintf("minLevel must be one of %s", strings.Join(h.severityLevels, ", "))
+	}
+	params.LogLevels = levels
+	params.MinLevel = ""
+	return ""
+}
+
+// validateLogLevels checks a logLevels filter against the accepted levels, which are matched
+// exactly, as by the query. Unknown levels would silently match nothing, so they are
+// rejected with a user-facing message, or only logged when lenient validation is enabled.
+func (h *LogsHandler) validateLogLevels(levels []string) string {
+	var unknown []string
+	for _, level := range levels {
+		if !slices.Contains(h.acceptedLogLevels, level) {
+			unknown = append(unknown, level)
+		}
+	}
+	if len(unknown) == 0 {
+		return ""
+	}
+	if h.lenientLogLevels {
+		h.logger.Warn("Log level filter lists unknown levels",
+			slog.Any("unknownLevels", unknown),
+			slog.Any("acceptedLevels", h.acceptedLogLevels),
+		)
+		return ""
+	}
+	return fmt.Sprintf("unknown log levels %s; logLevels must be among %s",
+		strings.Join(unknown, ", "), strings.Join(h.acceptedLogLevels, ", "))
+}
+
+// ParseSeverityClasses parses a comma-separated list of level=class pairs mapping log levels
+// to display classes (e.g. "FATAL=critical,ERROR=critical,WARN=warning,*=normal"). Levels
+// are matched case-insensitively; "*" classifies the levels not listed.
+func ParseSeverityClasses(value string) (map[string]string, error) {
+	classes := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		level, class, ok := strings.Cut(pair, "=")
+		level, class = strings.ToUpper(strings.TrimSpace(level)), strings.TrimSpace(class)
+		if !ok || level == "" || class == "" {
+			return nil, fmt.Errorf("invalid severity class %q: expected level=class", pair)
+		}
+		if _, dup := classes[level]; dup {
+			return nil, fmt.Errorf("severity level %q is classified more than once", level)
+		}
+		classes[level] = class
+	}
+	if len(classes) == 0 {
+		return nil, fmt.Errorf("at least one severity class is required")
+	}
+	return classes, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestParseSeverityLevels(t *testing.T) {
+	got, err := ParseSeverityLevels(" trace, DEBUG,info ,warn,error,fatal,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	for _, value := range []string{"", " , ", "INFO,WARN,info"} {
+		if _, err := ParseSeverityLevels(value); err == nil {
+			t.Errorf("expected error for %q, got nil", value)
+		}
+	}
+}
+
+func TestParseSeverityClasses(t *testing.T) {
+	got, err := ParseSeverityClasses(" fatal=critical, ERROR = critical,warn=warning,*=normal,")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"FATAL": "critical", "ERROR": "critical", "WARN": "warning", "*": "normal"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	for _, value := range []string{"", " , ", "ERROR", "ERROR=", "=critical", "error=critical,ERROR=warning"} {
+		if _, err := ParseSeverityClasses(value); err == nil {
+			t.Errorf("expected error for %q, got nil", value)
+		}
+	}
+}
+
+func TestResolveMinLevel(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+
+	tests := []struct {
+		name       string
+		params     openobserve.ComponentLogsParams
+		wantLevels []string
+		wantErr    bool
+	}{
+		{"no minLevel", openobserve.ComponentLogsParams{LogLevels: []string{"INFO"}}, []string{"INFO"}, false},
+		{"warn and above", openobserve.ComponentLogsParams{MinLevel: "warn"}, []string{"WARN", "ERROR", "FATAL"}, false},
+		{"lowest level", openobserve.ComponentLogsParams{MinLevel: "DEBUG"}, DefaultSeverityLevels, false},
+		{"unknown level", openobserve.ComponentLogsParams{MinLevel: "NOTICE"}, nil, true},
+		{"combined with logLevels", openobserve.ComponentLogsParams{MinLevel: "WARN", LogLevels: []string{"INFO"}}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			msg := handler.resolveMinLevel(&params)
+			if (msg != "") != tt.wantErr {
+				t.Fatalf("resolveMinLevel() message = %q, wantErr %v", msg, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(params.LogLevels, tt.wantLevels) {
+				t.Errorf("expected levels %v, got %v", tt.wantLevels, params.LogLevels)
+			}
+		})
+	}
+}
+
+func TestResolveMinLevel_CustomScale(t *testing.T) {
+	handler := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{
+		SeverityLevels: []string{"TRACE", "DEBUG", "INFO", "NOTICE", "WARN", "ERROR", "CRITICAL"},
+	}, testLogger())
+
+	params := openobserve.ComponentLogsParams{MinLevel: "NOTICE"}
+	if msg := handler.resolveMinLevel(&params); msg != "" {
+		t.Fatalf("unexpected message: %s", msg)
+	}
+	want := []string{"NOTICE", "WARN", "ERROR", "CRITICAL"}
+	if !reflect.DeepEqual(params.LogLevels, want) {
+		t.Errorf("expected %v, got %v", want, params.LogLevels)
+	}
+}
+
+func TestSearchLogs_MinLevel(t *testing.T) {
+	var sql string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "count(*)") {
+			sql = string(body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"hits":[]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandler(client, nil, testLogger())
+
+	body := `{"namespace":"test-ns","minLevel":"ERROR","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	for _, level := range []string{"ERROR", "FATAL"} {
+		if !strings.Contains(sql, "logLevel = '"+level+"'") {
+			t.Errorf("expected query to include level %s, got %s", level, sql)
+		}
+	}
+	if strings.Contains(sql, "logLevel = 'WARN'") {
+		t.Errorf("expected query to exclude levels below ERROR, got %s", sql)
+	}
+}
+
+func TestValidateLogLevels(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+	if msg := handler.validateLogLevels([]string{"INFO", "ERROR"}); msg != "" {
+		t.Errorf("unexpected message for accepted levels: %s", msg)
+	}
+	msg := handler.validateLogLevels([]string{"INFO", "EROR", "info"})
+	if !strings.Contains(msg, "EROR, info") || !strings.Contains(msg, strings.Join(DefaultSeverityLevels, ", ")) {
+		t.Errorf("expected message listing the unknown and accepted levels, got %q", msg)
+	}
+
+	custom := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{AcceptedLogLevels: []string{"INFO", "NOTICE"}}, testLogger())
+	if msg := custom.validateLogLevels([]string{"NOTICE"}); msg != "" {
+		t.Errorf("unexpected message for custom level: %s", msg)
+	}
+	if msg := custom.validateLogLevels([]string{"ERROR"}); msg == "" {
+		t.Error("expected ERROR to be rejected by the custom allowlist")
+	}
+
+	lenient := NewLogsHandlerWithOptions(nil, nil, HandlerOptions{LenientLogLevels: true}, testLogger())
+	if msg := lenient.validateLogLevels([]string{"EROR"}); msg != "" {
+		t.Errorf("expected lenient validation to accept unknown levels, got %q", msg)
+	}
+}
+
+func TestSearchLogs_UnknownLogLevel(t *testing.T) {
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"hits":[]}`))
+	}))
+	defer ooServer.Close()
+
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	body := `{"namespace":"test-ns","logLevels":["EROR"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}`
+
+	handler := NewLogsHandler(client, nil, testLogger())
+	rec := httptest.NewRecorder()
+	handler.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), "EROR") || !strings.Contains(rec.Body.String(), "ERROR") {
+		t.Errorf("expected error to list the invalid and accepted levels, got %s", rec.Body.String())
+	}
+
+	lenient := NewLogsHandlerWithOptions(client, nil, HandlerOptions{LenientLogLevels: true}, testLogger())
+	rec = httptest.NewRecorder()
+	lenient.SearchLogs(rec, httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body)))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 with lenient validation, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// templateNamePattern is the form of query template names, which are used in URLs.
+var templateNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,62}$`)
+
+// templatePlaceholderPattern matches the {{variable}} placeholders of query template params.
+var templatePlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// errTemplateNotFound is returned for a query template that does not exist.
+var errTemplateNotFound = errors.New("query template not found")
+
+// queryTemplate is a named search whose params, a body of POST /api/v1/logs/search, may
+// contain {{variable}} placeholders in string values that are filled in when it is run.
+type queryTemplate struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	Params      json.RawMessage `json:"params"`
+	Variables   []string        `json:"variables"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// templateStore keeps the query templates in memory or, when path is set, in a JSON file
+// that is read on every access so that adapter replicas sharing a volume see the same
+// templates. Each tenant has its own templates (see templateKey).
+type templateStore struct {
+	path      string
+	mu        sync.Mutex
+	templates map[string]queryTemplate
+}
+
+// newTemplateStore returns a store backed by the JSON file at path, or an in-memory store if
+// path is empty.
+func newTemplateStore(path string) *templateStore {
+	return &templateStore{path: path, templates: make(map[string]queryTemplate)}
+}
+
+// templateKey returns the key under which the template name of tenant is stored: the name
+// itself without multi-tenancy, and "<tenant>/<name>" otherwise. Template names contain no
+// "/", so the keys of different tenants never collide.
+func templateKey(tenant, name string) string {
+	if tenant == "" {
+		return name
+	}
+	return tenant + "/" + name
+}
+
+// load returns the stored templates. The caller must hold s.mu.
+func (s *templateStore) load() (map[string]queryTemplate, error) {
+	if s.path == "" {
+		return s.templates, nil
+	}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return make(map[string]queryTemplate), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read query templates: %w", err)
+	}
+	templates := make(map[string]queryTemplate)
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse query templates file %s: %w", s.path, err)
+	}
+	return templates, nil
+}
+
+// store replaces the stored templates. The file is written under a temporary name first so
+// that it is never read half-written. The caller must hold s.mu.
+func (s *templateStore) store(templates map[string]queryTemplate) error {
+	if s.path == "" {
+		s.templates = templates
+		return nil
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create query templates directory: %w", err)
+	}
+	f, err := os.CreateTemp(dir, ".templates-*")
+	if err != nil {
+		return fmt.Errorf("failed to create query templates file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(templates); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write query templates: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write query templates: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to store query templates: %w", err)
+	}
+	return nil
+}
+
+// list returns the templates of tenant ordered by name.
+func (s *templateStore) list(tenant string) ([]queryTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	list := make([]queryTemplate, 0, len(templates))
+	for key, t := range templates {
+		if key == templateKey(tenant, t.Name) {
+			list = append(list, t)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// get returns the template of tenant with the given name, or errTemplateNotFound.
+func (s *templateStore) get(tenant, name string) (queryTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return queryTemplate{}, err
+	}
+	t, ok := templates[templateKey(tenant, name)]
+	if !ok {
+		return queryTemplate{}, errTemplateNotFound
+	}
+	return t, nil
+}
+
+// put stores t for tenant, replacing any template of the same name, and reports whether it
+// was created.
+func (s *templateStore) put(tenant string, t queryTemplate) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	key := templateKey(tenant, t.Name)
+	_, exists := templates[key]
+	templates[key] = t
+	return !exists, s.store(templates)
+}
+
+// remove deletes the template of tenant with the given name, or returns errTemplateNotFound.
+func (s *templateStore) remove(tenant, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	templates, err := s.load()
+	if err != nil {
+		return err
+	}
+	key := templateKey(tenant, name)
+	if _, ok := templates[key]; !ok {
+		return errTemplateNotFound
+	}
+	delete(templates, key)
+	return s.store(templates)
+}
+
+// templateVariables returns the names of the placeholders in the string values of params,
+// sorted and without duplicates.
+func templateVariables(params interface{}) []string {
+	seen := make(map[string]bool)
+	walkTemplateStrings(params, func(s string) string {
+		for _, m := range templatePlaceholderPattern.FindAllStringSubmatch(s, -1) {
+			seen[m[1]] = true
+		}
+		return s
+	})
+	variables := make([]string, 0, len(seen))
+	for name := range seen {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return variables
+}
+
+// walkTemplateStrings replaces every string value in v, a decoded JSON value, with the result
+// of fn, and returns the updated value.
+func walkTemplateStrings(v interface{}, fn func(string) string) interface{} {
+	switch v := v.(type) {
+	case string:
+		return fn(v)
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = walkTemplateStrings(value, fn)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = walkTemplateStrings(value, fn)
+		}
+	}
+	return v
+}
+
+// renderTemplate fills in the placeholders of the template params with variables and applies
+// overrides to the top-level params, returning a body for POST /api/v1/logs/search.
+func renderTemplate(t queryTemplate, variables map[string]string, overrides map[string]json.RawMessage) ([]byte, error) {
+	var params map[string]interface{}
+	if err := json.Unmarshal(t.Params, &params); err != nil {
+		return nil, fmt.Errorf("template params are invalid: %w", err)
+	}
+	missing := make(map[string]bool)
+	walkTemplateStrings(params, func(s string) string {
+		return templatePlaceholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+			name := templatePlaceholderPattern.FindStringSubmatch(placeholder)[1]
+			value, ok := variables[name]
+			if !ok {
+				missing[name] = true
+			}
+			return value
+		})
+	})
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("template variables are not set: %s", strings.Join(names, ", "))
+	}
+	for key, value := range overrides {
+		params[key] = value
+	}
+	return json.Marshal(params)
+}
+
+// queryTemplateRequest is the body of PUT /api/v1/logs/templates/{name}.
+type queryTemplateRequest struct {
+	Description string          `json:"description"`
+	Params      json.RawMessage `json:"params"`
+}
+
+// runTemplateRequest is the body of POST /api/v1/logs/templates/{name}/run.
+type runTemplateRequest struct {
+	Variables map[string]string          `json:"variables"`
+	Overrides map[string]json.RawMessage `json:"overrides"`
+}
+
+// ListQueryTemplates implements GET /api/v1/logs/templates.
+func (h *LogsHandler) ListQueryTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.templates.list(tenantID(r.Context()))
+	if err != nil {
+		h.logger.Error("Failed to list query templates", slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, map[string]interface{}{"templates": templates})
+}
+
+// GetQueryTemplate implements GET /api/v1/logs/templates/{name}.
+func (h *LogsHandler) GetQueryTemplate(w http.ResponseWriter, r *http.Request) {
+	t, ok := h.lookupTemplate(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	h.writeJSON(w, http.StatusOK, t)
+}
+
+// PutQueryTemplate implements PUT /api/v1/logs/templates/{name}.
+// It saves a named search whose params, a body of POST /api/v1/logs/search, may contain
+// {{variable}} placeholders in string values (e.g. "componentIds": ["{{component}}"]),
+// replacing any template of the same name. It responds with 201 when the template is new.
+func (h *LogsHandler) PutQueryTemplate(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if !templateNamePattern.MatchString(name) {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest,
+			"template name must be 1-63 letters, digits, '.', '_' or '-', starting with a letter or digit")
+		return
+	}
+	var req queryTemplateRequest
+	if err := decodeJSONBody(r, &req); err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(req.Params, &params); err != nil || params == nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, "params must be a search request object")
+		return
+	}
+
+	t := queryTemplate{
+		Name:        name,
+		Description: req.Description,
+		Params:      req.Params,
+		Variables:   templateVariables(params),
+		UpdatedAt:   time.Now().UTC(),
+	}
+	created, err := h.templates.put(tenantID(r.Context()), t)
+	if err != nil {
+		h.logger.Error("Failed to save query template", slog.String("template", name), slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	h.writeJSON(w, status, t)
+}
+
+// DeleteQueryTemplate implements DELETE /api/v1/logs/templates/{name}.
+func (h *LogsHandler) DeleteQueryTemplate(w http.ResponseWriter, r *http.Request) {
+	err := h.templates.remove(tenantID(r.Context()), r.PathValue("name"))
+	if errors.Is(err, errTemplateNotFound) {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "query template not found")
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to delete query template", slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunQueryTemplate implements POST /api/v1/logs/templates/{name}/run.
+// It runs a saved template as POST /api/v1/logs/search, with the query parameters of the
+// request. The body sets the template "variables" and may replace top-level search params
+// with "overrides" (e.g. a different time window); an empty body runs the template as saved.
+func (h *LogsHandler) RunQueryTemplate(w http.ResponseWriter, r *http.Request) {
+	t, ok := h.lookupTemplate(w, r, r.PathValue("name"))
+	if !ok {
+		return
+	}
+	var req runTemplateRequest
+	if err := decodeJSONBody(r, &req); err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, requestBodyMessage(err))
+		return
+	}
+	body, err := renderTemplate(t, req.Variables, req.Overrides)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, err.Error())
+		return
+	}
+
+	search := r.Clone(r.Context())
+	search.Body = io.NopCloser(bytes.NewReader(body))
+	search.ContentLength = int64(len(body))
+	h.SearchLogs(w, search)
+}
+
+// lookupTemplate returns the template of the tenant of r with the given name, or writes an
+// error response.
+func (h *LogsHandler) lookupTemplate(w http.ResponseWriter, r *http.Request, name string) (queryTemplate, bool) {
+	t, err := h.templates.get(tenantID(r.Context()), name)
+	if errors.Is(err, errTemplateNotFound) {
+		h.writeError(w, http.StatusNotFound, gen.NotFound, "query template not found")
+		return queryTemplate{}, false
+	}
+	if err != nil {
+		h.logger.Error("Failed to read query template", slog.String("template", name), slog.Any("error", err))
+		h.writeError(w, http.StatusInternalServerError, gen.InternalServerError, "internal server error")
+		return queryTemplate{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestQueryTemplates(t *testing.T) {
+	var sqls []string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		sqls = append(sqls, body.Query.SQL)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{
+			Hits: []map[string]interface{}{{"_timestamp": float64(1735732800000000), "log": "boom", "total": float64(1)}},
+		})
+	}))
+	defer ooServer.Close()
+
+	file := filepath.Join(t.TempDir(), "templates", "templates.json")
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	newMux := func() *http.ServeMux {
+		mux := http.NewServeMux()
+		NewLogsHandlerWithOptions(client, nil, HandlerOptions{QueryTemplatesFile: file}, testLogger()).registerRoutes(mux)
+		return mux
+	}
+	mux := newMux()
+	do := func(mux *http.ServeMux, method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(method, path, strings.NewReader(body)))
+		return rec
+	}
+
+	template := `{"description":"errors","params":{"namespace":"{{namespace}}","componentIds":["{{component}}"],` +
+		`"logLevels":["ERROR"],"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z"}}`
+	rec := do(mux, http.MethodPut, "/api/v1/logs/templates/errors", template)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var saved queryTemplate
+	if err := json.Unmarshal(rec.Body.Bytes(), &saved); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+	if strings.Join(saved.Variables, ",") != "component,namespace" {
+		t.Errorf("unexpected variables %v", saved.Variables)
+	}
+	if rec := do(mux, http.MethodPut, "/api/v1/logs/templates/errors", template); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 replacing a template, got %d", rec.Code)
+	}
+
+	// A handler reading the same file sees the template.
+	mux = newMux()
+	rec = do(mux, http.MethodPost, "/api/v1/logs/templates/errors/run",
+		`{"variables":{"namespace":"acme","component":"api"},"overrides":{"logLevels":["WARN"]}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if len(sqls) == 0 || !strings.Contains(sqls[0], "'acme'") || !strings.Contains(sqls[0], "'api'") ||
+		!strings.Contains(sqls[0], "'WARN'") || strings.Contains(sqls[0], "'ERROR'") {
+		t.Errorf("expected the rendered template to be searched, got %q", sqls)
+	}
+
+	if rec := do(mux, http.MethodPost, "/api/v1/logs/templates/errors/run", `{"variables":{"namespace":"acme"}}`); rec.Code != http.StatusBadRequest ||
+		!strings.Contains(rec.Body.String(), "component") {
+		t.Errorf("expected 400 naming the unset variable, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = do(mux, http.MethodGet, "/api/v1/logs/templates", "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"name":"errors"`) {
+		t.Errorf("expected the template to be listed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := do(mux, http.MethodDelete, "/api/v1/logs/templates/errors", ""); rec.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rec.Code)
+	}
+	for _, tc := range []struct{ method, path, body string }{
+		{http.MethodGet, "/api/v1/logs/templates/errors", ""},
+		{http.MethodDelete, "/api/v1/logs/templates/errors", ""},
+		{http.MethodPost, "/api/v1/logs/templates/errors/run", ""},
+	} {
+		if rec := do(mux, tc.method, tc.path, tc.body); rec.Code != http.StatusNotFound {
+			t.Errorf("%s %s: expected 404, got %d", tc.method, tc.path, rec.Code)
+		}
+	}
+}
+
+func TestPutQueryTemplate_BadRequest(t *testing.T) {
+	mux := http.NewServeMux()
+	NewLogsHandler(nil, nil, testLogger()).registerRoutes(mux)
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"invalid name", "/api/v1/logs/templates/-bad", `{"params":{"namespace":"ns"}}`},
+		{"missing params", "/api/v1/logs/templates/ok", `{"description":"x"}`},
+		{"params not an object", "/api/v1/logs/templates/ok", `{"params":["ns"]}`},
+		{"malformed body", "/api/v1/logs/templates/ok", `{`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body)))
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
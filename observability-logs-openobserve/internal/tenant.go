@@ -0,0 +1,240 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+// DefaultTenantHeader is the request header carrying the tenant ID when
+// TenantSettings.Header is not set.
+const DefaultTenantHeader = "X-Tenant-ID"
+
+// Tenant is what a tenant of a multi-tenant deployment may query.
+type Tenant struct {
+	// Org is the OpenObserve organization queried for the tenant. Empty keeps the configured one.
+	Org string `json:"org,omitempty"`
+	// Stream is the log stream component log queries read, replacing the logStream of the
+	// request. It must be the configured stream or among the allowed streams. Empty keeps the
+	// stream selected by the request.
+	Stream string `json:"stream,omitempty"`
+	// LabelSelectors are the pod labels every component log query is restricted to, on top of
+	// the labelSelectors of the request, e.g. {"team": "payments"}.
+	LabelSelectors map[string]string `json:"labelSelectors,omitempty"`
+
+	// id is the tenant ID, set when the tenant is resolved from a request.
+	id string
+	// ownOrg is set for a tenant whose organization no other tenant, nor the configured
+	// organization, shares (see TenantSettings.withOrgOwnership). Only such tenants may use
+	// the endpoints that cannot be restricted to their label selectors.
+	ownOrg bool
+}
+
+// TenantSettings configures multi-tenancy. Every request must then name one of Tenants in
+// Header. The zero value disables multi-tenancy.
+type TenantSettings struct {
+	// Header is the request header carrying the tenant ID. Defaults to DefaultTenantHeader.
+	Header string
+	// Tenants maps the tenant IDs to what they may query.
+	Tenants map[string]Tenant
+}
+
+// Enabled reports whether any tenant is configured.
+func (s TenantSettings) Enabled() bool {
+	return len(s.Tenants) > 0
+}
+
+// LoadTenants reads the tenant map from a JSON file keyed by tenant ID, e.g.
+//
+//	{"payments": {"org": "payments", "labelSelectors": {"team": "payments"}}}
+//
+// The stream of a tenant must be stream, the configured one, or among allowedStreams.
+func LoadTenants(path, stream string, allowedStreams []openobserve.StreamRef) (map[string]Tenant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tenants map[string]Tenant
+	if err := json.Unmarshal(data, &tenants); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	for id, tenant := range tenants {
+		if strings.TrimSpace(id) == "" {
+			return nil, fmt.Errorf("tenant IDs must not be empty")
+		}
+		if tenant.Stream != "" {
+			ref, err := openobserve.ParseStreamRef(tenant.Stream)
+			if err != nil {
+				return nil, fmt.Errorf("tenant %q: invalid stream %q: %w", id, tenant.Stream, err)
+			}
+			if (ref.Folder != "" || ref.Name != stream) && !slices.Contains(allowedStreams, ref) {
+				return nil, fmt.Errorf("tenant %q: stream %q is not among the allowed streams", id, tenant.Stream)
+			}
+		}
+		for key := range tenant.LabelSelectors {
+			if !openobserve.ValidLabelKey(key) {
+				return nil, fmt.Errorf("tenant %q: invalid label key %q", id, key)
+			}
+		}
+	}
+	return tenants, nil
+}
+
+// resolve returns the tenant named by the tenant header of r, or a user-facing message if
+// there is none.
+func (s TenantSettings) resolve(r *http.Request) (Tenant, string) {
+	header := s.Header
+	if header == "" {
+		header = DefaultTenantHeader
+	}
+	id := strings.TrimSpace(r.Header.Get(header))
+	if id == "" {
+		return Tenant{}, "missing " + header + " header"
+	}
+	tenant, ok := s.Tenants[id]
+	if !ok {
+		return Tenant{}, "unknown tenant " + id
+	}
+	tenant.id = id
+	return tenant, ""
+}
+
+// withOrgOwnership returns a copy of s marking the tenants that have an organization of their
+// own: one that is not defaultOrg, the configured organization, and that no other tenant
+// queries.
+func (s TenantSettings) withOrgOwnership(defaultOrg string) TenantSettings {
+	tenantsPerOrg := make(map[string]int)
+	for _, tenant := range s.Tenants {
+		tenantsPerOrg[tenant.Org]++
+	}
+	tenants := make(map[string]Tenant, len(s.Tenants))
+	for id, tenant := range s.Tenants {
+		tenant.ownOrg = tenant.Org != "" && tenant.Org != defaultOrg && tenantsPerOrg[tenant.Org] == 1
+		tenants[id] = tenant
+	}
+	s.Tenants = tenants
+	return s
+}
+
+// orgWideRoute reports whether the endpoint at path reads or changes data of the whole
+// organization, which label selectors cannot restrict: the events and the alert rules.
+// Workflow logs, served by the component log query endpoint, are rejected by QueryLogs.
+func orgWideRoute(path string) bool {
+	return path == "/api/v1/events/query" || path == "/api/v1alpha1/alerts/rules" ||
+		strings.HasPrefix(path, "/api/v1alpha1/alerts/rules/")
+}
+
+// orgWideMessage is the message rejecting a tenant's request to an org-wide endpoint.
+const orgWideMessage = "not available to tenants sharing an OpenObserve organization"
+
+type tenantKey struct{}
+
+// withTenant returns a copy of ctx carrying tenant (see tenantFromContext).
+func withTenant(ctx context.Context, tenant Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// tenantMiddleware rejects the requests that do not name a configured tenant in the tenant
+// header with 403, and stores the tenant of the others in the request context. Requests to
+// org-wide endpoints (see orgWideRoute) are rejected with 403 too unless the tenant has an
+// organization of its own. The health check and the admin endpoints, which are not scoped to
+// a tenant, are exempt. settings must have gone through TenantSettings.withOrgOwnership.
+func tenantMiddleware(next http.Handler, settings TenantSettings, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unauthenticatedRoutes[r.URL.Path] || adminRoutes[r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		tenant, message := settings.resolve(r)
+		if message == "" && !tenant.ownOrg && orgWideRoute(r.URL.Path) {
+			message = r.URL.Path + " is " + orgWideMessage
+		}
+		if message != "" {
+			logger.Warn("Rejected request without a valid tenant",
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.String("clientIP", clientIPFromContext(r.Context())),
+				slog.String("reason", message),
+			)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			if err := json.NewEncoder(w).Encode(gen.ErrorResponse{
+				Title:   ptr(gen.Forbidden),
+				Message: ptr(message),
+			}); err != nil {
+				logger.Error("Failed to write forbidden response", slog.Any("error", err))
+			}
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withTenant(r.Context(), tenant)))
+	})
+}
+
+// tenantFromContext returns the tenant of the request, if multi-tenancy is enabled.
+func tenantFromContext(ctx context.Context) (Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(Tenant)
+	return tenant, ok
+}
+
+// clientFor returns the OpenObserve client serving the tenant of ctx: a client querying the
+// tenant's organization, or the configured client when the tenant has none or there is no
+// tenant. The clients are kept per organization, so that their queries are still deduplicated.
+func (h *LogsHandler) clientFor(ctx context.Context) *openobserve.Client {
+	tenant, ok := tenantFromContext(ctx)
+	if !ok || tenant.Org == "" {
+		return h.client
+	}
+	if client, ok := h.tenantClients.Load(tenant.Org); ok {
+		return client.(*openobserve.Client)
+	}
+	client, _ := h.tenantClients.LoadOrStore(tenant.Org, h.client.ForOrg(tenant.Org))
+	return client.(*openobserve.Client)
+}
+
+// sharesOrg reports whether ctx belongs to a tenant without an organization of its own, whose
+// requests must be restricted to its label selectors.
+func sharesOrg(ctx context.Context) bool {
+	tenant, ok := tenantFromContext(ctx)
+	return ok && !tenant.ownOrg
+}
+
+// tenantID returns the ID of the tenant of ctx, or "" if multi-tenancy is disabled.
+func tenantID(ctx context.Context) string {
+	tenant, _ := tenantFromContext(ctx)
+	return tenant.id
+}
+
+// scopeToTenant restricts params to what the tenant of ctx may query: its stream and its
+// label selectors, which take precedence over the request's own.
+func scopeToTenant(ctx context.Context, params openobserve.ComponentLogsParams) openobserve.ComponentLogsParams {
+	tenant, ok := tenantFromContext(ctx)
+	if !ok {
+		return params
+	}
+	if tenant.Stream != "" {
+		params.LogStream = tenant.Stream
+	}
+	if len(tenant.LabelSelectors) > 0 {
+		selectors := make(map[string]string, len(params.LabelSelectors)+len(tenant.LabelSelectors))
+		for key, value := range params.LabelSelectors {
+			selectors[key] = value
+		}
+		for key, value := range tenant.LabelSelectors {
+			selectors[key] = value
+		}
+		params.LabelSelectors = selectors
+	}
+	return params
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/openobserve"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	settings := TenantSettings{Tenants: map[string]Tenant{"payments": {Org: "payments"}}}
+	var got Tenant
+	handler := tenantMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = tenantFromContext(r.Context())
+	}), settings, testLogger())
+
+	tests := []struct {
+		name       string
+		path       string
+		tenant     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"health check", "/health", "", http.StatusOK, ""},
+		{"missing header", "/api/v1/logs/search", "", http.StatusForbidden, "missing X-Tenant-ID header"},
+		{"unknown tenant", "/api/v1/logs/search", "billing", http.StatusForbidden, "unknown tenant billing"},
+		{"valid tenant", "/api/v1/logs/search", "payments", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+		if tt.tenant != "" {
+			req.Header.Set(DefaultTenantHeader, tt.tenant)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.wantStatus || !strings.Contains(rec.Body.String(), tt.wantBody) {
+			t.Errorf("%s: got %d %s, want %d %q", tt.name, rec.Code, rec.Body.String(), tt.wantStatus, tt.wantBody)
+		}
+	}
+	if got.Org != "payments" {
+		t.Errorf("expected the tenant in the request context, got %+v", got)
+	}
+}
+
+func TestSearchLogs_ScopedToTenant(t *testing.T) {
+	var mu sync.Mutex
+	var paths, queries []string
+	ooServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query struct {
+				SQL string `json:"sql"`
+			} `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		queries = append(queries, body.Query.SQL)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(openobserve.OpenObserveResponse{})
+	}))
+	defer ooServer.Close()
+	client := openobserve.NewClient(ooServer.URL, "default", "default", "k8s_events", "admin", "pass", testLogger())
+	handler := NewLogsHandlerWithOptions(client, nil, HandlerOptions{LabelSelectorKeys: []string{"team"}}, testLogger())
+	srv := NewServerWithOptions("0", handler, ServerOptions{Tenants: TenantSettings{
+		Header:  "X-Team",
+		Tenants: map[string]Tenant{"payments": {Org: "payments", LabelSelectors: map[string]string{"team": "payments"}}},
+	}}, testLogger())
+
+	// The request's own selector cannot widen the tenant's.
+	body := `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","labelSelectors":{"team":"billing"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/search", strings.NewReader(body))
+	req.Header.Set("X-Team", "payments")
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if len(paths) == 0 {
+		t.Fatal("expected OpenObserve to be queried")
+	}
+	for i, path := range paths {
+		if path != "/api/payments/_search" {
+			t.Errorf("expected the tenant's organization to be queried, got %s", path)
+		}
+		if !strings.Contains(queries[i], "kubernetes_labels_team = 'payments'") || strings.Contains(queries[i], "billing") {
+			t.Errorf("expected the tenant's label selector, got %s", queries[i])
+		}
+	}
+}
+
+func TestTenantMiddleware_OrgWideRoutes(t *testing.T) {
+	settings := TenantSettings{Tenants: map[string]Tenant{
+		"payments": {Org: "payments"},
+		"search":   {Org: "shared"},
+		"billing":  {Org: "shared"},
+		"default":  {},
+	}}.withOrgOwnership("default")
+	handler := tenantMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), settings, testLogger())
+
+	tests := []struct {
+		tenant     string
+		path       string
+		wantStatus int
+	}{
+		{"payments", "/api/v1alpha1/alerts/rules", http.StatusOK},
+		{"payments", "/api/v1/events/query", http.StatusOK},
+		{"search", "/api/v1alpha1/alerts/rules", http.StatusForbidden},
+		{"search", "/api/v1alpha1/alerts/rules/my-rule/evidence", http.StatusForbidden},
+		{"default", "/api/v1/events/query", http.StatusForbidden},
+		{"default", "/api/v1/logs/search", http.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		req.Header.Set(DefaultTenantHeader, tt.tenant)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s %s: expected %d, got %d", tt.tenant, tt.path, tt.wantStatus, rec.Code)
+		}
+	}
+}
+
+func TestQueryLogs_WorkflowLogsOfSharedOrg(t *testing.T) {
+	handler := NewLogsHandler(nil, nil, testLogger())
+	srv := NewServerWithOptions("0", handler, ServerOptions{Tenants: TenantSettings{
+		Tenants: map[string]Tenant{"search": {}},
+	}}, testLogger())
+
+	body := `{"startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","searchScope":{"namespace":"ns","workflowRunName":"run-1"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/logs/query", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DefaultTenantHeader, "search")
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestQueryTemplates_PerTenant(t *testing.T) {
+	srv := NewServerWithOptions("0", NewLogsHandler(nil, nil, testLogger()), ServerOptions{Tenants: TenantSettings{
+		Tenants: map[string]Tenant{"payments": {}, "search": {}},
+	}}, testLogger())
+	do := func(method, path, tenant, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(DefaultTenantHeader, tenant)
+		rec := httptest.NewRecorder()
+		srv.httpServer.Handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := do(http.MethodPut, "/api/v1/logs/templates/errors", "payments", `{"params":{"namespace":"payments"}}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := do(http.MethodPut, "/api/v1/logs/templates/errors", "search", `{"params":{"namespace":"search"}}`); rec.Code != http.StatusCreated {
+		t.Errorf("expected another tenant's template of the same name to be created, got %d", rec.Code)
+	}
+	rec := do(http.MethodGet, "/api/v1/logs/templates/errors", "payments", "")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"namespace":"payments"`) {
+		t.Errorf("expected the tenant's own template, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := do(http.MethodDelete, "/api/v1/logs/templates/errors", "search", ""); rec.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rec.Code)
+	}
+	if rec := do(http.MethodGet, "/api/v1/logs/templates", "payments", ""); !strings.Contains(rec.Body.String(), `"name":"errors"`) {
+		t.Errorf("expected deleting another tenant's template to keep this one, got %s", rec.Body.String())
+	}
+}
+
+func TestStreamAndExport_ValidatedWithTenantClient(t *testing.T) {
+	client := openobserve.NewClient("http://127.0.0.1:0", "default", "default", "k8s_events", "admin", "pass", testLogger())
+	for _, tt := range []struct {
+		name   string
+		method string
+		target string
+		body   string
+	}{
+		{"stream", http.MethodGet, "/api/v1/logs/stream?namespace=default&logStream=other", ""},
+		{"export", http.MethodPost, "/api/v1/logs/export", `{"namespace":"default","startTime":"2025-01-01T00:00:00Z","endTime":"2025-01-02T00:00:00Z","logStream":"other"}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewLogsHandler(client, nil, testLogger())
+			srv := NewServerWithOptions("0", handler, ServerOptions{Tenants: TenantSettings{
+				Tenants: map[string]Tenant{"payments": {Org: "payments"}},
+			}}, testLogger())
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(DefaultTenantHeader, "payments")
+			rec := httptest.NewRecorder()
+			srv.httpServer.Handler.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if _, ok := handler.tenantClients.Load("payments"); !ok {
+				t.Error("expected the request to be validated with the tenant's client")
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/openchoreo/community-modules/observability-logs-openobserve/internal/api/gen"
+)
+
+// exampleTimestamp illustrates the expected timestamp format in error messages.
+const exampleTimestamp = "2025-01-01T00:00:00Z"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TimeParamError reports a timestamp of a request body that is not in RFC3339 format.
+// Field is empty when the offending field could not be determined; Value is the offending
+// string, or the JSON text of a value that is not a string.
+type TimeParamError struct {
+	Field string
+	Value string
+}
+
+func (e *TimeParamError) Error() string {
+	field := e.Field
+	if field == "" {
+		field = "timestamps"
+	}
+	if e.Value == "" {
+		return fmt.Sprintf("%s must not be empty; expected an RFC3339 timestamp such as %s", field, exampleTimestamp)
+	}
+	return fmt.Sprintf("%s must be an RFC3339 timestamp such as %s, got %q", field, exampleTimestamp, e.Value)
+}
+
+// requestBodyMessage returns the user-facing message for a request body that could not be
+// decoded.
+func requestBodyMessage(err error) string {
+	var timeErr *TimeParamError
+	if errors.As(err, &timeErr) {
+		return timeErr.Error()
+	}
+	return "invalid request body"
+}
+
+// findTimeParamError returns a TimeParamError for the first timestamp field of v whose value
+// in body cannot be decoded, or nil if the timestamps are valid.
+func findTimeParamError(body []byte, v interface{}) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+	for _, name := range timeFieldNames(reflect.TypeOf(v)) {
+		raw, ok := fields[name]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		var t time.Time
+		if err := json.Unmarshal(raw, &t); err != nil {
+			value := string(raw)
+			var s string
+			if json.Unmarshal(raw, &s) == nil {
+				value = s
+			}
+			return &TimeParamError{Field: name, Value: value}
+		}
+	}
+	return nil
+}
+
+// timeFieldNames returns the JSON names of the timestamp fields of the struct type t,
+// including those of embedded structs.
+func timeFieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft == timeType:
+			if tag == "" {
+				tag = f.Name
+			}
+			names = append(names, tag)
+		case f.Anonymous && tag == "" && ft.Kind() == reflect.Struct:
+			names = append(names, timeFieldNames(ft)...)
+		}
+	}
+	return names
+}
+
+// handleRequestError reports a request of a generated endpoint that could not be decoded.
+// Malformed timestamps are reported like those of the other endpoints; the generated
+// decoder does not name the field, so the message shows the offending value instead.
+func (h *LogsHandler) handleRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	var parseErr *time.ParseError
+	if errors.As(err, &parseErr) {
+		h.writeError(w, http.StatusBadRequest, gen.BadRequest, (&TimeParamError{Value: parseErr.Value}).Error())
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
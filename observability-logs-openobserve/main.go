@@ -44,14 +44,60 @@ func main() {
 		slog.String("OpenObserve Password", string(cfg.OpenObservePassword[0])+"*****"),
 		slog.String("Server Port", cfg.ServerPort),
 	)
+	for _, key := range cfg.DefaultedTarget {
+		logger.Warn("OpenObserve target not configured, falling back to the default; set it explicitly or enable OPENOBSERVE_STRICT_TARGET",
+			slog.String("variable", key),
+			slog.String("default", "default"),
+		)
+	}
+
+	// With a login URL, requests authenticate with session tokens obtained from it using the
+	// OpenObserve credentials, instead of sending the credentials with every request.
+	var tokenSource openobserve.TokenSource
+	if cfg.LoginURL != "" {
+		tokenSource = openobserve.PasswordLogin(cfg.LoginURL, cfg.LoginClientID, cfg.OpenObserveUser, cfg.OpenObservePassword)
+	}
 
-	client := openobserve.NewClient(
+	client := openobserve.NewClientWithOptions(
 		cfg.OpenObserveURL,
 		cfg.OpenObserveOrg,
 		cfg.OpenObserveStream,
 		cfg.OpenObserveEventsStream,
 		cfg.OpenObserveUser,
 		cfg.OpenObservePassword,
+		openobserve.ClientOptions{
+			NearLimitRatio:     cfg.NearLimitRatio,
+			AtTimestampEpsilon: cfg.AtTimestampEpsilon,
+			FallbackStreams:    cfg.FallbackStreams,
+			TimestampField:     cfg.TimestampField,
+			StreamField:        cfg.StreamField,
+			EventTimeField:     cfg.EventTimeField,
+			VersionField:       cfg.VersionField,
+			CorrelationField:   cfg.CorrelationField,
+			MessageField:       cfg.MessageField,
+			LevelField:         cfg.LevelField,
+			LevelFromMessage:   cfg.LevelFromMessage,
+			LevelPattern:       cfg.LevelPattern,
+			SeverityClasses:    cfg.SeverityClasses,
+			MaxComponentIDs:    cfg.MaxComponentIDs,
+			DefaultWindow:      cfg.DefaultQueryWindow,
+			DefaultSortOrder:   cfg.DefaultSortOrder,
+			SchemaCacheTTL:     cfg.SchemaCacheTTL,
+			BestEffortTimeout:  cfg.BestEffortTimeout,
+			ScanBudgets:        cfg.ScanBudgets,
+			ScanBudgetWindow:   cfg.ScanBudgetWindow,
+			QueryDedupWindow:   cfg.QueryDedupWindow,
+			MaxScanBytes:       cfg.MaxScanBytes,
+			KeepAliveInterval:  cfg.KeepAliveInterval,
+			AllowedStreams:     cfg.AllowedStreams,
+			StripANSI:          cfg.StripANSI,
+			Headers:            cfg.OpenObserveHeaders,
+			QueryRetry:         cfg.QueryRetry,
+			AlertRetry:         cfg.AlertRetry,
+			EmptyResultRetry:   cfg.EmptyResultRetry,
+			UIURL:              cfg.UIURL,
+			TokenSource:        tokenSource,
+		},
 		logger,
 	)
 
@@ -97,10 +143,50 @@ func main() {
 
 	logger.Info("Successfully connected to OpenObserve")
 
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	go client.KeepAlive(backgroundCtx)
+
 	// Create observer client and handlers
 	observerClient := observer.NewClient(cfg.ObserverURL)
-	logsHandler := app.NewLogsHandler(client, observerClient, logger)
-	srv := app.NewServer(cfg.ServerPort, logsHandler, logger)
+	logsHandler := app.NewLogsHandlerWithOptions(client, observerClient, app.HandlerOptions{
+		FieldMapping:            cfg.LogFieldMapping,
+		TimestampFormat:         cfg.LogTimestampFormat,
+		StreamPollInterval:      cfg.StreamPollInterval,
+		StreamHeartbeatInterval: cfg.StreamHeartbeatInterval,
+		StreamBufferSize:        cfg.StreamBufferSize,
+		StreamWriteTimeout:      cfg.StreamWriteTimeout,
+		StreamIdleTimeout:       cfg.StreamIdleTimeout,
+		StreamMaxDuration:       cfg.StreamMaxDuration,
+		EmptyResultNotFound:     cfg.EmptyResultNotFound,
+		SeverityLevels:          cfg.SeverityLevels,
+		AcceptedLogLevels:       cfg.AcceptedLogLevels,
+		LenientLogLevels:        cfg.LenientLogLevels,
+		PercentileFields:        cfg.PercentileFields,
+		LabelSelectorKeys:       cfg.LabelSelectorKeys,
+		MaxAlertWindow:          cfg.MaxAlertWindow,
+		ClockSkewTolerance:      cfg.ClockSkewTolerance,
+		ClampEndTime:            cfg.ClampEndTime,
+		ResultsDir:              cfg.ResultsDir,
+		ResultTTL:               cfg.ResultTTL,
+		QueryTemplatesFile:      cfg.QueryTemplatesFile,
+		DeploymentEventsURL:     cfg.DeploymentEventsURL,
+		ExportBucket:            cfg.ExportBucket,
+		AdminOrgs:               cfg.AdminOrgs,
+		AdminOrgConcurrency:     cfg.AdminOrgConcurrency,
+	}, logger)
+	go logsHandler.CleanupResults(backgroundCtx)
+	srv := app.NewServerWithOptions(cfg.ServerPort, logsHandler, app.ServerOptions{
+		RequestTimeout: cfg.RequestTimeout,
+		TrustedProxies: cfg.TrustedProxies,
+		Auth:           cfg.APIAuth,
+		DisableAlerts:  !cfg.AlertsEnabled,
+
+		EnforceScanBudgets: len(cfg.ScanBudgets) > 0,
+		StreamDrainTimeout: cfg.StreamDrainTimeout,
+		MaxStreams:         cfg.MaxStreams,
+		Tenants:            cfg.Tenants,
+	}, logger)
 
 	go func() {
 		if err := srv.Start(); err != nil {
@@ -109,6 +195,17 @@ func main() {
 		}
 	}()
 
+	var grpcSrv *app.GRPCServer
+	if cfg.GRPCPort != "" {
+		grpcSrv = app.NewGRPCServer(cfg.GRPCPort, logsHandler, cfg.APIAuth, cfg.Tenants, logger)
+		go func() {
+			if err := grpcSrv.Start(); err != nil {
+				logger.Error("gRPC server error", slog.Any("error", err))
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Shutdown logic
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -116,9 +213,15 @@ func main() {
 
 	logger.Info("Shutting down gracefully")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// Open log streams are drained first, within their own timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.StreamDrainTimeout+10*time.Second)
 	defer cancel()
 
+	if grpcSrv != nil {
+		if err := grpcSrv.Shutdown(ctx); err != nil {
+			logger.Error("Error during gRPC server shutdown", slog.Any("error", err))
+		}
+	}
 	if err := srv.Shutdown(ctx); err != nil {
 		logger.Error("Error during shutdown", slog.Any("error", err))
 		os.Exit(1)